@@ -1,11 +1,16 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
+	"os/signal"
 	"runtime"
+	"sync"
 	"syscall"
 	"time"
 
@@ -14,10 +19,16 @@ import (
 	"go.uber.org/dig"
 
 	"link/config"
+	_accessTokenEntity "link/internal/accesstoken/entity"
+	_boardUsecase "link/internal/board/usecase"
+	_chatUsecase "link/internal/chat/usecase"
+	_postUsecase "link/internal/post/usecase"
+	"link/internal/user/entity"
 	handlerHttp "link/pkg/http"
 	"link/pkg/interceptor"
 	"link/pkg/logger"
 	"link/pkg/middleware"
+	"link/pkg/sso"
 	ws "link/pkg/ws"
 )
 
@@ -50,6 +61,25 @@ func setUlimit() {
 	logger.LogSuccess(fmt.Sprintf("설정된 ulimit: %d (Soft) / %d (Hard)\n", rLimit.Cur, rLimit.Max))
 }
 
+// SSO_STUB_ISSUER 경로에 개발용 IdP 라우팅
+func mountStubIdP(r *gin.Engine) {
+	issuer := os.Getenv("SSO_STUB_ISSUER")
+	if issuer == "" {
+		issuer = "http://localhost:8080/dev/sso/stub"
+	}
+	issuerUrl, err := url.Parse(issuer)
+	if err != nil {
+		log.Fatalf("SSO_STUB_ISSUER 형식 오류: %v", err)
+	}
+
+	stub, err := sso.NewStubIdP(issuer, os.Getenv("SSO_STUB_CLIENT_SECRET"))
+	if err != nil {
+		log.Fatalf("개발용 SSO IdP 생성 실패: %v", err)
+	}
+	stub.RegisterRoutes(r.Group(issuerUrl.Path))
+	logger.LogSuccess(fmt.Sprintf("개발용 SSO IdP 활성화: %s", issuer))
+}
+
 func startServer() {
 	setUlimit()
 
@@ -75,6 +105,7 @@ func startServer() {
 	config.InitCompany(cfg.DB)
 	config.InitAdminUser(cfg.DB)
 	config.InitRedisUserState(cfg.Redis)
+	config.InitMongoIndexes(cfg.Mongo)
 	// config.UpdateAllUserOffline(cfg.DB)
 	config.EnsureDirectory("static/profiles")
 	config.EnsureDirectory("static/posts")
@@ -94,6 +125,8 @@ func startServer() {
 
 	r.Static("/static/posts", "./static/posts")       //게시물
 	r.Static("/static/profiles", "./static/profiles") //프로필
+	r.Static("/static/chats", "./static/chats")       //채팅 첨부 파일
+	r.Static("/static/boards", "./static/boards")     //칸반보드 카드 첨부 파일
 
 	// CORS 설정 - 개발 환경에서는 모든 오리진을 쿠키 허용
 	//TODO 배포 환경에서 특정도메인 허용
@@ -113,8 +146,17 @@ func startServer() {
 	r.SetTrustedProxies(nil)
 	r.Use(interceptor.ErrorHandler())
 
-	wsHub := ws.NewWebSocketHub()
-	go wsHub.Run()
+	// 종료 신호를 받으면 백그라운드 워커와 HTTP 서버 종료
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	var workers sync.WaitGroup
+	startWorker := func(run func(ctx context.Context)) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			run(ctx)
+		}()
+	}
 
 	err := container.Invoke(func(
 		userHandler *handlerHttp.UserHandler,
@@ -132,16 +174,32 @@ func startServer() {
 		reportHandler *handlerHttp.ReportHandler,
 		projectHandler *handlerHttp.ProjectHandler,
 		boardHandler *handlerHttp.BoardHandler,
+		ssoHandler *handlerHttp.SsoHandler,
+		accessTokenHandler *handlerHttp.AccessTokenHandler,
+		chatRetentionHandler *handlerHttp.ChatRetentionHandler,
 		params struct {
 			dig.In
 			ProfileImageMiddleware *middleware.ImageUploadMiddleware `name:"profileImageMiddleware"`
 			PostImageMiddleware    *middleware.ImageUploadMiddleware `name:"postImageMiddleware"`
+			ChatFileMiddleware     *middleware.FileUploadMiddleware
+			BoardFileMiddleware    *middleware.FileUploadMiddleware `name:"boardFileMiddleware"`
 		},
 
 		tokenInterceptor *interceptor.TokenInterceptor,
 
 		wsHandler *ws.WsHandler,
+
+		postViewFlusher *_postUsecase.PostViewFlusher,
+		chatRetentionPurger *_chatUsecase.ChatRetentionPurger,
+		cardDocumentSnapshotter *_boardUsecase.CardDocumentSnapshotter,
 	) {
+		// 조회수 diff -> DB 반영 워커
+		startWorker(postViewFlusher.Run)
+		// 보관 기간 지난 채팅 삭제 워커
+		startWorker(chatRetentionPurger.Run)
+		// 협업 편집 중인 카드 문서 -> DB 스냅샷 워커
+		startWorker(cardDocumentSnapshotter.Run)
+
 		// WebSocket 관련 라우팅 그룹
 		wsGroup := r.Group("/ws")
 		{
@@ -150,6 +208,16 @@ func startServer() {
 			wsGroup.GET("/user", wsHandler.HandleUserWebSocketConnection)
 			wsGroup.GET("/company", wsHandler.HandleCompanyEvent)
 			wsGroup.GET("/board", wsHandler.HandleBoardWebSocket)
+			// 하나의 연결로 chat/board/company/user 토픽 구독
+			wsGroup.GET("/v2", wsHandler.HandleWebSocketV2)
+		}
+
+		// 토큰 검증용 공개키 (NATS 컨슈머 등 다른 서비스에서 사용)
+		r.GET("/.well-known/jwks.json", authHandler.GetJWKS)
+
+		//! 로컬 개발용 SSO IdP (운영에서는 사용 금지)
+		if os.Getenv("SSO_STUB_IDP") == "true" {
+			mountStubIdP(r)
 		}
 
 		api := r.Group("/api")
@@ -162,6 +230,15 @@ func startServer() {
 			publicRoute.GET("user/validate-email", userHandler.ValidateEmail)
 			publicRoute.GET("user/validate-nickname", userHandler.ValidateNickname)
 			publicRoute.POST("auth/signin", authHandler.SignIn)
+			publicRoute.POST("auth/signin/2fa", authHandler.VerifySignInMfa)                            //! 로그인 2단계 인증
+			publicRoute.POST("auth/signin/2fa/enroll", authHandler.StartSignInTotpEnrollment)           //! 로그인 중 필수 2단계 인증 등록
+			publicRoute.POST("auth/signin/2fa/enroll/confirm", authHandler.ConfirmSignInTotpEnrollment) //! 로그인 중 필수 2단계 인증 등록 완료
+			publicRoute.POST("auth/email/verify", authHandler.VerifyEmail)                              //! 이메일 인증 완료
+			publicRoute.POST("auth/email/verify/resend", authHandler.ResendVerificationEmail)           //! 인증 메일 재발송
+			publicRoute.POST("auth/password/forgot", authHandler.ForgotPassword)                        //! 비밀번호 재설정 메일 요청
+			publicRoute.POST("auth/password/reset", authHandler.ResetPassword)                          //! 비밀번호 재설정
+			publicRoute.GET("auth/sso/:companyid/start", ssoHandler.StartSso)                           //! 회사 SSO 로그인 시작 (IdP로 이동)
+			publicRoute.GET("auth/sso/callback", ssoHandler.SsoCallback)                                //! IdP 로그인 완료 콜백
 			publicRoute.GET("company/list", companyHandler.GetAllCompanies)
 			publicRoute.GET("company/:id", companyHandler.GetCompanyInfo)
 			publicRoute.POST("company/search", companyHandler.SearchCompany)
@@ -172,24 +249,54 @@ func startServer() {
 		//, tokenInterceptor.RefreshTokenInterceptor() accessToken 재발급 인터셉터 제거 -> accessToken 재발급 기능 따로 구현 (필요해지면 다시 사용)
 		{
 
-			auth := protectedRoute.Group("auth")
+			auth := protectedRoute.Group("auth", tokenInterceptor.RequireUserSession())
 			{
-				auth.POST("/signout", authHandler.SignOut) //완료되면 모든 로그 찍기
+				auth.POST("/signout", authHandler.SignOut)                            //완료되면 모든 로그 찍기
+				auth.GET("/session/list", authHandler.GetSessions)                    //! 로그인 세션 목록
+				auth.DELETE("/session/:sessionid", authHandler.RevokeSession)         //! 특정 세션 폐기
+				auth.DELETE("/session", authHandler.RevokeAllSessions)                //! 모든 세션 폐기
+				auth.POST("/2fa/enroll", authHandler.StartTotpEnrollment)             //! 2단계 인증 등록
+				auth.POST("/2fa/enroll/confirm", authHandler.ConfirmTotpEnrollment)   //! 2단계 인증 등록 완료
+				auth.DELETE("/2fa", authHandler.DisableTotp)                          //! 2단계 인증 해제
+				auth.POST("/2fa/recovery-codes", authHandler.RegenerateRecoveryCodes) //! 복구 코드 재발급
+
+				//TODO 개인 액세스 토큰 (연동/봇용)
+				auth.GET("/token/scopes", accessTokenHandler.GetScopes)
+				auth.GET("/token/list", accessTokenHandler.GetAccessTokens)
+				auth.POST("/token", accessTokenHandler.CreateAccessToken)
+				auth.DELETE("/token/:tokenid", accessTokenHandler.RevokeAccessToken)
+				auth.GET("/token/:tokenid/audit", accessTokenHandler.GetAccessTokenAudits)
 			}
 
-			chat := protectedRoute.Group("chat")
+			chat := protectedRoute.Group("chat", tokenInterceptor.RequireScope(_accessTokenEntity.ScopeChatRead, _accessTokenEntity.ScopeChatWrite))
 			{
 				//! 채팅방 관련 핸들러
 				chat.GET("/list", chatHandler.GetChatRoomList)
+				chat.GET("/search", chatHandler.SearchChatMessages) // 참여중인 채팅방 메시지 검색
 				chat.GET("/:chatroomid", chatHandler.GetChatRoomById)
 				chat.DELETE("/:chatroomid", chatHandler.LeaveChatRoom) //! 채팅방 나가기
 				chat.POST("", chatHandler.CreateChatRoom)
 				chat.GET("/:chatroomid/messages", chatHandler.GetChatMessages)
 				chat.DELETE("/messages", chatHandler.DeleteChatMessage) //! 채팅 메시지 삭제
+				chat.POST("/:chatroomid/messages", params.ChatFileMiddleware.ChatAttachmentUploadMiddleware(), chatHandler.SendChatMessage)
+				chat.PUT("/messages", chatHandler.EditChatMessage)
+				chat.GET("/:chatroomid/messages/:messageid/history", chatHandler.GetChatMessageHistory)
+				chat.POST("/messages/reaction", chatHandler.AddChatReaction)
+				chat.DELETE("/messages/reaction", chatHandler.RemoveChatReaction)
+				chat.PATCH("/:chatroomid/name", chatHandler.RenameChatRoom)
+				chat.PATCH("/:chatroomid/alias", chatHandler.UpdateChatRoomAlias) // 본인에게만 보이는 채팅방 이름
+				chat.POST("/:chatroomid/members", chatHandler.InviteChatRoomMembers)
+				chat.DELETE("/:chatroomid/members/:userid", chatHandler.RemoveChatRoomMember) //! 채팅방 내보내기
+				chat.PUT("/:chatroomid/owner", chatHandler.TransferChatRoomOwner)
+				chat.PUT("/:chatroomid/members/:userid/role", chatHandler.UpdateChatRoomMemberRole)
+				chat.GET("/:chatroomid/pins", chatHandler.GetPinnedChatMessages)
+				chat.POST("/messages/pin", chatHandler.PinChatMessage)
+				chat.DELETE("/messages/pin", chatHandler.UnpinChatMessage)
+				chat.POST("/announcement", tokenInterceptor.RequireRole(entity.RoleCompanySubManager), chatHandler.CreateAnnouncementChatRoom) // 회사 공지 채팅방
 
 				// chat.GET("/:id", chatHandler.GetChatRoom) // 채팅방 정보
 			}
-			user := protectedRoute.Group("user")
+			user := protectedRoute.Group("user", tokenInterceptor.RequireScope(_accessTokenEntity.ScopeUserRead, _accessTokenEntity.ScopeUserWrite))
 			{
 				user.GET("/:id", userHandler.GetUserInfo)
 				user.PUT("/:id", params.ProfileImageMiddleware.ProfileImageUploadMiddleware(), userHandler.UpdateUserInfo)
@@ -199,7 +306,7 @@ func startServer() {
 				// user.GET("/company/organization/:companyid", userHandler.GetOrganizationByCompany)
 			}
 
-			company := protectedRoute.Group("company")
+			company := protectedRoute.Group("company", tokenInterceptor.RequireScope(_accessTokenEntity.ScopeCompanyRead, _accessTokenEntity.ScopeCompanyWrite), tokenInterceptor.RequireCompanyMember())
 			{
 				company.POST("/invite", companyHandler.InviteUserToCompany)
 				company.GET("/search", userHandler.SearchUser)
@@ -213,8 +320,24 @@ func startServer() {
 				company.POST("/position", companyHandler.CreateCompanyPosition)
 				company.DELETE("/position/:positionid", companyHandler.DeleteCompanyPosition)
 				company.PUT("/position/:positionid", companyHandler.UpdateCompanyPosition)
+
+				//TODO 회사 SSO 설정 - 회사 관리자 이상
+				company.GET("/sso", tokenInterceptor.RequireRole(entity.RoleCompanyManager), ssoHandler.GetCompanySso)
+				company.PUT("/sso", tokenInterceptor.RequireRole(entity.RoleCompanyManager), ssoHandler.UpdateCompanySso)
+				company.DELETE("/sso", tokenInterceptor.RequireRole(entity.RoleCompanyManager), ssoHandler.DeleteCompanySso)
+
+				//TODO 회사 채팅 보관 정책, 법적 보존 조치, 대화 내보내기 - 회사 관리자 이상
+				company.GET("/chat/retention", tokenInterceptor.RequireRole(entity.RoleCompanyManager), chatRetentionHandler.GetChatRetention)
+				company.PUT("/chat/retention", tokenInterceptor.RequireRole(entity.RoleCompanyManager), chatRetentionHandler.UpdateChatRetention)
+				company.GET("/chat/hold", tokenInterceptor.RequireRole(entity.RoleCompanyManager), chatRetentionHandler.GetChatLegalHolds)
+				company.POST("/chat/hold", tokenInterceptor.RequireRole(entity.RoleCompanyManager), chatRetentionHandler.CreateChatLegalHold)
+				company.DELETE("/chat/hold/:holdid", tokenInterceptor.RequireRole(entity.RoleCompanyManager), chatRetentionHandler.ReleaseChatLegalHold)
+				company.GET("/chat/:chatroomid/export", tokenInterceptor.RequireRole(entity.RoleCompanyManager), chatRetentionHandler.ExportChatMessages)
+
+				//TODO 소속 사용자(봇 계정 등) 액세스 토큰 발급 - 회사 관리자 이상
+				company.POST("/token", tokenInterceptor.RequireUserSession(), tokenInterceptor.RequireRole(entity.RoleCompanyManager), accessTokenHandler.CreateUserAccessToken)
 			}
-			department := protectedRoute.Group("department")
+			department := protectedRoute.Group("department", tokenInterceptor.RequireScope(_accessTokenEntity.ScopeCompanyRead, _accessTokenEntity.ScopeCompanyWrite), tokenInterceptor.RequireCompanyMember())
 			{
 				department.POST("", departmentHandler.CreateDepartment)
 				department.GET("/list", departmentHandler.GetDepartments)
@@ -224,7 +347,7 @@ func startServer() {
 				department.POST("/invite", departmentHandler.InviteUserToDepartment)
 			}
 
-			notification := protectedRoute.Group("notification")
+			notification := protectedRoute.Group("notification", tokenInterceptor.RequireScope(_accessTokenEntity.ScopeNotificationRead, _accessTokenEntity.ScopeNotificationWrite))
 			{
 				notification.POST("/mention", notificationHandler.SendMentionNotification)
 				notification.GET("/list", notificationHandler.GetNotifications)
@@ -232,7 +355,7 @@ func startServer() {
 				notification.PUT("/:docId", notificationHandler.UpdateNotificationReadStatus)          //! 알림 읽음 처리
 			}
 
-			post := protectedRoute.Group("post")
+			post := protectedRoute.Group("post", tokenInterceptor.RequireScope(_accessTokenEntity.ScopePostRead, _accessTokenEntity.ScopePostWrite))
 			{
 				post.POST("", params.PostImageMiddleware.PostImageUploadMiddleware(), postHandler.CreatePost)
 				post.GET("/list", postHandler.GetPosts)
@@ -244,7 +367,7 @@ func startServer() {
 			}
 
 			//TODO 댓글 관련 핸들러
-			comment := protectedRoute.Group("comment")
+			comment := protectedRoute.Group("comment", tokenInterceptor.RequireScope(_accessTokenEntity.ScopePostRead, _accessTokenEntity.ScopePostWrite))
 			{
 				comment.POST("", commentHandler.CreateComment)
 				comment.POST("/reply", commentHandler.CreateReply)
@@ -255,26 +378,31 @@ func startServer() {
 			}
 
 			//TODO admin 요청 - 관리자 페이지
-			admin := protectedRoute.Group("admin")
+			//TODO 액세스 토큰은 라우트마다 해당 관리 영역의 scope 필요
+			admin := protectedRoute.Group("admin", tokenInterceptor.RequireRole(entity.RoleSubAdmin))
+			adminUserScope := tokenInterceptor.RequireScope(_accessTokenEntity.ScopeAdminUser, _accessTokenEntity.ScopeAdminUser)
+			adminCompanyScope := tokenInterceptor.RequireScope(_accessTokenEntity.ScopeAdminCompany, _accessTokenEntity.ScopeAdminCompany)
+			adminReportScope := tokenInterceptor.RequireScope(_accessTokenEntity.ScopeAdminReport, _accessTokenEntity.ScopeAdminReport)
 			{
-				admin.POST("/signup", adminHandler.AdminCreateAdmin)
-				admin.POST("/company", params.ProfileImageMiddleware.CompanyImageUploadMiddleware(), adminHandler.AdminCreateCompany)
-				admin.PUT("/company", adminHandler.AdminUpdateCompany)
-				admin.DELETE("/company/:companyid", adminHandler.AdminDeleteCompany)
-				admin.GET("/user/list", adminHandler.AdminGetAllUsers)                     //TODO 전체 사용자 조회
-				admin.GET("/user/company/:companyid", adminHandler.AdminGetUsersByCompany) //TODO 회사 사용자 조회
-				admin.GET("/user/search", adminHandler.AdminSearchUser)
-				admin.POST("/user/company", adminHandler.AdminAddUserToCompany) //TODO 회사에 사용자 추가
-				admin.PUT("/user/role", adminHandler.AdminUpdateUserRole)
-				admin.PUT("/user/:userid", adminHandler.AdminUpdateUser)
-				admin.DELETE("/user/:userid", adminHandler.AdminRemoveUserFromCompany) //TODO 관리자 1,2,3 일반 사용자 회사에서 퇴출
-				admin.PUT("/user/:userid/status", adminHandler.AdminUpdateUserStatus)
+				admin.POST("/signup", adminUserScope, adminHandler.AdminCreateAdmin)
+				admin.POST("/company", adminCompanyScope, params.ProfileImageMiddleware.CompanyImageUploadMiddleware(), adminHandler.AdminCreateCompany)
+				admin.PUT("/company", adminCompanyScope, adminHandler.AdminUpdateCompany)
+				admin.DELETE("/company/:companyid", adminCompanyScope, adminHandler.AdminDeleteCompany)
+				admin.GET("/user/list", adminUserScope, adminHandler.AdminGetAllUsers)                     //TODO 전체 사용자 조회
+				admin.GET("/user/company/:companyid", adminUserScope, adminHandler.AdminGetUsersByCompany) //TODO 회사 사용자 조회
+				admin.GET("/user/search", adminUserScope, adminHandler.AdminSearchUser)
+				admin.POST("/user/company", adminUserScope, adminHandler.AdminAddUserToCompany) //TODO 회사에 사용자 추가
+				admin.PUT("/user/role", adminUserScope, adminHandler.AdminUpdateUserRole)
+				admin.PUT("/user/:userid", adminUserScope, adminHandler.AdminUpdateUser)
+				admin.DELETE("/user/:userid", adminUserScope, adminHandler.AdminRemoveUserFromCompany) //TODO 관리자 1,2,3 일반 사용자 회사에서 퇴출
+				admin.PUT("/user/:userid/status", adminUserScope, adminHandler.AdminUpdateUserStatus)
+				admin.DELETE("/user/:userid/session", adminUserScope, adminHandler.AdminForceLogoutUser) //! 강제 로그아웃
 
 				//TODO 부서 관련 핸들러
-				admin.POST("/department", adminHandler.AdminCreateDepartment)
-				admin.PUT("/department/:companyid/:departmentid", adminHandler.AdminUpdateDepartment)
-				admin.DELETE("/department/:companyid/:departmentid", adminHandler.AdminDeleteDepartment)
-				admin.GET("/department/list/:companyid", adminHandler.GetDepartments)
+				admin.POST("/department", adminCompanyScope, adminHandler.AdminCreateDepartment)
+				admin.PUT("/department/:companyid/:departmentid", adminCompanyScope, adminHandler.AdminUpdateDepartment)
+				admin.DELETE("/department/:companyid/:departmentid", adminCompanyScope, adminHandler.AdminDeleteDepartment)
+				admin.GET("/department/list/:companyid", adminCompanyScope, adminHandler.GetDepartments)
 				// admin.GET("/department/:departmentid", adminHandler.GetDepartment)
 
 				//TODO 리포트 관련 핸들러
@@ -282,13 +410,13 @@ func startServer() {
 				//TODO 신고 상세 보기
 
 				//TODO 사용자별 신고 리스트 조회
-				admin.GET("/report/user/:userid", adminHandler.AdminGetReportsByUser)
+				admin.GET("/report/user/:userid", adminReportScope, adminHandler.AdminGetReportsByUser)
 				//TODO 유저 제재 처리
 
 			}
 
 			//TODO 좋아요 관련 핸들러
-			like := protectedRoute.Group("like")
+			like := protectedRoute.Group("like", tokenInterceptor.RequireScope(_accessTokenEntity.ScopePostRead, _accessTokenEntity.ScopePostWrite))
 			{
 				like.POST("/post", likeHandler.CreatePostLike)                    //! 게시물 이모지 좋아요
 				like.GET("/post/list/:postid", likeHandler.GetPostLikeList)       //! 게시글 좋아요
@@ -297,7 +425,7 @@ func startServer() {
 				like.DELETE("/comment/:commentid", likeHandler.DeleteCommentLike) //! 댓글 대댓글 좋아요 취소
 			}
 
-			project := protectedRoute.Group("project")
+			project := protectedRoute.Group("project", tokenInterceptor.RequireScope(_accessTokenEntity.ScopeProjectRead, _accessTokenEntity.ScopeProjectWrite))
 			{
 				project.POST("", projectHandler.CreateProject)
 				project.GET("", projectHandler.GetProjects)
@@ -310,7 +438,7 @@ func startServer() {
 				project.DELETE("/:projectid/role/:userid", projectHandler.DeleteProjectUser)
 			}
 
-			board := protectedRoute.Group("board")
+			board := protectedRoute.Group("board", tokenInterceptor.RequireScope(_accessTokenEntity.ScopeBoardRead, _accessTokenEntity.ScopeBoardWrite))
 			{
 				board.POST("", boardHandler.CreateBoard)
 				board.GET("/:boardid", boardHandler.GetBoard)
@@ -319,23 +447,34 @@ func startServer() {
 				board.DELETE("/:boardid", boardHandler.DeleteBoard)
 				board.POST("/:projectid/:boardid/snapshots", boardHandler.AutoSaveBoard)
 				board.GET("/:boardid/all", boardHandler.GetKanbanBoard)
+				board.GET("/:boardid/activity", boardHandler.GetBoardActivities)
+				board.GET("/card/:cardid/activity", boardHandler.GetCardActivities)
+				board.POST("/:projectid/:boardid/attachments", params.BoardFileMiddleware.BoardAttachmentUploadMiddleware(), boardHandler.UploadBoardAttachments)
+				board.POST("/:projectid/:boardid/template", boardHandler.SaveBoardAsTemplate)
+				board.POST("/:projectid/:boardid/copy", boardHandler.CopyBoard)
+				board.GET("/template", boardHandler.GetBoardTemplates)
+				board.GET("/template/:templateid", boardHandler.GetBoardTemplate)
+				board.POST("/template", boardHandler.CreateBoardTemplate)
+				board.DELETE("/template/:templateid", boardHandler.DeleteBoardTemplate)
 			}
 
-			stat := protectedRoute.Group("stat")
+			stat := protectedRoute.Group("stat", tokenInterceptor.RequireScope(_accessTokenEntity.ScopeStatRead, _accessTokenEntity.ScopeStatRead))
 			{
 				stat.GET("/user/role", statHandler.GetUserRoleStat)
 				stat.GET("/post/today", statHandler.GetTodayPostStat)
-				stat.GET("/company/user/online", statHandler.GetCurrentCompanyOnlineUsers)
+				stat.GET("/company/user/online", tokenInterceptor.RequireCompanyMember(), statHandler.GetCurrentCompanyOnlineUsers)
 				stat.GET("/user/online", statHandler.GetAllUsersOnlineCount)
-				stat.GET("/system/resource", statHandler.GetSystemResourceInfo)
+				stat.GET("/system/resource", tokenInterceptor.RequireRole(entity.RoleSubAdmin), statHandler.GetSystemResourceInfo)
 				//회사의 월별 게시글 (월별 게시글 수, 월별 좋아요 수, 월별 댓글 수)
 				stat.GET("/post/popular", statHandler.GetPopularPostStat)
+				stat.GET("/post/view/flush", statHandler.GetPostViewFlushStat) //조회수 DB 반영 워커 상태
+				stat.GET("/ws/drop", tokenInterceptor.RequireRole(entity.RoleSubAdmin), statHandler.GetWebSocketDropStat)
 				//회사 주간 게시글
 				//내가 쓴 게시글
 				//활동 로그
 			}
 
-			report := protectedRoute.Group("report")
+			report := protectedRoute.Group("report", tokenInterceptor.RequireScope(_accessTokenEntity.ScopeReportRead, _accessTokenEntity.ScopeReportWrite))
 			{
 				report.POST("", reportHandler.CreateReport)
 				report.GET("/list", reportHandler.GetReports)
@@ -348,11 +487,25 @@ func startServer() {
 	}
 
 	// HTTP 서버 시작
-	log.Printf("HTTP 서버 실행중: %s", cfg.HTTPPort)
-	if err := r.Run(cfg.HTTPPort); err != nil {
-		log.Fatalf("HTTP 서버 시작 실패: %v", err)
-	}
+	srv := &http.Server{Addr: cfg.HTTPPort, Handler: r}
+	go func() {
+		log.Printf("HTTP 서버 실행중: %s", cfg.HTTPPort)
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("HTTP 서버 시작 실패: %v", err)
+		}
+	}()
 
+	<-ctx.Done()
+	log.Println("종료 신호 수신 - 서버 종료중")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		log.Printf("HTTP 서버 종료 실패: %v", err)
+	}
+	// 워커가 마지막 반영을 끝낼 때까지 대기
+	workers.Wait()
+	log.Println("서버 종료 완료")
 }
 
 func main() {
@@ -7,11 +7,14 @@ import (
 	"link/infrastructure/persistence"
 	"link/pkg/http"
 	"link/pkg/interceptor"
+	"link/pkg/mailer"
 	"link/pkg/middleware"
+	"link/pkg/sso"
 	"link/pkg/ws"
 
 	// 새로 추가
 
+	accessTokenUsecase "link/internal/accesstoken/usecase"
 	adminUsecase "link/internal/admin/usecase"
 	authUsecase "link/internal/auth/usecase"
 	boardUsecase "link/internal/board/usecase"
@@ -24,6 +27,7 @@ import (
 	postUsecase "link/internal/post/usecase"
 	projectUsecase "link/internal/project/usecase"
 	reportUsecase "link/internal/report/usecase"
+	ssoUsecase "link/internal/sso/usecase"
 	statUsecase "link/internal/stat/usecase"
 	userUsecase "link/internal/user/usecase"
 	_nats "link/pkg/nats"
@@ -54,6 +58,12 @@ func BuildContainer(db *gorm.DB,
 	container.Provide(ws.NewWebSocketHub)
 	container.Provide(ws.NewWsHandler)
 
+	//메일 발송 주입
+	container.Provide(mailer.NewMailer)
+
+	//SSO(OIDC) 클라이언트 주입
+	container.Provide(sso.NewOidcClient)
+
 	//인터셉터 주입
 	container.Provide(interceptor.NewTokenInterceptor)
 
@@ -67,6 +77,14 @@ func BuildContainer(db *gorm.DB,
 		return middleware.NewImageUploadMiddleware("./static/posts", "/static/posts")
 	}, dig.Name("postImageMiddleware"))
 
+	container.Provide(func() *middleware.FileUploadMiddleware {
+		return middleware.NewFileUploadMiddleware("./static/chats", "/static/chats")
+	})
+
+	container.Provide(func() *middleware.FileUploadMiddleware {
+		return middleware.NewFileUploadMiddleware("./static/boards", "/static/boards")
+	}, dig.Name("boardFileMiddleware"))
+
 	// Repository 계층 등록
 	container.Provide(persistence.NewAuthPersistence)
 	container.Provide(persistence.NewUserPersistence)
@@ -81,6 +99,8 @@ func BuildContainer(db *gorm.DB,
 	container.Provide(persistence.NewReportPersistence)
 	container.Provide(persistence.NewProjectPersistence)
 	container.Provide(persistence.NewBoardPersistence)
+	container.Provide(persistence.NewSsoPersistence)
+	container.Provide(persistence.NewAccessTokenPersistence)
 	// Usecase 계층 등록
 	container.Provide(authUsecase.NewAuthUsecase)
 	container.Provide(userUsecase.NewUserUsecase)
@@ -96,6 +116,13 @@ func BuildContainer(db *gorm.DB,
 	container.Provide(reportUsecase.NewReportUsecase)
 	container.Provide(projectUsecase.NewProjectUsecase)
 	container.Provide(boardUsecase.NewBoardUsecase)
+	container.Provide(boardUsecase.NewCardDocumentUsecase)
+	container.Provide(boardUsecase.NewCardDocumentSnapshotter)
+	container.Provide(ssoUsecase.NewSsoUsecase)
+	container.Provide(accessTokenUsecase.NewAccessTokenUsecase)
+	container.Provide(postUsecase.NewPostViewFlusher)
+	container.Provide(chatUsecase.NewChatRetentionUsecase)
+	container.Provide(chatUsecase.NewChatRetentionPurger)
 	// Handler 계층 등록
 	container.Provide(http.NewUserHandler)
 	container.Provide(http.NewAuthHandler)
@@ -111,6 +138,9 @@ func BuildContainer(db *gorm.DB,
 	container.Provide(http.NewReportHandler)
 	container.Provide(http.NewProjectHandler)
 	container.Provide(http.NewBoardHandler)
+	container.Provide(http.NewSsoHandler)
+	container.Provide(http.NewAccessTokenHandler)
+	container.Provide(http.NewChatRetentionHandler)
 	container.Provide(ws.NewWebSocketHub)
 
 	return container
@@ -7,6 +7,9 @@ import (
 	"time"
 
 	"github.com/go-redis/redis/v8"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"gorm.io/gorm"
 
 	"link/infrastructure/model"
@@ -123,19 +126,37 @@ func AutoMigrate(db *gorm.DB) {
 		&model.Department{},
 		&model.ChatRoom{},
 		&model.ChatRoomUser{},
+		&model.ChatRetentionPolicy{},
+		&model.ChatLegalHold{},
 		&model.Post{},
 		&model.PostImage{},
 		&model.Comment{},
 		&model.Like{},
 		&model.Company{},
 		&model.Position{},
+		&model.CompanySso{},
+		&model.PersonalAccessToken{},
+		&model.PersonalAccessTokenAudit{},
 		&model.Project{},
 		&model.ProjectUser{},
 		&model.Board{},
 		&model.BoardUser{},
 		&model.BoardColumn{},
 		&model.BoardCard{},
+		&model.BoardCardSnapshot{},
 		&model.CardAssignee{},
+		&model.BoardLabel{},
+		&model.CardLabel{},
+		&model.BoardCardComment{},
+		&model.CardCommentMention{},
+		&model.BoardCardChecklist{},
+		&model.BoardCardChecklistItem{},
+		&model.BoardCardAttachment{},
+		&model.BoardTemplate{},
+		&model.BoardTemplateColumn{},
+		&model.BoardTemplateLabel{},
+		&model.BoardTemplateCard{},
+		&model.BoardTemplateCardLabel{},
 	); err != nil {
 		log.Fatalf("마이그레이션 실패: %v", err)
 	}
@@ -149,6 +170,71 @@ func AutoMigrate(db *gorm.DB) {
 	if err := db.Exec("CREATE INDEX IF NOT EXISTS idx_companies_cp_name ON companies USING gin(to_tsvector('simple', cp_name))").Error; err != nil {
 		log.Fatalf("GIN 인덱스 생성 중 오류 발생: %v", err)
 	}
+
+	//TODO 역할 추가 전에 만든 그룹 채팅방은 가장 먼저 참여한 사용자를 방장으로 지정
+	if err := db.Exec(`
+		UPDATE chat_room_users SET role = 'owner'
+		FROM (
+			SELECT DISTINCT ON (cru.chat_room_id) cru.chat_room_id, cru.user_id
+			FROM chat_room_users cru
+			JOIN chat_rooms cr ON cr.id = cru.chat_room_id
+			WHERE cr.is_private = false AND cru.left_at IS NULL
+			AND NOT EXISTS (
+				SELECT 1 FROM chat_room_users owner
+				WHERE owner.chat_room_id = cru.chat_room_id AND owner.role = 'owner' AND owner.left_at IS NULL
+			)
+			ORDER BY cru.chat_room_id, cru.joined_at
+		) first_member
+		WHERE chat_room_users.chat_room_id = first_member.chat_room_id AND chat_room_users.user_id = first_member.user_id
+	`).Error; err != nil {
+		log.Printf("그룹 채팅방 방장 지정 중 오류 발생: %v", err)
+	}
+}
+
+// TODO 몽고DB 인덱스 생성 (이미 있으면 무시)
+func InitMongoIndexes(client *mongo.Client) {
+	messages := client.Database("link").Collection("messages")
+
+	_, err := messages.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		// 채팅방 메시지 페이지 조회
+		{
+			Keys:    bson.D{{Key: "chat_room_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("messages_chat_room_id_created_at"),
+		},
+		// 채팅방 고정 메시지 - 고정된 메시지만 색인
+		{
+			Keys:    bson.D{{Key: "chat_room_id", Value: 1}, {Key: "pinned_at", Value: -1}},
+			Options: options.Index().SetName("messages_chat_room_id_pinned_at").SetPartialFilterExpression(bson.M{"pinned_at": bson.M{"$exists": true}}),
+		},
+	})
+	if err != nil {
+		log.Printf("몽고DB 인덱스 생성 중 오류 발생: %v", err)
+		return
+	}
+
+	activities := client.Database("link").Collection("board_activities")
+	_, err = activities.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		// 보드 활동 페이지 조회 (커서는 _id)
+		{
+			Keys:    bson.D{{Key: "board_id", Value: 1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("board_activities_board_id_id"),
+		},
+		// 카드 활동 조회 - 카드 활동만 색인
+		{
+			Keys:    bson.D{{Key: "card_id", Value: 1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("board_activities_card_id_id").SetPartialFilterExpression(bson.M{"card_id": bson.M{"$exists": true}}),
+		},
+		// 작업자 필터
+		{
+			Keys:    bson.D{{Key: "board_id", Value: 1}, {Key: "user_id", Value: 1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("board_activities_board_id_user_id_id"),
+		},
+	})
+	if err != nil {
+		log.Printf("몽고DB 인덱스 생성 중 오류 발생: %v", err)
+		return
+	}
+	log.Println("몽고DB 인덱스 생성 완료")
 }
 
 // TODO 레디스 사용자 정보 초기화
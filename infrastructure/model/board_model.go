@@ -35,26 +35,30 @@ type BoardColumn struct {
 	BoardID   uint      `gorm:"not null;index"`
 	Board     Board     `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
 	Position  uint      `gorm:"not null;"`
+	WipLimit  uint      `gorm:"not null;default:0"` // 진행 중 카드 수 제한 (0이면 제한 없음)
+	Version   int       `gorm:"not null;default:0"`
 	CreatedAt time.Time `gorm:"autoCreateTime"`
 	UpdatedAt time.Time `gorm:"autoUpdateTime"`
 }
 
 // BoardCard (카드 테이블)
 type BoardCard struct {
-	ID            uuid.UUID      `gorm:"primaryKey;type:uuid"`
-	Name          string         `gorm:"not null"`
-	Content       string         `gorm:"type:text"`
-	BoardID       uint           `gorm:"not null;index"` //  인덱스 추가
-	Board         Board          `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
-	BoardColumnID uuid.UUID      `gorm:"not null;index"` //  인덱스 추가
-	BoardColumn   BoardColumn    `gorm:"foreignKey:BoardColumnID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
-	Position      uint           `gorm:"not null;"`
-	StartDate     time.Time      `gorm:"not null"`
-	EndDate       time.Time      `gorm:"not null"`
-	Version       int            `gorm:"not null;"`
-	CreatedAt     time.Time      `gorm:"autoCreateTime"`
-	UpdatedAt     time.Time      `gorm:"autoUpdateTime"`
-	Assignees     []CardAssignee `gorm:"foreignKey:CardID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	ID            uuid.UUID   `gorm:"primaryKey;type:uuid"`
+	Name          string      `gorm:"not null"`
+	Content       string      `gorm:"type:text"`
+	BoardID       uint        `gorm:"not null;index"` //  인덱스 추가
+	Board         Board       `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	BoardColumnID uuid.UUID   `gorm:"not null;index"` //  인덱스 추가
+	BoardColumn   BoardColumn `gorm:"foreignKey:BoardColumnID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	Position      uint        `gorm:"not null;"`
+	StartDate     time.Time   `gorm:"not null"`
+	EndDate       time.Time   `gorm:"not null"`
+	Version       int         `gorm:"not null;"`
+	// 내용 버전 - 자동 저장 내용 수정과 협업 편집 스냅샷마다 증가 (스냅샷은 카드 버전을 올리지 않음)
+	ContentVersion int            `gorm:"not null;default:0"`
+	CreatedAt      time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time      `gorm:"autoUpdateTime"`
+	Assignees      []CardAssignee `gorm:"foreignKey:CardID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
 }
 
 // CardAssignee (카드 담당자 - 다대다 관계)
@@ -65,15 +69,178 @@ type CardAssignee struct {
 	User   User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
 }
 
-// 얘는 몽고 디비에 저장해야 함
+// BoardLabel 보드 라벨 (보드 단위로 관리, 카드에 여러 개 지정)
+type BoardLabel struct {
+	ID        uuid.UUID `gorm:"primaryKey;type:uuid"`
+	BoardID   uint      `gorm:"not null;index"`
+	Board     Board     `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	Name      string    `gorm:"not null"`
+	Color     string    `gorm:"not null"` // #RRGGBB
+	Version   int       `gorm:"not null;default:0"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+// CardLabel (카드 라벨 - 다대다 관계)
+type CardLabel struct {
+	CardID  uuid.UUID  `gorm:"primaryKey;type:uuid"`
+	LabelID uuid.UUID  `gorm:"primaryKey;type:uuid;index"`
+	Card    BoardCard  `gorm:"foreignKey:CardID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	Label   BoardLabel `gorm:"foreignKey:LabelID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+}
+
+// BoardCardComment 카드 댓글 (ParentID가 있으면 답글, 답글의 답글은 없음)
+type BoardCardComment struct {
+	ID        uuid.UUID            `gorm:"primaryKey;type:uuid"`
+	CardID    uuid.UUID            `gorm:"type:uuid;not null;index"`
+	Card      BoardCard            `gorm:"foreignKey:CardID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	BoardID   uint                 `gorm:"not null;index"`
+	ParentID  *uuid.UUID           `gorm:"type:uuid;index"`
+	UserID    uint                 `gorm:"not null"`
+	User      User                 `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	Content   string               `gorm:"type:text;not null"`
+	Version   int                  `gorm:"not null;default:0"`
+	CreatedAt time.Time            `gorm:"autoCreateTime"`
+	UpdatedAt time.Time            `gorm:"autoUpdateTime"`
+	Mentions  []CardCommentMention `gorm:"foreignKey:CommentID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+}
+
+// CardCommentMention (댓글에서 @멘션한 사용자)
+type CardCommentMention struct {
+	CommentID uuid.UUID `gorm:"primaryKey;type:uuid"`
+	UserID    uint      `gorm:"primaryKey"`
+	User      User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+}
+
+// BoardCardChecklist 카드 체크리스트 (카드 안에서 Position 순서)
+type BoardCardChecklist struct {
+	ID        uuid.UUID                `gorm:"primaryKey;type:uuid"`
+	CardID    uuid.UUID                `gorm:"type:uuid;not null;index"`
+	Card      BoardCard                `gorm:"foreignKey:CardID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	BoardID   uint                     `gorm:"not null;index"`
+	Name      string                   `gorm:"not null"`
+	Position  uint                     `gorm:"not null"`
+	Version   int                      `gorm:"not null;default:0"`
+	CreatedAt time.Time                `gorm:"autoCreateTime"`
+	UpdatedAt time.Time                `gorm:"autoUpdateTime"`
+	Items     []BoardCardChecklistItem `gorm:"foreignKey:ChecklistID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+}
+
+// BoardCardChecklistItem 체크리스트 항목 (체크리스트 안에서 Position 순서)
+type BoardCardChecklistItem struct {
+	ID          uuid.UUID `gorm:"primaryKey;type:uuid"`
+	ChecklistID uuid.UUID `gorm:"type:uuid;not null;index"`
+	CardID      uuid.UUID `gorm:"type:uuid;not null;index"`
+	BoardID     uint      `gorm:"not null;index"`
+	Content     string    `gorm:"not null"`
+	Checked     bool      `gorm:"not null;default:false"`
+	Position    uint      `gorm:"not null"`
+	Version     int       `gorm:"not null;default:0"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+}
+
+// BoardCardAttachment 카드 첨부 파일 (파일은 업로드 API로 먼저 올린 뒤 자동 저장으로 카드에 연결)
+type BoardCardAttachment struct {
+	ID          uuid.UUID `gorm:"primaryKey;type:uuid"`
+	CardID      uuid.UUID `gorm:"type:uuid;not null;index"`
+	Card        BoardCard `gorm:"foreignKey:CardID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	BoardID     uint      `gorm:"not null;index"`
+	URL         string    `gorm:"not null"`
+	Name        string    `gorm:"not null"`
+	Size        int64     `gorm:"not null"`
+	ContentType string
+	UploadedBy  uint      `gorm:"not null"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+}
+
+// BoardTemplate 회사 단위 보드 템플릿 (보드 생성 시 컬럼, 라벨, 예시 카드를 그대로 만들어 줌)
+type BoardTemplate struct {
+	ID          uint                  `gorm:"primaryKey;autoIncrement"`
+	CompanyID   uint                  `gorm:"not null;index"`
+	Company     Company               `gorm:"foreignKey:CompanyID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	Name        string                `gorm:"not null"`
+	Description string                `gorm:"type:text"`
+	CreatedBy   uint                  `gorm:"not null"`
+	CreatedAt   time.Time             `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time             `gorm:"autoUpdateTime"`
+	Columns     []BoardTemplateColumn `gorm:"foreignKey:TemplateID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	Labels      []BoardTemplateLabel  `gorm:"foreignKey:TemplateID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	Cards       []BoardTemplateCard   `gorm:"foreignKey:TemplateID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+}
+
+// BoardTemplateColumn 템플릿 컬럼 (템플릿 안에서 Position 순서)
+type BoardTemplateColumn struct {
+	ID         uuid.UUID `gorm:"primaryKey;type:uuid"`
+	TemplateID uint      `gorm:"not null;index"`
+	Name       string    `gorm:"not null"`
+	Position   uint      `gorm:"not null"`
+	WipLimit   uint      `gorm:"not null;default:0"`
+}
+
+// BoardTemplateLabel 템플릿 라벨
+type BoardTemplateLabel struct {
+	ID         uuid.UUID `gorm:"primaryKey;type:uuid"`
+	TemplateID uint      `gorm:"not null;index"`
+	Name       string    `gorm:"not null"`
+	Color      string    `gorm:"not null"` // #RRGGBB
+}
+
+// BoardTemplateCard 템플릿 예시 카드 (컬럼 안에서 Position 순서)
+type BoardTemplateCard struct {
+	ID         uuid.UUID                `gorm:"primaryKey;type:uuid"`
+	TemplateID uint                     `gorm:"not null;index"`
+	ColumnID   uuid.UUID                `gorm:"type:uuid;not null;index"`
+	Column     BoardTemplateColumn      `gorm:"foreignKey:ColumnID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	Name       string                   `gorm:"not null"`
+	Content    string                   `gorm:"type:text"`
+	Position   uint                     `gorm:"not null"`
+	Labels     []BoardTemplateCardLabel `gorm:"foreignKey:CardID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+}
+
+// BoardTemplateCardLabel (템플릿 카드 라벨 - 다대다 관계)
+type BoardTemplateCardLabel struct {
+	CardID  uuid.UUID          `gorm:"primaryKey;type:uuid"`
+	LabelID uuid.UUID          `gorm:"primaryKey;type:uuid;index"`
+	Label   BoardTemplateLabel `gorm:"foreignKey:LabelID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+}
+
+// BoardCardSnapshot 협업 편집 문서 스냅샷 (redis 문서를 주기적으로 저장, 카드별 최근 몇 개만 보관)
+type BoardCardSnapshot struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement"`
+	CardID    uuid.UUID `gorm:"type:uuid;not null;index:idx_board_card_snapshot_card_revision,priority:1"`
+	Card      BoardCard `gorm:"foreignKey:CardID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	Revision  int       `gorm:"not null;index:idx_board_card_snapshot_card_revision,priority:2"`
+	Content   string    `gorm:"type:text"`
+	UpdatedBy uint      `gorm:"not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// 보드 활동 기록 (몽고 디비 board_activities 컬렉션)
 type CardActivityLog struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty"`
-	UserID    uint               `bson:"user_id,omitempty"`
-	UserName  string             `bson:"user_name,omitempty"`
-	CardID    uint               `bson:"card_id,omitempty"`
-	ProjectID uint               `bson:"project_id,omitempty"`
-	BoardID   uint               `bson:"board_id,omitempty"`
-	Action    string             `bson:"action,omitempty"`
-	CreatedAt time.Time          `bson:"created_at,omitempty"`
-	UpdatedAt time.Time          `bson:"updated_at,omitempty"`
+	ID             primitive.ObjectID      `bson:"_id,omitempty"`
+	UserID         uint                    `bson:"user_id,omitempty"`
+	UserName       string                  `bson:"user_name,omitempty"`
+	ProjectID      uint                    `bson:"project_id,omitempty"`
+	BoardID        uint                    `bson:"board_id,omitempty"`
+	TargetType     string                  `bson:"target_type,omitempty"`
+	TargetID       string                  `bson:"target_id,omitempty"`
+	CardID         string                  `bson:"card_id,omitempty"`
+	ColumnID       string                  `bson:"column_id,omitempty"`
+	Name           string                  `bson:"name,omitempty"`
+	Action         string                  `bson:"action,omitempty"`
+	FromColumnID   string                  `bson:"from_column_id,omitempty"`
+	FromColumnName string                  `bson:"from_column_name,omitempty"`
+	ToColumnID     string                  `bson:"to_column_id,omitempty"`
+	ToColumnName   string                  `bson:"to_column_name,omitempty"`
+	FromPosition   *uint                   `bson:"from_position,omitempty"`
+	ToPosition     *uint                   `bson:"to_position,omitempty"`
+	Changes        []CardActivityChangeLog `bson:"changes,omitempty"`
+	CreatedAt      time.Time               `bson:"created_at,omitempty"`
+}
+
+type CardActivityChangeLog struct {
+	Field  string `bson:"field"`
+	Before string `bson:"before"`
+	After  string `bson:"after"`
 }
@@ -17,4 +17,32 @@ type Chat struct {
 	CreatedAt   time.Time          `json:"created_at" bson:"created_at"`
 	UnreadBy    []uint             `bson:"unread_by"`    // 아직 읽지 않은 사용자 ID 목록
 	UnreadCount int                `bson:"unread_count"` // 읽지 않은 사용자 수
+
+	ReplyTo     string           `json:"reply_to,omitempty" bson:"reply_to,omitempty"`         // 답장 대상 메시지 ID
+	Attachments []ChatAttachment `json:"attachments,omitempty" bson:"attachments,omitempty"`   // 첨부 파일
+	Reactions   []ChatReaction   `json:"reactions,omitempty" bson:"reactions,omitempty"`       // 이모지 반응
+	EditHistory []ChatEdit       `json:"edit_history,omitempty" bson:"edit_history,omitempty"` // 수정 전 내용 목록
+	EditedAt    *time.Time       `json:"edited_at,omitempty" bson:"edited_at,omitempty"`
+	PinnedBy    *uint            `json:"pinned_by,omitempty" bson:"pinned_by,omitempty"` // 고정한 사용자
+	PinnedAt    *time.Time       `json:"pinned_at,omitempty" bson:"pinned_at,omitempty"`
+}
+
+type ChatAttachment struct {
+	URL         string `json:"url" bson:"url"`
+	Name        string `json:"name" bson:"name"`
+	Size        int64  `json:"size" bson:"size"`
+	ContentType string `json:"content_type,omitempty" bson:"content_type,omitempty"`
+}
+
+// emojis 테이블(postgres)의 이모지를 참조
+type ChatReaction struct {
+	EmojiID uint   `json:"emoji_id" bson:"emoji_id"`
+	Unified string `json:"unified" bson:"unified"`
+	Content string `json:"content" bson:"content"`
+	UserIDs []uint `json:"user_ids" bson:"user_ids"`
+}
+
+type ChatEdit struct {
+	Content  string    `json:"content" bson:"content"`
+	EditedAt time.Time `json:"edited_at" bson:"edited_at"`
 }
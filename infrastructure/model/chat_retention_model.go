@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// 회사별 채팅 보관 정책 - 회사당 1개, 없으면 등급 기본값 사용
+type ChatRetentionPolicy struct {
+	ID            uint      `gorm:"primaryKey"`
+	CompanyID     uint      `gorm:"not null;uniqueIndex"`
+	Company       Company   `gorm:"foreignKey:CompanyID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	RetentionDays int       `gorm:"not null;default:0"` // 0이면 영구 보관
+	UpdatedBy     uint      `gorm:"not null"`
+	CreatedAt     time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// 법적 보존 조치 - 해제 전까지 보관 기간이 지나도 삭제하지 않음
+type ChatLegalHold struct {
+	ID         uint       `gorm:"primaryKey"`
+	CompanyID  uint       `gorm:"not null;index"`
+	Company    Company    `gorm:"foreignKey:CompanyID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	ChatRoomID *uint      `gorm:"index;default:null"` // 비어있으면 회사 전체 채팅방
+	Reason     string     `gorm:"type:varchar(255);not null"`
+	CreatedBy  uint       `gorm:"not null"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	ReleasedAt *time.Time `gorm:"default:null"`
+	ReleasedBy *uint      `gorm:"default:null"`
+}
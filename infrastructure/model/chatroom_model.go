@@ -10,4 +10,8 @@ type ChatRoom struct {
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 	ChatRoomUsers []ChatRoomUser `gorm:"foreignKey:ChatRoomID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"` // 중간 테이블을 통해 유저와 연결
+
+	//TODO 회사 공지 채팅방 - 회사 관리자만 작성, 회사 사용자는 모두 자동 참여
+	IsAnnouncement bool  `gorm:"not null;default:false"`
+	CompanyID      *uint `gorm:"index;default:null"`
 }
@@ -10,6 +10,8 @@ type ChatRoomUser struct {
 	LeftAt     time.Time `gorm:"default:null"`
 	//TODO 사용자별 채팅방 별칭 추가
 	ChatRoomAlias string `gorm:"default:''"`
+	// 그룹 채팅방 역할 (owner | admin | member)
+	Role string `gorm:"size:20;not null;default:'member'"`
 	// 관계 설정 belongsTo
 	User     *User     `gorm:"foreignKey:UserID;references:ID"`
 	ChatRoom *ChatRoom `gorm:"foreignKey:ChatRoomID;references:ID"`
@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// 회사별 SSO(IdP) 설정 - 회사당 1개
+type CompanySso struct {
+	ID              uint      `gorm:"primaryKey"`
+	CompanyID       uint      `gorm:"not null;uniqueIndex"`
+	Company         Company   `gorm:"foreignKey:CompanyID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	Protocol        string    `gorm:"type:varchar(20);not null;default:'oidc'"`
+	Issuer          string    `gorm:"type:varchar(255);not null"`
+	ClientID        string    `gorm:"type:varchar(255);not null"`
+	ClientSecret    string    `gorm:"type:varchar(255)"`
+	Scopes          string    `gorm:"type:varchar(255)"` // 공백 구분
+	EmailClaim      string    `gorm:"type:varchar(100);default:'email'"`
+	NameClaim       string    `gorm:"type:varchar(100);default:'name'"`
+	DepartmentClaim string    `gorm:"type:varchar(100)"`
+	PositionClaim   string    `gorm:"type:varchar(100)"`
+	AutoProvision   bool      `gorm:"default:false"`
+	AllowedDomains  string    `gorm:"type:varchar(500)"` // 공백 구분, JIT 생성 허용 이메일 도메인
+	Enabled         bool      `gorm:"default:true"`
+	CreatedAt       time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
@@ -0,0 +1,34 @@
+package model
+
+import "time"
+
+// 개인 액세스 토큰 (연동/봇용) - 원문은 발급 시 한 번만 응답하고 sha256 해시만 저장
+type PersonalAccessToken struct {
+	ID         uint       `gorm:"primaryKey"`
+	UserID     uint       `gorm:"not null;index"`
+	User       User       `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	CreatedBy  uint       `gorm:"not null;index"`
+	Name       string     `gorm:"type:varchar(100);not null"`
+	Prefix     string     `gorm:"type:varchar(30);not null"`
+	TokenHash  string     `gorm:"type:char(64);not null;uniqueIndex"`
+	Scopes     string     `gorm:"type:text;not null"` // 공백 구분
+	ExpiresAt  *time.Time `gorm:"index"`
+	LastUsedAt *time.Time
+	LastUsedIP string `gorm:"type:varchar(64)"`
+	RevokedAt  *time.Time
+	CreatedAt  time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// 개인 액세스 토큰 감사 로그 (생성/사용/거부/폐기)
+type PersonalAccessTokenAudit struct {
+	ID        uint      `gorm:"primaryKey"`
+	TokenID   uint      `gorm:"not null;index"`
+	UserID    uint      `gorm:"not null;index"`
+	ActorID   uint      `gorm:"not null"`
+	Action    string    `gorm:"type:varchar(20);not null"`
+	IP        string    `gorm:"type:varchar(64)"`
+	UserAgent string    `gorm:"type:varchar(255)"`
+	Detail    string    `gorm:"type:varchar(255)"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime;index"`
+}
@@ -16,18 +16,23 @@ const (
 
 // User 모델: 사용자 핵심 정보
 type User struct {
-	ID             uint           `json:"id" gorm:"primaryKey"`
-	Name           string         `json:"name" binding:"required" gorm:"not null"`
-	Email          string         `json:"email" binding:"required,email" gorm:"unique;not null"`
-	Nickname       string         `json:"nickname" binding:"required,nickname" gorm:"unique"`
-	Password       string         `json:"password" gorm:"not null"`
-	Phone          string         `json:"phone"`
-	Role           UserRole       `json:"role" binding:"required" gorm:"not null;default:5"`
-	Status         string         `json:"status" gorm:"not null;default:active"`
-	UserProfile    *UserProfile   `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"` // 1:1 관계 설정
-	CreatedAt      time.Time      `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt      time.Time      `json:"updated_at"`
-	ChatRoomsUsers []ChatRoomUser `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
-	ProjectUsers   []ProjectUser  `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
-	CardAssignees  []CardAssignee `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	ID       uint     `json:"id" gorm:"primaryKey"`
+	Name     string   `json:"name" binding:"required" gorm:"not null"`
+	Email    string   `json:"email" binding:"required,email" gorm:"unique;not null"`
+	Nickname string   `json:"nickname" binding:"required,nickname" gorm:"unique"`
+	Password string   `json:"password" gorm:"not null"`
+	Phone    string   `json:"phone"`
+	Role     UserRole `json:"role" binding:"required" gorm:"not null;default:5"`
+	Status   string   `json:"status" gorm:"not null;default:active"`
+	//TODO 2단계 인증(TOTP) - 복구 코드는 sha256 해시를 콤마로 연결해서 저장
+	TotpEnabled       bool           `json:"totp_enabled" gorm:"not null;default:false"`
+	TotpSecret        string         `json:"-"`
+	TotpRecoveryCodes string         `json:"-"`
+	TotpEnabledAt     *time.Time     `json:"totp_enabled_at"`
+	UserProfile       *UserProfile   `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"` // 1:1 관계 설정
+	CreatedAt         time.Time      `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	ChatRoomsUsers    []ChatRoomUser `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	ProjectUsers      []ProjectUser  `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
+	CardAssignees     []CardAssignee `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE;OnUpdate:CASCADE"`
 }
@@ -0,0 +1,196 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+
+	"link/infrastructure/model"
+	"link/internal/accesstoken/entity"
+	"link/internal/accesstoken/repository"
+)
+
+type accessTokenPersistence struct {
+	db          *gorm.DB
+	redisClient *redis.Client
+}
+
+func NewAccessTokenPersistence(db *gorm.DB, redisClient *redis.Client) repository.AccessTokenRepository {
+	return &accessTokenPersistence{db: db, redisClient: redisClient}
+}
+
+func accessTokenUsedKey(tokenId uint) string {
+	return fmt.Sprintf("auth:pat:used:%d", tokenId)
+}
+
+func toAccessTokenEntity(token *model.PersonalAccessToken) *entity.PersonalAccessToken {
+	return &entity.PersonalAccessToken{
+		ID:         token.ID,
+		UserID:     token.UserID,
+		UserEmail:  token.User.Email,
+		CreatedBy:  token.CreatedBy,
+		Name:       token.Name,
+		Prefix:     token.Prefix,
+		TokenHash:  token.TokenHash,
+		Scopes:     strings.Fields(token.Scopes),
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+		LastUsedIP: token.LastUsedIP,
+		RevokedAt:  token.RevokedAt,
+		CreatedAt:  token.CreatedAt,
+	}
+}
+
+func (r *accessTokenPersistence) CreateAccessToken(token *entity.PersonalAccessToken) error {
+	modelToken := &model.PersonalAccessToken{
+		UserID:    token.UserID,
+		CreatedBy: token.CreatedBy,
+		Name:      token.Name,
+		Prefix:    token.Prefix,
+		TokenHash: token.TokenHash,
+		Scopes:    strings.Join(token.Scopes, " "),
+		ExpiresAt: token.ExpiresAt,
+	}
+
+	if err := r.db.Omit("User").Create(modelToken).Error; err != nil {
+		log.Printf("액세스 토큰 생성 중 DB 오류: %v", err)
+		return fmt.Errorf("액세스 토큰 생성 중 DB 오류: %w", err)
+	}
+
+	token.ID = modelToken.ID
+	token.CreatedAt = modelToken.CreatedAt
+	return nil
+}
+
+// TODO 토큰 해시로 조회 (인증용) - 없으면 nil
+func (r *accessTokenPersistence) GetAccessTokenByHash(tokenHash string) (*entity.PersonalAccessToken, error) {
+	var token model.PersonalAccessToken
+	err := r.db.Preload("User", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id", "email")
+	}).Where("token_hash = ?", tokenHash).First(&token).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		log.Printf("액세스 토큰 조회 중 DB 오류: %v", err)
+		return nil, fmt.Errorf("액세스 토큰 조회 중 DB 오류: %w", err)
+	}
+	return toAccessTokenEntity(&token), nil
+}
+
+// TODO 토큰 ID로 조회 - 없으면 nil
+func (r *accessTokenPersistence) GetAccessTokenByID(tokenId uint) (*entity.PersonalAccessToken, error) {
+	var token model.PersonalAccessToken
+	if err := r.db.Where("id = ?", tokenId).First(&token).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		log.Printf("액세스 토큰 조회 중 DB 오류: %v", err)
+		return nil, fmt.Errorf("액세스 토큰 조회 중 DB 오류: %w", err)
+	}
+	return toAccessTokenEntity(&token), nil
+}
+
+func (r *accessTokenPersistence) GetAccessTokensByUser(userId uint) ([]*entity.PersonalAccessToken, error) {
+	var tokens []model.PersonalAccessToken
+	if err := r.db.Where("user_id = ? OR created_by = ?", userId, userId).
+		Order("created_at DESC").
+		Find(&tokens).Error; err != nil {
+		log.Printf("액세스 토큰 목록 조회 중 DB 오류: %v", err)
+		return nil, fmt.Errorf("액세스 토큰 목록 조회 중 DB 오류: %w", err)
+	}
+
+	result := make([]*entity.PersonalAccessToken, len(tokens))
+	for i := range tokens {
+		result[i] = toAccessTokenEntity(&tokens[i])
+	}
+	return result, nil
+}
+
+func (r *accessTokenPersistence) RevokeAccessToken(tokenId uint, revokedAt time.Time) error {
+	if err := r.db.Model(&model.PersonalAccessToken{}).
+		Where("id = ? AND revoked_at IS NULL", tokenId).
+		Update("revoked_at", revokedAt).Error; err != nil {
+		log.Printf("액세스 토큰 폐기 중 DB 오류: %v", err)
+		return fmt.Errorf("액세스 토큰 폐기 중 DB 오류: %w", err)
+	}
+	return nil
+}
+
+// TODO interval 안에 처음 사용한 경우에만 true (SETNX)
+func (r *accessTokenPersistence) TryMarkAccessTokenUsed(tokenId uint, interval time.Duration) (bool, error) {
+	ok, err := r.redisClient.SetNX(context.Background(), accessTokenUsedKey(tokenId), 1, interval).Result()
+	if err != nil {
+		log.Printf("액세스 토큰 사용 기록 오류: %v", err)
+		return false, fmt.Errorf("액세스 토큰 사용 기록 오류: %w", err)
+	}
+	return ok, nil
+}
+
+func (r *accessTokenPersistence) UpdateAccessTokenLastUsed(tokenId uint, usedAt time.Time, ip string) error {
+	if err := r.db.Model(&model.PersonalAccessToken{}).
+		Where("id = ?", tokenId).
+		Updates(map[string]interface{}{"last_used_at": usedAt, "last_used_ip": ip}).Error; err != nil {
+		log.Printf("액세스 토큰 사용 시각 업데이트 중 DB 오류: %v", err)
+		return fmt.Errorf("액세스 토큰 사용 시각 업데이트 중 DB 오류: %w", err)
+	}
+	return nil
+}
+
+func (r *accessTokenPersistence) CreateAccessTokenAudit(audit *entity.AccessTokenAudit) error {
+	modelAudit := &model.PersonalAccessTokenAudit{
+		TokenID:   audit.TokenID,
+		UserID:    audit.UserID,
+		ActorID:   audit.ActorID,
+		Action:    audit.Action,
+		IP:        audit.IP,
+		UserAgent: truncate(audit.UserAgent, 255),
+		Detail:    truncate(audit.Detail, 255),
+	}
+	if err := r.db.Create(modelAudit).Error; err != nil {
+		log.Printf("액세스 토큰 감사 로그 저장 중 DB 오류: %v", err)
+		return fmt.Errorf("액세스 토큰 감사 로그 저장 중 DB 오류: %w", err)
+	}
+	return nil
+}
+
+func (r *accessTokenPersistence) GetAccessTokenAudits(tokenId uint, limit int) ([]*entity.AccessTokenAudit, error) {
+	var audits []model.PersonalAccessTokenAudit
+	if err := r.db.Where("token_id = ?", tokenId).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&audits).Error; err != nil {
+		log.Printf("액세스 토큰 감사 로그 조회 중 DB 오류: %v", err)
+		return nil, fmt.Errorf("액세스 토큰 감사 로그 조회 중 DB 오류: %w", err)
+	}
+
+	result := make([]*entity.AccessTokenAudit, len(audits))
+	for i, audit := range audits {
+		result[i] = &entity.AccessTokenAudit{
+			ID:        audit.ID,
+			TokenID:   audit.TokenID,
+			UserID:    audit.UserID,
+			ActorID:   audit.ActorID,
+			Action:    audit.Action,
+			IP:        audit.IP,
+			UserAgent: audit.UserAgent,
+			Detail:    audit.Detail,
+			CreatedAt: audit.CreatedAt,
+		}
+	}
+	return result, nil
+}
+
+func truncate(value string, max int) string {
+	runes := []rune(value)
+	if len(runes) <= max {
+		return value
+	}
+	return string(runes[:max])
+}
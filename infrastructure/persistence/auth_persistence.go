@@ -4,13 +4,90 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
 
+	"link/internal/auth/entity"
 	"link/internal/auth/repository"
 )
 
+// 리프레시 토큰 회전 (compare-and-swap)
+// {1}: 회전 성공, {2, 후속 토큰}: 방금 회전된 토큰을 유예 시간 안에 다시 사용, {-1}: 이미 회전된 토큰 재사용, {0}: 세션 없음
+// 동시에 갱신한 탭/재시도 요청은 유예 시간 동안 같은 후속 토큰을 받음
+var rotateRefreshTokenScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return {0}
+end
+local current = redis.call("HGET", KEYS[1], "refresh_jti")
+if current ~= ARGV[1] then
+	local prev = redis.call("HGET", KEYS[1], "prev_refresh_jti")
+	local rotatedAt = tonumber(redis.call("HGET", KEYS[1], "prev_rotated_at") or "0")
+	if prev == ARGV[1] and tonumber(ARGV[5]) - rotatedAt <= tonumber(ARGV[6]) then
+		return {2, redis.call("HGET", KEYS[1], "successor_token")}
+	end
+	return {-1}
+end
+redis.call("HSET", KEYS[1], "refresh_jti", ARGV[2], "expires_at", ARGV[3],
+	"prev_refresh_jti", ARGV[1], "prev_rotated_at", ARGV[5], "successor_token", ARGV[7])
+redis.call("EXPIRE", KEYS[1], ARGV[4])
+redis.call("EXPIRE", KEYS[2], ARGV[4])
+return {1}
+`)
+
+// 이메일 토큰 1회 사용 - 저장된 jti와 일치할 때만 삭제 후 1 반환
+var consumeEmailTokenScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	redis.call("DEL", KEYS[1])
+	return 1
+end
+return 0
+`)
+
+// 로그인 실패 기록 (ZSET 슬라이딩 윈도우) - 윈도우 밖 기록 정리 후 추가
+// 반환: {윈도우 내 실패 횟수, 마지막 실패 시각(ms)}
+var recordLoginFailureScript = redis.NewScript(`
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", ARGV[1] - ARGV[2])
+redis.call("ZADD", KEYS[1], ARGV[1], ARGV[3])
+redis.call("PEXPIRE", KEYS[1], ARGV[2])
+return redis.call("ZCARD", KEYS[1])
+`)
+
+// user:* 키는 서버 시작 시 초기화되므로 세션은 auth: prefix 사용
+func sessionKey(userId uint, sessionId string) string {
+	return fmt.Sprintf("auth:session:%d:%s", userId, sessionId)
+}
+
+func userSessionsKey(userId uint) string {
+	return fmt.Sprintf("auth:sessions:%d", userId)
+}
+
+func emailTokenKey(purpose entity.EmailTokenPurpose, userId uint) string {
+	return fmt.Sprintf("auth:email_token:%s:%d", purpose, userId)
+}
+
+func mfaChallengeKey(challengeId string) string {
+	return fmt.Sprintf("auth:mfa:challenge:%s", challengeId)
+}
+
+func pendingTotpKey(userId uint) string {
+	return fmt.Sprintf("auth:mfa:pending:%d", userId)
+}
+
+func usedTotpStepKey(userId uint, step int64) string {
+	return fmt.Sprintf("auth:mfa:used:%d:%d", userId, step)
+}
+
+func loginFailureKey(scope entity.LoginLimitScope, key string) string {
+	return fmt.Sprintf("auth:login:fail:%s:%s", scope, key)
+}
+
+func loginLockKey(userId uint) string {
+	return fmt.Sprintf("auth:login:lock:%d", userId)
+}
+
 type authPersistence struct {
 	redisClient *redis.Client
 }
@@ -19,48 +96,388 @@ func NewAuthPersistence(redisClient *redis.Client) repository.AuthRepository {
 	return &authPersistence{redisClient: redisClient}
 }
 
-// refreshToken 저장
-func (r *authPersistence) StoreRefreshToken(mergeKey, refreshToken string) error {
+// TODO 세션 생성 (로그인 시)
+func (r *authPersistence) CreateSession(session *entity.Session) error {
 	ctx := context.Background()
 
-	key := fmt.Sprintf("session:%s", mergeKey)
+	key := sessionKey(session.UserId, session.SessionId)
+	listKey := userSessionsKey(session.UserId)
+	ttl := time.Until(session.ExpiresAt)
 
-	// Redis에 Refresh Token 저장
-	err := r.redisClient.Set(ctx, key, refreshToken, time.Hour*24*5).Err() // 5일 유효
-	if err != nil {
-		log.Printf("Refresh Token Redis 저장 오류: %v", err)
+	pipe := r.redisClient.TxPipeline()
+	pipe.HSet(ctx, key, map[string]interface{}{
+		"session_id":  session.SessionId,
+		"user_id":     session.UserId,
+		"refresh_jti": session.RefreshTokenId,
+		"ip":          session.IP,
+		"user_agent":  session.UserAgent,
+		"created_at":  session.CreatedAt.Format(time.RFC3339),
+		"expires_at":  session.ExpiresAt.Format(time.RFC3339),
+	})
+	pipe.Expire(ctx, key, ttl)
+	pipe.SAdd(ctx, listKey, session.SessionId)
+	pipe.Expire(ctx, listKey, ttl)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Printf("세션 Redis 저장 오류: %v", err)
 		return err
 	}
 
 	return nil
 }
 
-// userId로 refreshToken 가져오기
-func (r *authPersistence) GetRefreshToken(mergeKey string) (string, error) {
+// TODO 사용자 세션 목록 조회 (만료된 세션은 목록에서 정리)
+func (r *authPersistence) GetSessions(userId uint) ([]*entity.Session, error) {
 	ctx := context.Background()
 
-	key := fmt.Sprintf("session:%s", mergeKey)
+	listKey := userSessionsKey(userId)
+	sessionIds, err := r.redisClient.SMembers(ctx, listKey).Result()
+	if err != nil {
+		log.Printf("세션 목록 조회 오류: %v", err)
+		return nil, err
+	}
+
+	sessions := make([]*entity.Session, 0, len(sessionIds))
+	for _, sessionId := range sessionIds {
+		data, err := r.redisClient.HGetAll(ctx, sessionKey(userId, sessionId)).Result()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(data) == 0 {
+			r.redisClient.SRem(ctx, listKey, sessionId)
+			continue
+		}
+
+		parsedUserId, _ := strconv.ParseUint(data["user_id"], 10, 64)
+		createdAt, _ := time.Parse(time.RFC3339, data["created_at"])
+		expiresAt, _ := time.Parse(time.RFC3339, data["expires_at"])
+
+		sessions = append(sessions, &entity.Session{
+			SessionId: data["session_id"],
+			UserId:    uint(parsedUserId),
+			IP:        data["ip"],
+			UserAgent: data["user_agent"],
+			CreatedAt: createdAt,
+			ExpiresAt: expiresAt,
+		})
+	}
+
+	return sessions, nil
+}
+
+func (r *authPersistence) IsSessionActive(userId uint, sessionId string) (bool, error) {
+	ctx := context.Background()
 
-	refreshToken, err := r.redisClient.Get(ctx, key).Result()
+	count, err := r.redisClient.Exists(ctx, sessionKey(userId, sessionId)).Result()
 	if err != nil {
-		return "", err
+		return false, err
 	}
 
-	return refreshToken, nil
+	return count > 0, nil
 }
 
-// refreshToken 삭제 (로그아웃)
-func (r *authPersistence) DeleteRefreshToken(mergeKey string) error {
+// TODO 세션 하나 폐기
+func (r *authPersistence) DeleteSession(userId uint, sessionId string) error {
 	ctx := context.Background()
 
-	key := fmt.Sprintf("session:%s", mergeKey)
+	pipe := r.redisClient.TxPipeline()
+	pipe.Del(ctx, sessionKey(userId, sessionId))
+	pipe.SRem(ctx, userSessionsKey(userId), sessionId)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Printf("세션 삭제 오류: %v", err)
+		return err
+	}
+
+	return nil
+}
 
-	// Redis에서 Refresh Token 삭제
-	err := r.redisClient.Del(ctx, key).Err()
+// TODO 사용자의 모든 세션 폐기 (강제 로그아웃)
+func (r *authPersistence) DeleteAllSessions(userId uint) error {
+	ctx := context.Background()
+
+	listKey := userSessionsKey(userId)
+	sessionIds, err := r.redisClient.SMembers(ctx, listKey).Result()
 	if err != nil {
-		log.Printf("Redis Refresh Token 삭제 오류: %v", err)
+		log.Printf("세션 목록 조회 오류: %v", err)
+		return err
+	}
+
+	keys := make([]string, 0, len(sessionIds)+1)
+	for _, sessionId := range sessionIds {
+		keys = append(keys, sessionKey(userId, sessionId))
+	}
+	keys = append(keys, listKey)
+
+	if err := r.redisClient.Del(ctx, keys...).Err(); err != nil {
+		log.Printf("세션 전체 삭제 오류: %v", err)
 		return err
 	}
 
 	return nil
 }
+
+// TODO 리프레시 토큰 회전 - 현재 jti와 일치할 때만 새 jti로 교체하고 세션 만료 연장
+func (r *authPersistence) RotateSessionRefreshToken(userId uint, sessionId string, currentTokenId string, newTokenId string, newRefreshToken string, expiresAt time.Time, grace time.Duration) (entity.RefreshTokenRotation, string, error) {
+	ctx := context.Background()
+
+	ttlSeconds := int64(time.Until(expiresAt).Seconds())
+	result, err := rotateRefreshTokenScript.Run(ctx, r.redisClient,
+		[]string{sessionKey(userId, sessionId), userSessionsKey(userId)},
+		currentTokenId, newTokenId, expiresAt.Format(time.RFC3339), ttlSeconds,
+		time.Now().UnixMilli(), grace.Milliseconds(), newRefreshToken,
+	).Slice()
+	if err != nil || len(result) == 0 {
+		log.Printf("리프레시 토큰 회전 오류: %v", err)
+		return entity.RefreshTokenSessionNotFound, "", err
+	}
+
+	code, _ := result[0].(int64)
+	switch code {
+	case 1:
+		return entity.RefreshTokenRotated, "", nil
+	case 2:
+		successor, _ := result[1].(string)
+		if successor == "" {
+			return entity.RefreshTokenReused, "", nil
+		}
+		return entity.RefreshTokenGrace, successor, nil
+	case -1:
+		return entity.RefreshTokenReused, "", nil
+	default:
+		return entity.RefreshTokenSessionNotFound, "", nil
+	}
+}
+
+// TODO 이메일 토큰 저장 - 새로 발급하면 이전 토큰은 자동으로 무효화
+func (r *authPersistence) StoreEmailToken(purpose entity.EmailTokenPurpose, userId uint, tokenId string, expiresAt time.Time) error {
+	ctx := context.Background()
+
+	if err := r.redisClient.Set(ctx, emailTokenKey(purpose, userId), tokenId, time.Until(expiresAt)).Err(); err != nil {
+		log.Printf("이메일 토큰 저장 오류: %v", err)
+		return fmt.Errorf("이메일 토큰 저장 오류: %w", err)
+	}
+
+	return nil
+}
+
+// TODO 이메일 토큰 사용 처리 - 이미 사용했거나 재발급으로 무효화된 토큰이면 false
+func (r *authPersistence) ConsumeEmailToken(purpose entity.EmailTokenPurpose, userId uint, tokenId string) (bool, error) {
+	ctx := context.Background()
+
+	result, err := consumeEmailTokenScript.Run(ctx, r.redisClient, []string{emailTokenKey(purpose, userId)}, tokenId).Int()
+	if err != nil {
+		log.Printf("이메일 토큰 사용 처리 오류: %v", err)
+		return false, fmt.Errorf("이메일 토큰 사용 처리 오류: %w", err)
+	}
+
+	return result == 1, nil
+}
+
+// TODO 2단계 인증 대기 로그인 생성
+func (r *authPersistence) CreateMfaChallenge(challenge *entity.MfaChallenge) error {
+	ctx := context.Background()
+
+	key := mfaChallengeKey(challenge.ChallengeId)
+	pipe := r.redisClient.TxPipeline()
+	pipe.HSet(ctx, key, map[string]interface{}{
+		"user_id":             challenge.UserId,
+		"email":               challenge.Email,
+		"enrollment_required": challenge.EnrollmentRequired,
+		"attempts":            challenge.Attempts,
+		"expires_at":          challenge.ExpiresAt.Format(time.RFC3339),
+	})
+	pipe.Expire(ctx, key, time.Until(challenge.ExpiresAt))
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Printf("2단계 인증 대기 로그인 저장 오류: %v", err)
+		return fmt.Errorf("2단계 인증 대기 로그인 저장 오류: %w", err)
+	}
+	return nil
+}
+
+// 만료되었거나 없으면 nil 반환
+func (r *authPersistence) GetMfaChallenge(challengeId string) (*entity.MfaChallenge, error) {
+	ctx := context.Background()
+
+	data, err := r.redisClient.HGetAll(ctx, mfaChallengeKey(challengeId)).Result()
+	if err != nil {
+		log.Printf("2단계 인증 대기 로그인 조회 오류: %v", err)
+		return nil, fmt.Errorf("2단계 인증 대기 로그인 조회 오류: %w", err)
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	userId, _ := strconv.ParseUint(data["user_id"], 10, 64)
+	enrollmentRequired, _ := strconv.ParseBool(data["enrollment_required"])
+	attempts, _ := strconv.Atoi(data["attempts"])
+	expiresAt, _ := time.Parse(time.RFC3339, data["expires_at"])
+
+	return &entity.MfaChallenge{
+		ChallengeId:        challengeId,
+		UserId:             uint(userId),
+		Email:              data["email"],
+		EnrollmentRequired: enrollmentRequired,
+		Attempts:           attempts,
+		ExpiresAt:          expiresAt,
+	}, nil
+}
+
+func (r *authPersistence) IncrMfaChallengeAttempts(challengeId string) (int, error) {
+	ctx := context.Background()
+
+	attempts, err := r.redisClient.HIncrBy(ctx, mfaChallengeKey(challengeId), "attempts", 1).Result()
+	if err != nil {
+		log.Printf("2단계 인증 시도 횟수 증가 오류: %v", err)
+		return 0, fmt.Errorf("2단계 인증 시도 횟수 증가 오류: %w", err)
+	}
+	return int(attempts), nil
+}
+
+func (r *authPersistence) DeleteMfaChallenge(challengeId string) error {
+	ctx := context.Background()
+
+	if err := r.redisClient.Del(ctx, mfaChallengeKey(challengeId)).Err(); err != nil {
+		log.Printf("2단계 인증 대기 로그인 삭제 오류: %v", err)
+		return fmt.Errorf("2단계 인증 대기 로그인 삭제 오류: %w", err)
+	}
+	return nil
+}
+
+// TODO 등록 확인 전 TOTP 시크릿 임시 저장 (코드 확인에 성공해야 DB에 저장)
+func (r *authPersistence) StorePendingTotpSecret(userId uint, secret string, expiresAt time.Time) error {
+	ctx := context.Background()
+
+	if err := r.redisClient.Set(ctx, pendingTotpKey(userId), secret, time.Until(expiresAt)).Err(); err != nil {
+		log.Printf("TOTP 임시 시크릿 저장 오류: %v", err)
+		return fmt.Errorf("TOTP 임시 시크릿 저장 오류: %w", err)
+	}
+	return nil
+}
+
+// 없으면 빈 문자열 반환
+func (r *authPersistence) GetPendingTotpSecret(userId uint) (string, error) {
+	ctx := context.Background()
+
+	secret, err := r.redisClient.Get(ctx, pendingTotpKey(userId)).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	if err != nil {
+		log.Printf("TOTP 임시 시크릿 조회 오류: %v", err)
+		return "", fmt.Errorf("TOTP 임시 시크릿 조회 오류: %w", err)
+	}
+	return secret, nil
+}
+
+func (r *authPersistence) DeletePendingTotpSecret(userId uint) error {
+	ctx := context.Background()
+
+	if err := r.redisClient.Del(ctx, pendingTotpKey(userId)).Err(); err != nil {
+		log.Printf("TOTP 임시 시크릿 삭제 오류: %v", err)
+		return fmt.Errorf("TOTP 임시 시크릿 삭제 오류: %w", err)
+	}
+	return nil
+}
+
+// TODO 한 번 사용한 TOTP 코드(time step) 재사용 방지 - 처음 사용이면 true
+func (r *authPersistence) MarkTotpStepUsed(userId uint, step int64) (bool, error) {
+	ctx := context.Background()
+
+	ok, err := r.redisClient.SetNX(ctx, usedTotpStepKey(userId, step), 1, 2*time.Minute).Result()
+	if err != nil {
+		log.Printf("TOTP 사용 기록 오류: %v", err)
+		return false, fmt.Errorf("TOTP 사용 기록 오류: %w", err)
+	}
+	return ok, nil
+}
+
+// TODO 로그인 실패 기록 후 윈도우 내 실패 현황 반환
+func (r *authPersistence) RecordLoginFailure(scope entity.LoginLimitScope, key string, window time.Duration) (*entity.LoginFailures, error) {
+	ctx := context.Background()
+
+	now := time.Now()
+	count, err := recordLoginFailureScript.Run(ctx, r.redisClient,
+		[]string{loginFailureKey(scope, key)},
+		now.UnixMilli(), window.Milliseconds(), fmt.Sprintf("%d-%s", now.UnixNano(), uuid.NewString()[:8]),
+	).Int()
+	if err != nil {
+		log.Printf("로그인 실패 기록 오류: %v", err)
+		return nil, fmt.Errorf("로그인 실패 기록 오류: %w", err)
+	}
+
+	return &entity.LoginFailures{Count: count, LastFailureAt: now}, nil
+}
+
+func (r *authPersistence) GetLoginFailures(scope entity.LoginLimitScope, key string, window time.Duration) (*entity.LoginFailures, error) {
+	ctx := context.Background()
+
+	redisKey := loginFailureKey(scope, key)
+	minScore := strconv.FormatInt(time.Now().Add(-window).UnixMilli(), 10)
+
+	entries, err := r.redisClient.ZRangeByScoreWithScores(ctx, redisKey, &redis.ZRangeBy{Min: "(" + minScore, Max: "+inf"}).Result()
+	if err != nil {
+		log.Printf("로그인 실패 조회 오류: %v", err)
+		return nil, fmt.Errorf("로그인 실패 조회 오류: %w", err)
+	}
+
+	failures := &entity.LoginFailures{Count: len(entries)}
+	if len(entries) > 0 {
+		failures.LastFailureAt = time.UnixMilli(int64(entries[len(entries)-1].Score))
+	}
+	return failures, nil
+}
+
+func (r *authPersistence) ClearLoginFailures(scope entity.LoginLimitScope, key string) error {
+	ctx := context.Background()
+
+	if err := r.redisClient.Del(ctx, loginFailureKey(scope, key)).Err(); err != nil {
+		log.Printf("로그인 실패 기록 삭제 오류: %v", err)
+		return fmt.Errorf("로그인 실패 기록 삭제 오류: %w", err)
+	}
+	return nil
+}
+
+// TODO 계정 잠금 만료 시각 저장 (로그인 실패로 잠긴 계정 표시 - 만료 시각이 지나면 다음 로그인 때 해제)
+func (r *authPersistence) SetLoginLock(userId uint, until time.Time) error {
+	ctx := context.Background()
+
+	// 만료 시각이 지나도 키를 남겨 로그인 실패로 잠긴 계정임을 표시 (잠금 해제 시 삭제)
+	if err := r.redisClient.Set(ctx, loginLockKey(userId), until.Format(time.RFC3339), 0).Err(); err != nil {
+		log.Printf("계정 잠금 저장 오류: %v", err)
+		return fmt.Errorf("계정 잠금 저장 오류: %w", err)
+	}
+	return nil
+}
+
+// 로그인 실패로 잠긴 기록이 없으면 nil 반환 (잠금 시간이 지난 기록도 반환)
+func (r *authPersistence) GetLoginLock(userId uint) (*time.Time, error) {
+	ctx := context.Background()
+
+	value, err := r.redisClient.Get(ctx, loginLockKey(userId)).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		log.Printf("계정 잠금 조회 오류: %v", err)
+		return nil, fmt.Errorf("계정 잠금 조회 오류: %w", err)
+	}
+
+	until, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("계정 잠금 시각 파싱 오류: %w", err)
+	}
+	return &until, nil
+}
+
+func (r *authPersistence) DeleteLoginLock(userId uint) error {
+	ctx := context.Background()
+
+	if err := r.redisClient.Del(ctx, loginLockKey(userId)).Err(); err != nil {
+		log.Printf("계정 잠금 삭제 오류: %v", err)
+		return fmt.Errorf("계정 잠금 삭제 오류: %w", err)
+	}
+	return nil
+}
@@ -2,29 +2,40 @@ package persistence
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"link/infrastructure/model"
 	"link/internal/board/entity"
 	"link/internal/board/repository"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type BoardPersistence struct {
 	db          *gorm.DB
 	redisClient *redis.Client
+	mongo       *mongo.Client
 }
 
-func NewBoardPersistence(db *gorm.DB, redisClient *redis.Client) repository.BoardRepository {
-	return &BoardPersistence{db: db, redisClient: redisClient}
+func NewBoardPersistence(db *gorm.DB, redisClient *redis.Client, mongo *mongo.Client) repository.BoardRepository {
+	return &BoardPersistence{db: db, redisClient: redisClient, mongo: mongo}
 }
 
 // ! 보드 관련
-func (p *BoardPersistence) CreateBoard(board *entity.Board, boardUsers []entity.BoardUser) error {
+// 보드, 보드 사용자, 보드 내용(컬럼, 카드, 라벨 등)을 한 트랜잭션으로 생성
+func (p *BoardPersistence) CreateBoard(board *entity.Board, boardUsers []entity.BoardUser, content *entity.BoardContent) error {
 	tx := p.db.Begin()
 	if tx.Error != nil {
 		return tx.Error
@@ -71,10 +82,252 @@ func (p *BoardPersistence) CreateBoard(board *entity.Board, boardUsers []entity.
 		return result.Error
 	}
 
+	if err := createBoardContent(tx, board.ID, content); err != nil {
+		tx.Rollback()
+		return err
+	}
+
 	board.ID = boardModel.ID
 	return tx.Commit().Error
 }
 
+// 라벨 -> 컬럼 -> 카드(담당자, 라벨) -> 체크리스트(항목) -> 첨부 파일 순서로 저장
+func createBoardContent(tx *gorm.DB, boardID uint, content *entity.BoardContent) error {
+	if content == nil {
+		return nil
+	}
+
+	labels := make([]model.BoardLabel, len(content.Labels))
+	for i, label := range content.Labels {
+		labels[i] = model.BoardLabel{
+			ID:      label.ID,
+			BoardID: boardID,
+			Name:    label.Name,
+			Color:   label.Color,
+			Version: 1,
+		}
+	}
+	if len(labels) > 0 {
+		if err := tx.Create(&labels).Error; err != nil {
+			return fmt.Errorf("라벨 생성 중 DB 오류: %w", err)
+		}
+	}
+
+	columns := make([]model.BoardColumn, len(content.Columns))
+	cards := []model.BoardCard{}
+	assignees := []model.CardAssignee{}
+	cardLabels := []model.CardLabel{}
+	for i, column := range content.Columns {
+		columns[i] = model.BoardColumn{
+			ID:       column.ID,
+			BoardID:  boardID,
+			Name:     column.Name,
+			Position: uint(i),
+			WipLimit: column.WipLimit,
+			Version:  1,
+		}
+		for j, card := range column.Cards {
+			cards = append(cards, model.BoardCard{
+				ID:            card.ID,
+				BoardID:       boardID,
+				BoardColumnID: column.ID,
+				Name:          card.Name,
+				Content:       card.Content,
+				Position:      uint(j),
+				StartDate:     card.StartDate,
+				EndDate:       card.EndDate,
+				Version:       1,
+			})
+			for _, userID := range card.Assignees {
+				assignees = append(assignees, model.CardAssignee{CardID: card.ID, UserID: userID})
+			}
+			for _, labelID := range card.Labels {
+				cardLabels = append(cardLabels, model.CardLabel{CardID: card.ID, LabelID: labelID})
+			}
+		}
+	}
+	if len(columns) > 0 {
+		if err := tx.Create(&columns).Error; err != nil {
+			return fmt.Errorf("컬럼 생성 중 DB 오류: %w", err)
+		}
+	}
+	if len(cards) > 0 {
+		if err := tx.Create(&cards).Error; err != nil {
+			return fmt.Errorf("카드 생성 중 DB 오류: %w", err)
+		}
+	}
+	if len(assignees) > 0 {
+		if err := tx.Create(&assignees).Error; err != nil {
+			return fmt.Errorf("카드 담당자 추가 중 DB 오류: %w", err)
+		}
+	}
+	if len(cardLabels) > 0 {
+		if err := tx.Create(&cardLabels).Error; err != nil {
+			return fmt.Errorf("카드 라벨 추가 중 DB 오류: %w", err)
+		}
+	}
+
+	checklists := make([]model.BoardCardChecklist, len(content.Checklists))
+	items := []model.BoardCardChecklistItem{}
+	for i, checklist := range content.Checklists {
+		checklists[i] = model.BoardCardChecklist{
+			ID:       checklist.ID,
+			CardID:   checklist.CardID,
+			BoardID:  boardID,
+			Name:     checklist.Name,
+			Position: checklist.Position,
+			Version:  1,
+		}
+		for _, item := range checklist.Items {
+			items = append(items, model.BoardCardChecklistItem{
+				ID:          item.ID,
+				ChecklistID: checklist.ID,
+				CardID:      checklist.CardID,
+				BoardID:     boardID,
+				Content:     item.Content,
+				Checked:     item.Checked,
+				Position:    item.Position,
+				Version:     1,
+			})
+		}
+	}
+	if len(checklists) > 0 {
+		if err := tx.Create(&checklists).Error; err != nil {
+			return fmt.Errorf("체크리스트 생성 중 DB 오류: %w", err)
+		}
+	}
+	if len(items) > 0 {
+		if err := tx.Create(&items).Error; err != nil {
+			return fmt.Errorf("체크리스트 항목 생성 중 DB 오류: %w", err)
+		}
+	}
+
+	attachments := make([]model.BoardCardAttachment, len(content.Attachments))
+	for i, attachment := range content.Attachments {
+		attachments[i] = model.BoardCardAttachment{
+			ID:          attachment.ID,
+			CardID:      attachment.CardID,
+			BoardID:     boardID,
+			URL:         attachment.URL,
+			Name:        attachment.Name,
+			Size:        attachment.Size,
+			ContentType: attachment.ContentType,
+			UploadedBy:  attachment.UploadedBy,
+		}
+	}
+	if len(attachments) > 0 {
+		if err := tx.Create(&attachments).Error; err != nil {
+			return fmt.Errorf("첨부 파일 생성 중 DB 오류: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// 보드 복사, 템플릿 저장용으로 보드 내용을 같은 시점 기준으로 한 번에 조회 (댓글, 활동 기록은 제외)
+// 컬럼과 카드는 Position 순서
+func (p *BoardPersistence) GetBoardContent(boardID uint) (*entity.BoardContent, error) {
+	content := &entity.BoardContent{}
+	err := p.db.Transaction(func(tx *gorm.DB) error {
+		var columns []model.BoardColumn
+		if err := tx.Where("board_id = ?", boardID).Order("position ASC").Find(&columns).Error; err != nil {
+			return fmt.Errorf("컬럼 조회 중 DB 오류: %w", err)
+		}
+
+		var cards []model.BoardCard
+		if err := tx.Preload("Assignees").Where("board_id = ?", boardID).Order("position ASC").Find(&cards).Error; err != nil {
+			return fmt.Errorf("카드 조회 중 DB 오류: %w", err)
+		}
+
+		var cardLabels []model.CardLabel
+		if err := tx.Select("card_labels.card_id, card_labels.label_id").
+			Joins("JOIN board_cards ON board_cards.id = card_labels.card_id").
+			Where("board_cards.board_id = ?", boardID).
+			Find(&cardLabels).Error; err != nil {
+			return fmt.Errorf("카드 라벨 조회 중 DB 오류: %w", err)
+		}
+		labelsByCard := make(map[uuid.UUID][]uuid.UUID)
+		for _, cardLabel := range cardLabels {
+			labelsByCard[cardLabel.CardID] = append(labelsByCard[cardLabel.CardID], cardLabel.LabelID)
+		}
+
+		cardsByColumn := make(map[uuid.UUID][]entity.BoardCard)
+		for _, card := range cards {
+			cardEntity := entity.BoardCard{
+				ID:             card.ID,
+				Name:           card.Name,
+				Content:        card.Content,
+				BoardID:        card.BoardID,
+				BoardColumnID:  card.BoardColumnID,
+				Position:       card.Position,
+				StartDate:      card.StartDate,
+				EndDate:        card.EndDate,
+				Version:        card.Version,
+				ContentVersion: card.ContentVersion,
+				CreatedAt:      card.CreatedAt,
+				UpdatedAt:      card.UpdatedAt,
+				Assignees:      make([]uint, len(card.Assignees)),
+				Labels:         labelsByCard[card.ID],
+			}
+			for i, assignee := range card.Assignees {
+				cardEntity.Assignees[i] = assignee.UserID
+			}
+			cardsByColumn[card.BoardColumnID] = append(cardsByColumn[card.BoardColumnID], cardEntity)
+		}
+
+		content.Columns = make([]entity.BoardColumn, len(columns))
+		for i, column := range columns {
+			content.Columns[i] = entity.BoardColumn{
+				ID:        column.ID,
+				Name:      column.Name,
+				BoardID:   column.BoardID,
+				Position:  column.Position,
+				WipLimit:  column.WipLimit,
+				Version:   column.Version,
+				CreatedAt: column.CreatedAt,
+				UpdatedAt: column.UpdatedAt,
+				Cards:     cardsByColumn[column.ID],
+			}
+		}
+
+		var labels []model.BoardLabel
+		if err := tx.Where("board_id = ?", boardID).Order("created_at ASC").Find(&labels).Error; err != nil {
+			return fmt.Errorf("라벨 조회 중 DB 오류: %w", err)
+		}
+		content.Labels = make([]entity.BoardLabel, len(labels))
+		for i, label := range labels {
+			content.Labels[i] = toBoardLabelEntity(label)
+		}
+
+		var checklists []model.BoardCardChecklist
+		if err := tx.Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).Where("board_id = ?", boardID).Order("position ASC").Find(&checklists).Error; err != nil {
+			return fmt.Errorf("체크리스트 조회 중 DB 오류: %w", err)
+		}
+		content.Checklists = make([]entity.BoardCardChecklist, len(checklists))
+		for i, checklist := range checklists {
+			content.Checklists[i] = toBoardCardChecklistEntity(checklist)
+		}
+
+		var attachments []model.BoardCardAttachment
+		if err := tx.Where("board_id = ?", boardID).Order("created_at ASC").Find(&attachments).Error; err != nil {
+			return fmt.Errorf("첨부 파일 조회 중 DB 오류: %w", err)
+		}
+		content.Attachments = make([]entity.BoardCardAttachment, len(attachments))
+		for i, attachment := range attachments {
+			content.Attachments[i] = toBoardCardAttachmentEntity(attachment)
+		}
+
+		return nil
+	}, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
+
 func (p *BoardPersistence) GetBoardByID(boardID uint) (*entity.Board, error) {
 	var board model.Board
 	if err := p.db.Where("id = ?", boardID).First(&board).Error; err != nil {
@@ -231,6 +484,7 @@ func (p *BoardPersistence) CreateBoardColumn(boardColumn *entity.BoardColumn) er
 		BoardID:   boardColumn.BoardID,
 		Name:      boardColumn.Name,
 		Position:  boardColumn.Position, //자등으로 다음 position 에 생성
+		WipLimit:  boardColumn.WipLimit,
 		CreatedAt: boardColumn.CreatedAt,
 		UpdatedAt: boardColumn.UpdatedAt,
 	}
@@ -258,6 +512,8 @@ func (p *BoardPersistence) GetBoardColumnsByBoardID(boardID uint) ([]entity.Boar
 			Name:      boardColumn.Name,
 			BoardID:   boardColumn.BoardID,
 			Position:  boardColumn.Position,
+			WipLimit:  boardColumn.WipLimit,
+			Version:   boardColumn.Version,
 			CreatedAt: boardColumn.CreatedAt,
 			UpdatedAt: boardColumn.UpdatedAt,
 		}
@@ -276,6 +532,8 @@ func (p *BoardPersistence) GetBoardColumnByID(columnID uuid.UUID) (*entity.Board
 		Name:      boardColumn.Name,
 		BoardID:   boardColumn.BoardID,
 		Position:  boardColumn.Position,
+		WipLimit:  boardColumn.WipLimit,
+		Version:   boardColumn.Version,
 		CreatedAt: boardColumn.CreatedAt,
 		UpdatedAt: boardColumn.UpdatedAt,
 	}
@@ -302,29 +560,24 @@ func (p *BoardPersistence) DeleteBoardColumn(columnID uuid.UUID) error {
 }
 
 func (p *BoardPersistence) MoveBoardColumn(columnID uuid.UUID, newPosition uint) error {
-	tx := p.db.Begin()
-	if tx.Error != nil {
-		return tx.Error
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		//현재 컬럼 정보 조회
+		var currentColumn model.BoardColumn
+		if err := tx.Where("id = ?", columnID).First(&currentColumn).Error; err != nil {
+			return err
 		}
-	}()
-
-	//현재 컬럼 정보 조회
-	var currentColumn model.BoardColumn
-	if err := tx.Where("id = ?", columnID).First(&currentColumn).Error; err != nil {
-		tx.Rollback()
-		return err
-	}
+		return moveBoardColumn(tx, &currentColumn, newPosition)
+	})
+}
 
+// 트랜잭션 안에서 컬럼 이동 - 옆의 컬럼들도 밀려남
+func moveBoardColumn(tx *gorm.DB, currentColumn *model.BoardColumn, newPosition uint) error {
 	currentPosition := currentColumn.Position
 	boardID := currentColumn.BoardID
 
 	//컬럼을 옮기면 , 옆의 컬럼들도 밀려나야함
 	if currentPosition == newPosition {
-		return tx.Commit().Error
+		return nil
 	}
 
 	//위치 이동 방향에 따라 다른 컬럼들의 위치 조정
@@ -333,7 +586,6 @@ func (p *BoardPersistence) MoveBoardColumn(columnID uuid.UUID, newPosition uint)
 		if err := tx.Model(&model.BoardColumn{}).
 			Where("board_id = ? AND position > ? AND position <= ?", boardID, currentPosition, newPosition).
 			Update("position", gorm.Expr("position - 1")).Error; err != nil {
-			tx.Rollback()
 			return err
 		}
 	} else {
@@ -341,17 +593,15 @@ func (p *BoardPersistence) MoveBoardColumn(columnID uuid.UUID, newPosition uint)
 		if err := tx.Model(&model.BoardColumn{}).
 			Where("board_id = ? AND position >= ? AND position < ?", boardID, newPosition, currentPosition).
 			Update("position", gorm.Expr("position + 1")).Error; err != nil {
-			tx.Rollback()
 			return err
 		}
 	}
 
-	if err := tx.Model(&model.BoardColumn{}).Where("id = ?", columnID).Update("position", newPosition).Error; err != nil {
-		tx.Rollback()
+	if err := tx.Model(&model.BoardColumn{}).Where("id = ?", currentColumn.ID).Update("position", newPosition).Error; err != nil {
 		return err
 	}
-
-	return tx.Commit().Error
+	currentColumn.Position = newPosition
+	return nil
 }
 
 // ! 카드 관련
@@ -385,17 +635,18 @@ func (p *BoardPersistence) CreateBoardCard(boardCard *entity.BoardCard) error {
 
 	// 모델 생성
 	boardCardModel := model.BoardCard{
-		ID:            boardCard.ID,
-		BoardID:       boardCard.BoardID,
-		BoardColumnID: boardCard.BoardColumnID,
-		Name:          boardCard.Name,
-		Content:       boardCard.Content,
-		Position:      boardCard.Position,
-		StartDate:     boardCard.StartDate,
-		EndDate:       boardCard.EndDate,
-		Version:       boardCard.Version,
-		CreatedAt:     boardCard.CreatedAt,
-		UpdatedAt:     boardCard.UpdatedAt,
+		ID:             boardCard.ID,
+		BoardID:        boardCard.BoardID,
+		BoardColumnID:  boardCard.BoardColumnID,
+		Name:           boardCard.Name,
+		Content:        boardCard.Content,
+		Position:       boardCard.Position,
+		StartDate:      boardCard.StartDate,
+		EndDate:        boardCard.EndDate,
+		Version:        boardCard.Version,
+		ContentVersion: boardCard.ContentVersion,
+		CreatedAt:      boardCard.CreatedAt,
+		UpdatedAt:      boardCard.UpdatedAt,
 	}
 
 	if len(boardCard.Assignees) > 0 {
@@ -435,17 +686,18 @@ func (p *BoardPersistence) GetBoardCardsByColumnID(columnID uuid.UUID) ([]entity
 	boardCardsEntity := make([]entity.BoardCard, len(boardCards))
 	for i, boardCard := range boardCards {
 		boardCardsEntity[i] = entity.BoardCard{
-			ID:            boardCard.ID,
-			Name:          boardCard.Name,
-			Content:       boardCard.Content,
-			BoardID:       boardCard.BoardID,
-			BoardColumnID: boardCard.BoardColumnID,
-			Position:      boardCard.Position,
-			StartDate:     boardCard.StartDate,
-			EndDate:       boardCard.EndDate,
-			Version:       boardCard.Version,
-			CreatedAt:     boardCard.CreatedAt,
-			UpdatedAt:     boardCard.UpdatedAt,
+			ID:             boardCard.ID,
+			Name:           boardCard.Name,
+			Content:        boardCard.Content,
+			BoardID:        boardCard.BoardID,
+			BoardColumnID:  boardCard.BoardColumnID,
+			Position:       boardCard.Position,
+			StartDate:      boardCard.StartDate,
+			EndDate:        boardCard.EndDate,
+			Version:        boardCard.Version,
+			ContentVersion: boardCard.ContentVersion,
+			CreatedAt:      boardCard.CreatedAt,
+			UpdatedAt:      boardCard.UpdatedAt,
 		}
 	}
 	return boardCardsEntity, nil
@@ -458,17 +710,18 @@ func (p *BoardPersistence) GetBoardCardByID(cardID uuid.UUID) (*entity.BoardCard
 	}
 
 	boardCardEntity := &entity.BoardCard{
-		ID:            boardCard.ID,
-		Name:          boardCard.Name,
-		Content:       boardCard.Content,
-		BoardID:       boardCard.BoardID,
-		BoardColumnID: boardCard.BoardColumnID,
-		Position:      boardCard.Position,
-		StartDate:     boardCard.StartDate,
-		EndDate:       boardCard.EndDate,
-		Version:       boardCard.Version,
-		CreatedAt:     boardCard.CreatedAt,
-		UpdatedAt:     boardCard.UpdatedAt,
+		ID:             boardCard.ID,
+		Name:           boardCard.Name,
+		Content:        boardCard.Content,
+		BoardID:        boardCard.BoardID,
+		BoardColumnID:  boardCard.BoardColumnID,
+		Position:       boardCard.Position,
+		StartDate:      boardCard.StartDate,
+		EndDate:        boardCard.EndDate,
+		Version:        boardCard.Version,
+		ContentVersion: boardCard.ContentVersion,
+		CreatedAt:      boardCard.CreatedAt,
+		UpdatedAt:      boardCard.UpdatedAt,
 	}
 
 	assignees := make([]model.CardAssignee, len(boardCard.Assignees))
@@ -541,23 +794,18 @@ func (p *BoardPersistence) DeleteBoardCard(cardID uuid.UUID) error {
 }
 
 func (p *BoardPersistence) MoveBoardCard(cardID uuid.UUID, toColumnID *uuid.UUID, newPosition *uint) error {
-	tx := p.db.Begin()
-	if tx.Error != nil {
-		return tx.Error
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		// 현재 카드 정보 조회
+		var card model.BoardCard
+		if err := tx.Where("id = ?", cardID).First(&card).Error; err != nil {
+			return err
 		}
-	}()
-
-	// 현재 카드 정보 조회
-	var card model.BoardCard
-	if err := tx.First(&card, cardID).Error; err != nil {
-		tx.Rollback()
-		return err
-	}
+		return moveBoardCard(tx, &card, toColumnID, newPosition)
+	})
+}
 
+// 트랜잭션 안에서 카드 이동 - 같은 컬럼 안에서 순서 변경 또는 다른 컬럼으로 이동
+func moveBoardCard(tx *gorm.DB, card *model.BoardCard, toColumnID *uuid.UUID, newPosition *uint) error {
 	fromColumnID := card.BoardColumnID
 	currentPosition := card.Position
 
@@ -565,7 +813,7 @@ func (p *BoardPersistence) MoveBoardCard(cardID uuid.UUID, toColumnID *uuid.UUID
 	isPositionChanged := newPosition != nil && currentPosition != *newPosition
 
 	if !isColumnChanged && !isPositionChanged {
-		return tx.Commit().Error
+		return nil
 	}
 
 	targetColumnID := fromColumnID
@@ -587,7 +835,6 @@ func (p *BoardPersistence) MoveBoardCard(cardID uuid.UUID, toColumnID *uuid.UUID
 			Select("COALESCE(MAX(position), 0) as max_pos").
 			Where("board_column_id = ?", targetColumnID).
 			Scan(&maxPosition).Error; err != nil {
-			tx.Rollback()
 			return err
 		}
 		targetPosition = maxPosition.MaxPos + 1
@@ -600,7 +847,6 @@ func (p *BoardPersistence) MoveBoardCard(cardID uuid.UUID, toColumnID *uuid.UUID
 				Where("board_column_id = ? AND position > ? AND position <= ?",
 					fromColumnID, currentPosition, targetPosition).
 				Update("position", gorm.Expr("position - 1")).Error; err != nil {
-				tx.Rollback()
 				return err
 			}
 		} else {
@@ -609,7 +855,6 @@ func (p *BoardPersistence) MoveBoardCard(cardID uuid.UUID, toColumnID *uuid.UUID
 				Where("board_column_id = ? AND position >= ? AND position < ?",
 					fromColumnID, targetPosition, currentPosition).
 				Update("position", gorm.Expr("position + 1")).Error; err != nil {
-				tx.Rollback()
 				return err
 			}
 		}
@@ -619,7 +864,6 @@ func (p *BoardPersistence) MoveBoardCard(cardID uuid.UUID, toColumnID *uuid.UUID
 			Where("board_column_id = ? AND position > ?",
 				fromColumnID, currentPosition).
 			Update("position", gorm.Expr("position - 1")).Error; err != nil {
-			tx.Rollback()
 			return err
 		}
 
@@ -628,7 +872,6 @@ func (p *BoardPersistence) MoveBoardCard(cardID uuid.UUID, toColumnID *uuid.UUID
 			Where("board_column_id = ? AND position >= ?",
 				targetColumnID, targetPosition).
 			Update("position", gorm.Expr("position + 1")).Error; err != nil {
-			tx.Rollback()
 			return err
 		}
 	}
@@ -639,10 +882,1974 @@ func (p *BoardPersistence) MoveBoardCard(cardID uuid.UUID, toColumnID *uuid.UUID
 		"position":        targetPosition,
 	}
 
-	if err := tx.Model(&card).Updates(updates).Error; err != nil {
-		tx.Rollback()
+	if err := tx.Model(&model.BoardCard{}).Where("id = ?", card.ID).Updates(updates).Error; err != nil {
 		return err
 	}
 
-	return tx.Commit().Error
+	card.BoardColumnID = targetColumnID
+	card.Position = targetPosition
+	return nil
+}
+
+// ! 자동 저장 관련
+
+// TODO 변경사항 묶음을 하나의 트랜잭션으로 적용
+// 버전이 맞지 않는 수정/삭제는 conflict로 거절하고 나머지는 적용, DB 오류가 나면 전체 롤백
+func (p *BoardPersistence) ApplyBoardChanges(boardID uint, changes []entity.BoardChange) ([]entity.BoardChangeResult, error) {
+	var results []entity.BoardChangeResult
+
+	err := p.db.Transaction(func(tx *gorm.DB) error {
+		results = make([]entity.BoardChangeResult, 0, len(changes))
+		for i, change := range changes {
+			result := entity.BoardChangeResult{
+				Index:  i,
+				Type:   change.Type,
+				Action: change.Action,
+			}
+
+			var err error
+			switch change.Type {
+			case "column":
+				result.TargetID = *change.ColumnID
+				if change.Action != "create" {
+					if _, err = lockBoardRow(tx, &model.BoardColumn{}, result.TargetID, boardID); err != nil {
+						return err
+					}
+					if result.PrevColumn, err = findBoardColumn(tx, boardID, result.TargetID); err != nil {
+						return err
+					}
+				}
+				if result.Status, err = applyBoardColumnChange(tx, boardID, change); err != nil {
+					return err
+				}
+				if result.Column, err = findBoardColumn(tx, boardID, result.TargetID); err != nil {
+					return err
+				}
+			case "card":
+				result.TargetID = change.CardID
+				if change.Action != "create" {
+					if _, err = lockBoardRow(tx, &model.BoardCard{}, result.TargetID, boardID); err != nil {
+						return err
+					}
+					if result.PrevCard, err = findBoardCard(tx, boardID, result.TargetID); err != nil {
+						return err
+					}
+				}
+				if result.Status, err = applyBoardCardChange(tx, boardID, change); err != nil {
+					return err
+				}
+				if result.Card, err = findBoardCard(tx, boardID, result.TargetID); err != nil {
+					return err
+				}
+			case "label":
+				result.TargetID = change.TargetID
+				if change.Action != "create" {
+					if _, err = lockBoardRow(tx, &model.BoardLabel{}, result.TargetID, boardID); err != nil {
+						return err
+					}
+					if result.PrevLabel, err = findBoardLabel(tx, boardID, result.TargetID); err != nil {
+						return err
+					}
+				}
+				if result.Status, err = applyBoardLabelChange(tx, boardID, change); err != nil {
+					return err
+				}
+				if result.Label, err = findBoardLabel(tx, boardID, result.TargetID); err != nil {
+					return err
+				}
+			case "comment":
+				result.TargetID = change.TargetID
+				if change.Action != "create" {
+					if _, err = lockBoardRow(tx, &model.BoardCardComment{}, result.TargetID, boardID); err != nil {
+						return err
+					}
+					if result.PrevComment, err = findBoardCardComment(tx, boardID, result.TargetID); err != nil {
+						return err
+					}
+				}
+				if result.Status, err = applyBoardCommentChange(tx, boardID, change); err != nil {
+					return err
+				}
+				if result.Comment, err = findBoardCardComment(tx, boardID, result.TargetID); err != nil {
+					return err
+				}
+			case "checklist":
+				result.TargetID = change.TargetID
+				if change.Action != "create" {
+					if _, err = lockBoardRow(tx, &model.BoardCardChecklist{}, result.TargetID, boardID); err != nil {
+						return err
+					}
+					if result.PrevChecklist, err = findBoardCardChecklist(tx, boardID, result.TargetID); err != nil {
+						return err
+					}
+				}
+				if result.Status, err = applyBoardChecklistChange(tx, boardID, change); err != nil {
+					return err
+				}
+				if result.Checklist, err = findBoardCardChecklist(tx, boardID, result.TargetID); err != nil {
+					return err
+				}
+			case "checklist_item":
+				result.TargetID = change.TargetID
+				if change.Action != "create" {
+					if _, err = lockBoardRow(tx, &model.BoardCardChecklistItem{}, result.TargetID, boardID); err != nil {
+						return err
+					}
+					if result.PrevChecklistItem, err = findBoardCardChecklistItem(tx, boardID, result.TargetID); err != nil {
+						return err
+					}
+				}
+				if result.Status, err = applyBoardChecklistItemChange(tx, boardID, change); err != nil {
+					return err
+				}
+				if result.ChecklistItem, err = findBoardCardChecklistItem(tx, boardID, result.TargetID); err != nil {
+					return err
+				}
+			case "attachment":
+				result.TargetID = change.TargetID
+				if change.Action != "create" {
+					if _, err = lockBoardRow(tx, &model.BoardCardAttachment{}, result.TargetID, boardID); err != nil {
+						return err
+					}
+					if result.PrevAttachment, err = findBoardCardAttachment(tx, boardID, result.TargetID); err != nil {
+						return err
+					}
+				}
+				if result.Status, err = applyBoardAttachmentChange(tx, boardID, change); err != nil {
+					return err
+				}
+				if result.Attachment, err = findBoardCardAttachment(tx, boardID, result.TargetID); err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("알 수 없는 변경 타입: %s", change.Type)
+			}
+
+			results = append(results, result)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// 트랜잭션이 끝날 때까지 다른 변경사항이 같은 행을 수정하지 못하도록 잠금
+func lockBoardRow(tx *gorm.DB, dest interface{}, id uuid.UUID, boardID uint) (bool, error) {
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND board_id = ?", id, boardID).
+		First(dest).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// 생성할 ID가 다른 보드에 이미 있는지 (lockBoardRow는 같은 보드만 조회)
+func boardRowIDTaken(tx *gorm.DB, dest interface{}, id uuid.UUID) (bool, error) {
+	var count int64
+	if err := tx.Model(dest).Where("id = ?", id).Count(&count).Error; err != nil {
+		return false, fmt.Errorf("ID 중복 조회 중 DB 오류: %w", err)
+	}
+	return count > 0, nil
+}
+
+// 버전 없이 온 변경은 이전 상태 기준으로 봄 (수정/삭제는 요청 검증에서 먼저 막음)
+func isStaleVersion(requestVersion *int, currentVersion int) bool {
+	return requestVersion == nil || *requestVersion != currentVersion
+}
+
+// 카드 수정 충돌 - 카드 버전이 다르거나, 내용을 바꾸는데 내용 버전이 다르면 충돌
+// 협업 편집 스냅샷은 내용 버전만 올리므로 이름/날짜 수정은 충돌하지 않음
+func isStaleCardUpdate(change entity.BoardChange, card *model.BoardCard) bool {
+	if isStaleVersion(change.Version, card.Version) {
+		return true
+	}
+	return change.Content != nil && isStaleVersion(change.ContentVersion, card.ContentVersion)
+}
+
+func applyBoardColumnChange(tx *gorm.DB, boardID uint, change entity.BoardChange) (string, error) {
+	var column model.BoardColumn
+	exists, err := lockBoardRow(tx, &column, *change.ColumnID, boardID)
+	if err != nil {
+		return "", fmt.Errorf("컬럼 조회 중 DB 오류: %w", err)
+	}
+
+	if change.Action == "create" {
+		// 재전송 등으로 이미 있는 컬럼이면 현재 상태를 돌려줌
+		if exists {
+			return entity.BoardChangeConflict, nil
+		}
+		// 다른 보드에서 쓰는 ID면 PK 충돌로 묶음 전체가 실패하므로 이 변경사항만 충돌 처리
+		if taken, err := boardRowIDTaken(tx, &column, *change.ColumnID); err != nil || taken {
+			return entity.BoardChangeConflict, err
+		}
+
+		var maxPosition struct {
+			MaxPos int
+		}
+		if err := tx.Model(&model.BoardColumn{}).
+			Select("COALESCE(MAX(position), -1) as max_pos").
+			Where("board_id = ?", boardID).
+			Scan(&maxPosition).Error; err != nil {
+			return "", fmt.Errorf("컬럼 위치 조회 중 DB 오류: %w", err)
+		}
+
+		column = model.BoardColumn{
+			ID:       *change.ColumnID,
+			BoardID:  boardID,
+			Name:     *change.Name,
+			Position: uint(maxPosition.MaxPos + 1),
+			Version:  1,
+		}
+		if change.WipLimit != nil {
+			column.WipLimit = *change.WipLimit
+		}
+		if err := tx.Create(&column).Error; err != nil {
+			return "", fmt.Errorf("컬럼 생성 중 DB 오류: %w", err)
+		}
+		return entity.BoardChangeApplied, nil
+	}
+
+	// 이미 삭제된 컬럼
+	if !exists {
+		return entity.BoardChangeConflict, nil
+	}
+
+	stale := isStaleVersion(change.Version, column.Version)
+	switch change.Action {
+	case "update":
+		if stale {
+			return entity.BoardChangeConflict, nil
+		}
+		updates := map[string]interface{}{"version": gorm.Expr("version + 1")}
+		if change.Name != nil {
+			updates["name"] = *change.Name
+		}
+		if change.WipLimit != nil {
+			updates["wip_limit"] = *change.WipLimit
+		}
+		if err := tx.Model(&column).Updates(updates).Error; err != nil {
+			return "", fmt.Errorf("컬럼 수정 중 DB 오류: %w", err)
+		}
+	case "delete":
+		if stale {
+			return entity.BoardChangeConflict, nil
+		}
+		if err := tx.Delete(&column).Error; err != nil {
+			return "", fmt.Errorf("컬럼 삭제 중 DB 오류: %w", err)
+		}
+		if err := tx.Model(&model.BoardColumn{}).
+			Where("board_id = ? AND position > ?", boardID, column.Position).
+			Update("position", gorm.Expr("position - 1")).Error; err != nil {
+			return "", fmt.Errorf("컬럼 위치 조정 중 DB 오류: %w", err)
+		}
+	case "move":
+		// 이동은 이전 버전 기준이어도 현재 위치에 맞춰 적용
+		if err := moveBoardColumn(tx, &column, *change.Position); err != nil {
+			return "", fmt.Errorf("컬럼 이동 중 DB 오류: %w", err)
+		}
+		if err := tx.Model(&column).Update("version", gorm.Expr("version + 1")).Error; err != nil {
+			return "", fmt.Errorf("컬럼 버전 갱신 중 DB 오류: %w", err)
+		}
+		if stale {
+			return entity.BoardChangeMerged, nil
+		}
+	}
+
+	return entity.BoardChangeApplied, nil
+}
+
+func applyBoardCardChange(tx *gorm.DB, boardID uint, change entity.BoardChange) (string, error) {
+	var card model.BoardCard
+	exists, err := lockBoardRow(tx, &card, change.CardID, boardID)
+	if err != nil {
+		return "", fmt.Errorf("카드 조회 중 DB 오류: %w", err)
+	}
+
+	if change.Action == "create" {
+		if exists {
+			return entity.BoardChangeConflict, nil
+		}
+		// 다른 보드에서 쓰는 ID면 PK 충돌로 묶음 전체가 실패하므로 이 변경사항만 충돌 처리
+		if taken, err := boardRowIDTaken(tx, &card, change.CardID); err != nil || taken {
+			return entity.BoardChangeConflict, err
+		}
+
+		// 같은 변경사항 묶음에서 먼저 삭제된 컬럼일 수 있음
+		var column model.BoardColumn
+		columnExists, err := lockBoardRow(tx, &column, *change.ColumnID, boardID)
+		if err != nil {
+			return "", fmt.Errorf("컬럼 조회 중 DB 오류: %w", err)
+		}
+		if !columnExists {
+			return entity.BoardChangeConflict, nil
+		}
+		if full, err := isBoardColumnFull(tx, &column); err != nil || full {
+			return entity.BoardChangeConflict, err
+		}
+		if labelsExist, err := boardLabelsExist(tx, boardID, change.Labels); err != nil || !labelsExist {
+			return entity.BoardChangeConflict, err
+		}
+
+		var maxPosition struct {
+			MaxPos int
+		}
+		if err := tx.Model(&model.BoardCard{}).
+			Select("COALESCE(MAX(position), -1) as max_pos").
+			Where("board_column_id = ?", column.ID).
+			Scan(&maxPosition).Error; err != nil {
+			return "", fmt.Errorf("카드 위치 조회 중 DB 오류: %w", err)
+		}
+
+		card = model.BoardCard{
+			ID:            change.CardID,
+			BoardID:       boardID,
+			BoardColumnID: column.ID,
+			Name:          *change.Name,
+			Position:      uint(maxPosition.MaxPos + 1),
+			Version:       1,
+		}
+		if change.Content != nil {
+			card.Content = *change.Content
+		}
+		if change.StartDate != nil {
+			card.StartDate = *change.StartDate
+		}
+		if change.EndDate != nil {
+			card.EndDate = *change.EndDate
+		}
+		if err := tx.Create(&card).Error; err != nil {
+			return "", fmt.Errorf("카드 생성 중 DB 오류: %w", err)
+		}
+		if err := replaceCardAssignees(tx, card.ID, change.Assignees); err != nil {
+			return "", err
+		}
+		if err := replaceCardLabels(tx, card.ID, change.Labels); err != nil {
+			return "", err
+		}
+		return entity.BoardChangeApplied, nil
+	}
+
+	if !exists {
+		return entity.BoardChangeConflict, nil
+	}
+
+	stale := isStaleVersion(change.Version, card.Version)
+	switch change.Action {
+	case "update":
+		if isStaleCardUpdate(change, &card) {
+			return entity.BoardChangeConflict, nil
+		}
+		// 같은 묶음에서 먼저 삭제된 라벨일 수 있음
+		if labelsExist, err := boardLabelsExist(tx, boardID, change.Labels); err != nil || !labelsExist {
+			return entity.BoardChangeConflict, err
+		}
+		updates := map[string]interface{}{"version": gorm.Expr("version + 1")}
+		if change.Name != nil {
+			updates["name"] = *change.Name
+		}
+		if change.Content != nil {
+			updates["content"] = *change.Content
+			updates["content_version"] = gorm.Expr("content_version + 1")
+		}
+		if change.StartDate != nil {
+			updates["start_date"] = *change.StartDate
+		}
+		if change.EndDate != nil {
+			updates["end_date"] = *change.EndDate
+		}
+		if err := tx.Model(&card).Updates(updates).Error; err != nil {
+			return "", fmt.Errorf("카드 수정 중 DB 오류: %w", err)
+		}
+		// 빈 배열이면 담당자 전체 해제, 생략(nil)하면 그대로
+		if change.Assignees != nil {
+			if err := replaceCardAssignees(tx, card.ID, change.Assignees); err != nil {
+				return "", err
+			}
+		}
+		if change.Labels != nil {
+			if err := replaceCardLabels(tx, card.ID, change.Labels); err != nil {
+				return "", err
+			}
+		}
+	case "delete":
+		if stale {
+			return entity.BoardChangeConflict, nil
+		}
+		if err := tx.Delete(&card).Error; err != nil {
+			return "", fmt.Errorf("카드 삭제 중 DB 오류: %w", err)
+		}
+		if err := tx.Model(&model.BoardCard{}).
+			Where("board_column_id = ? AND position > ?", card.BoardColumnID, card.Position).
+			Update("position", gorm.Expr("position - 1")).Error; err != nil {
+			return "", fmt.Errorf("카드 위치 조정 중 DB 오류: %w", err)
+		}
+	case "move":
+		if change.ColumnID != nil && *change.ColumnID != card.BoardColumnID {
+			var column model.BoardColumn
+			columnExists, err := lockBoardRow(tx, &column, *change.ColumnID, boardID)
+			if err != nil {
+				return "", fmt.Errorf("컬럼 조회 중 DB 오류: %w", err)
+			}
+			if !columnExists {
+				return entity.BoardChangeConflict, nil
+			}
+			if full, err := isBoardColumnFull(tx, &column); err != nil || full {
+				return entity.BoardChangeConflict, err
+			}
+		}
+		if err := moveBoardCard(tx, &card, change.ColumnID, change.Position); err != nil {
+			return "", fmt.Errorf("카드 이동 중 DB 오류: %w", err)
+		}
+		if err := tx.Model(&card).Update("version", gorm.Expr("version + 1")).Error; err != nil {
+			return "", fmt.Errorf("카드 버전 갱신 중 DB 오류: %w", err)
+		}
+		if stale {
+			return entity.BoardChangeMerged, nil
+		}
+	}
+
+	return entity.BoardChangeApplied, nil
+}
+
+// WIP 제한(0이면 제한 없음)까지 카드가 찼는지 - 컬럼 row를 잠근 상태에서 호출해야 동시에 넣어도 넘지 않음
+func isBoardColumnFull(tx *gorm.DB, column *model.BoardColumn) (bool, error) {
+	if column.WipLimit == 0 {
+		return false, nil
+	}
+	var count int64
+	if err := tx.Model(&model.BoardCard{}).Where("board_column_id = ?", column.ID).Count(&count).Error; err != nil {
+		return false, fmt.Errorf("컬럼 카드 수 조회 중 DB 오류: %w", err)
+	}
+	return isWipLimitReached(column.WipLimit, count), nil
+}
+
+// 카드를 하나 더 넣으면 WIP 제한을 넘는지
+func isWipLimitReached(wipLimit uint, cardCount int64) bool {
+	return wipLimit > 0 && cardCount >= int64(wipLimit)
+}
+
+func replaceCardAssignees(tx *gorm.DB, cardID uuid.UUID, userIDs []uint) error {
+	if err := tx.Where("card_id = ?", cardID).Delete(&model.CardAssignee{}).Error; err != nil {
+		return fmt.Errorf("카드 담당자 삭제 중 DB 오류: %w", err)
+	}
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	assignees := make([]model.CardAssignee, len(userIDs))
+	for i, userID := range userIDs {
+		assignees[i] = model.CardAssignee{
+			CardID: cardID,
+			UserID: userID,
+		}
+	}
+	if err := tx.Create(&assignees).Error; err != nil {
+		return fmt.Errorf("카드 담당자 추가 중 DB 오류: %w", err)
+	}
+	return nil
+}
+
+// 컬럼 상태 조회 (없거나 삭제되었으면 nil)
+func findBoardColumn(tx *gorm.DB, boardID uint, columnID uuid.UUID) (*entity.BoardColumn, error) {
+	var column model.BoardColumn
+	if err := tx.Where("id = ? AND board_id = ?", columnID, boardID).First(&column).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("컬럼 조회 중 DB 오류: %w", err)
+	}
+
+	return &entity.BoardColumn{
+		ID:        column.ID,
+		Name:      column.Name,
+		BoardID:   column.BoardID,
+		Position:  column.Position,
+		WipLimit:  column.WipLimit,
+		Version:   column.Version,
+		CreatedAt: column.CreatedAt,
+		UpdatedAt: column.UpdatedAt,
+	}, nil
+}
+
+// 카드 상태 조회 (없거나 삭제되었으면 nil)
+func findBoardCard(tx *gorm.DB, boardID uint, cardID uuid.UUID) (*entity.BoardCard, error) {
+	var card model.BoardCard
+	if err := tx.Preload("Assignees").Where("id = ? AND board_id = ?", cardID, boardID).First(&card).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("카드 조회 중 DB 오류: %w", err)
+	}
+
+	cardEntity := &entity.BoardCard{
+		ID:             card.ID,
+		Name:           card.Name,
+		Content:        card.Content,
+		BoardID:        card.BoardID,
+		BoardColumnID:  card.BoardColumnID,
+		Position:       card.Position,
+		StartDate:      card.StartDate,
+		EndDate:        card.EndDate,
+		Version:        card.Version,
+		ContentVersion: card.ContentVersion,
+		CreatedAt:      card.CreatedAt,
+		UpdatedAt:      card.UpdatedAt,
+		Assignees:      make([]uint, len(card.Assignees)),
+	}
+	for i, assignee := range card.Assignees {
+		cardEntity.Assignees[i] = assignee.UserID
+	}
+
+	var cardLabels []model.CardLabel
+	if err := tx.Where("card_id = ?", cardID).Find(&cardLabels).Error; err != nil {
+		return nil, fmt.Errorf("카드 라벨 조회 중 DB 오류: %w", err)
+	}
+	cardEntity.Labels = make([]uuid.UUID, len(cardLabels))
+	for i, cardLabel := range cardLabels {
+		cardEntity.Labels[i] = cardLabel.LabelID
+	}
+
+	return cardEntity, nil
+}
+
+// 보드에 있는 라벨인지 확인 (같은 묶음에서 먼저 삭제된 라벨이면 false)
+func boardLabelsExist(tx *gorm.DB, boardID uint, labelIDs []uuid.UUID) (bool, error) {
+	if len(labelIDs) == 0 {
+		return true, nil
+	}
+
+	var count int64
+	if err := tx.Model(&model.BoardLabel{}).
+		Where("board_id = ? AND id IN ?", boardID, labelIDs).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("라벨 조회 중 DB 오류: %w", err)
+	}
+	return count == int64(len(labelIDs)), nil
+}
+
+func replaceCardLabels(tx *gorm.DB, cardID uuid.UUID, labelIDs []uuid.UUID) error {
+	if err := tx.Where("card_id = ?", cardID).Delete(&model.CardLabel{}).Error; err != nil {
+		return fmt.Errorf("카드 라벨 삭제 중 DB 오류: %w", err)
+	}
+	if len(labelIDs) == 0 {
+		return nil
+	}
+
+	cardLabels := make([]model.CardLabel, len(labelIDs))
+	for i, labelID := range labelIDs {
+		cardLabels[i] = model.CardLabel{
+			CardID:  cardID,
+			LabelID: labelID,
+		}
+	}
+	if err := tx.Create(&cardLabels).Error; err != nil {
+		return fmt.Errorf("카드 라벨 추가 중 DB 오류: %w", err)
+	}
+	return nil
+}
+
+func replaceCommentMentions(tx *gorm.DB, commentID uuid.UUID, userIDs []uint) error {
+	if err := tx.Where("comment_id = ?", commentID).Delete(&model.CardCommentMention{}).Error; err != nil {
+		return fmt.Errorf("댓글 멘션 삭제 중 DB 오류: %w", err)
+	}
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	mentions := make([]model.CardCommentMention, len(userIDs))
+	for i, userID := range userIDs {
+		mentions[i] = model.CardCommentMention{
+			CommentID: commentID,
+			UserID:    userID,
+		}
+	}
+	if err := tx.Create(&mentions).Error; err != nil {
+		return fmt.Errorf("댓글 멘션 추가 중 DB 오류: %w", err)
+	}
+	return nil
+}
+
+// 같은 부모(카드, 체크리스트) 안에서 마지막 다음 위치
+func nextSiblingPosition(tx *gorm.DB, table interface{}, parentColumn string, parentID uuid.UUID) (uint, error) {
+	var maxPosition struct {
+		MaxPos int
+	}
+	if err := tx.Model(table).
+		Select("COALESCE(MAX(position), -1) as max_pos").
+		Where(parentColumn+" = ?", parentID).
+		Scan(&maxPosition).Error; err != nil {
+		return 0, err
+	}
+	return uint(maxPosition.MaxPos + 1), nil
+}
+
+// 같은 부모 안에서 위치 이동 - 옆의 항목들도 밀려남 (범위를 넘으면 마지막으로)
+func moveSiblingPosition(tx *gorm.DB, table interface{}, parentColumn string, parentID uuid.UUID, id uuid.UUID, currentPosition uint, newPosition uint) error {
+	var count int64
+	if err := tx.Model(table).Where(parentColumn+" = ?", parentID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 && newPosition > uint(count-1) {
+		newPosition = uint(count - 1)
+	}
+	if currentPosition == newPosition {
+		return nil
+	}
+
+	if currentPosition < newPosition {
+		if err := tx.Model(table).
+			Where(parentColumn+" = ? AND position > ? AND position <= ?", parentID, currentPosition, newPosition).
+			Update("position", gorm.Expr("position - 1")).Error; err != nil {
+			return err
+		}
+	} else {
+		if err := tx.Model(table).
+			Where(parentColumn+" = ? AND position >= ? AND position < ?", parentID, newPosition, currentPosition).
+			Update("position", gorm.Expr("position + 1")).Error; err != nil {
+			return err
+		}
+	}
+
+	return tx.Model(table).Where("id = ?", id).Update("position", newPosition).Error
+}
+
+// 삭제 후 뒤의 항목들을 한 칸씩 당김
+func compactSiblingPositions(tx *gorm.DB, table interface{}, parentColumn string, parentID uuid.UUID, removedPosition uint) error {
+	return tx.Model(table).
+		Where(parentColumn+" = ? AND position > ?", parentID, removedPosition).
+		Update("position", gorm.Expr("position - 1")).Error
+}
+
+func applyBoardLabelChange(tx *gorm.DB, boardID uint, change entity.BoardChange) (string, error) {
+	var label model.BoardLabel
+	exists, err := lockBoardRow(tx, &label, change.TargetID, boardID)
+	if err != nil {
+		return "", fmt.Errorf("라벨 조회 중 DB 오류: %w", err)
+	}
+
+	if change.Action == "create" {
+		if exists {
+			return entity.BoardChangeConflict, nil
+		}
+		// 다른 보드에서 쓰는 ID면 PK 충돌로 묶음 전체가 실패하므로 이 변경사항만 충돌 처리
+		if taken, err := boardRowIDTaken(tx, &label, change.TargetID); err != nil || taken {
+			return entity.BoardChangeConflict, err
+		}
+
+		label = model.BoardLabel{
+			ID:      change.TargetID,
+			BoardID: boardID,
+			Name:    *change.Name,
+			Color:   *change.Color,
+			Version: 1,
+		}
+		if err := tx.Create(&label).Error; err != nil {
+			return "", fmt.Errorf("라벨 생성 중 DB 오류: %w", err)
+		}
+		return entity.BoardChangeApplied, nil
+	}
+
+	if !exists || isStaleVersion(change.Version, label.Version) {
+		return entity.BoardChangeConflict, nil
+	}
+
+	switch change.Action {
+	case "update":
+		updates := map[string]interface{}{"version": gorm.Expr("version + 1")}
+		if change.Name != nil {
+			updates["name"] = *change.Name
+		}
+		if change.Color != nil {
+			updates["color"] = *change.Color
+		}
+		if err := tx.Model(&label).Updates(updates).Error; err != nil {
+			return "", fmt.Errorf("라벨 수정 중 DB 오류: %w", err)
+		}
+	case "delete":
+		// 라벨을 지우면 카드에서도 빠짐
+		if err := tx.Where("label_id = ?", label.ID).Delete(&model.CardLabel{}).Error; err != nil {
+			return "", fmt.Errorf("카드 라벨 삭제 중 DB 오류: %w", err)
+		}
+		if err := tx.Delete(&label).Error; err != nil {
+			return "", fmt.Errorf("라벨 삭제 중 DB 오류: %w", err)
+		}
+	}
+
+	return entity.BoardChangeApplied, nil
+}
+
+func applyBoardCommentChange(tx *gorm.DB, boardID uint, change entity.BoardChange) (string, error) {
+	var comment model.BoardCardComment
+	exists, err := lockBoardRow(tx, &comment, change.TargetID, boardID)
+	if err != nil {
+		return "", fmt.Errorf("댓글 조회 중 DB 오류: %w", err)
+	}
+
+	if change.Action == "create" {
+		if exists {
+			return entity.BoardChangeConflict, nil
+		}
+		// 다른 보드에서 쓰는 ID면 PK 충돌로 묶음 전체가 실패하므로 이 변경사항만 충돌 처리
+		if taken, err := boardRowIDTaken(tx, &comment, change.TargetID); err != nil || taken {
+			return entity.BoardChangeConflict, err
+		}
+
+		var card model.BoardCard
+		cardExists, err := lockBoardRow(tx, &card, change.CardID, boardID)
+		if err != nil {
+			return "", fmt.Errorf("카드 조회 중 DB 오류: %w", err)
+		}
+		if !cardExists {
+			return entity.BoardChangeConflict, nil
+		}
+
+		parentID := change.ParentID
+		if parentID != nil {
+			var parent model.BoardCardComment
+			parentExists, err := lockBoardRow(tx, &parent, *parentID, boardID)
+			if err != nil {
+				return "", fmt.Errorf("댓글 조회 중 DB 오류: %w", err)
+			}
+			if !parentExists || parent.CardID != card.ID {
+				return entity.BoardChangeConflict, nil
+			}
+			// 답글에 단 답글은 원 댓글의 답글로
+			if parent.ParentID != nil {
+				parentID = parent.ParentID
+			}
+		}
+
+		comment = model.BoardCardComment{
+			ID:       change.TargetID,
+			CardID:   card.ID,
+			BoardID:  boardID,
+			ParentID: parentID,
+			UserID:   change.UserID,
+			Content:  *change.Content,
+			Version:  1,
+		}
+		if err := tx.Create(&comment).Error; err != nil {
+			return "", fmt.Errorf("댓글 생성 중 DB 오류: %w", err)
+		}
+		if err := replaceCommentMentions(tx, comment.ID, change.Mentions); err != nil {
+			return "", err
+		}
+		return entity.BoardChangeApplied, nil
+	}
+
+	if !exists || isStaleVersion(change.Version, comment.Version) {
+		return entity.BoardChangeConflict, nil
+	}
+
+	switch change.Action {
+	case "update":
+		updates := map[string]interface{}{"version": gorm.Expr("version + 1")}
+		if change.Content != nil {
+			updates["content"] = *change.Content
+		}
+		if err := tx.Model(&comment).Updates(updates).Error; err != nil {
+			return "", fmt.Errorf("댓글 수정 중 DB 오류: %w", err)
+		}
+		if change.Mentions != nil {
+			if err := replaceCommentMentions(tx, comment.ID, change.Mentions); err != nil {
+				return "", err
+			}
+		}
+	case "delete":
+		// 답글도 함께 삭제
+		var commentIDs []uuid.UUID
+		if err := tx.Model(&model.BoardCardComment{}).
+			Where("parent_id = ?", comment.ID).
+			Pluck("id", &commentIDs).Error; err != nil {
+			return "", fmt.Errorf("답글 조회 중 DB 오류: %w", err)
+		}
+		commentIDs = append(commentIDs, comment.ID)
+
+		if err := tx.Where("comment_id IN ?", commentIDs).Delete(&model.CardCommentMention{}).Error; err != nil {
+			return "", fmt.Errorf("댓글 멘션 삭제 중 DB 오류: %w", err)
+		}
+		if err := tx.Where("id IN ?", commentIDs).Delete(&model.BoardCardComment{}).Error; err != nil {
+			return "", fmt.Errorf("댓글 삭제 중 DB 오류: %w", err)
+		}
+	}
+
+	return entity.BoardChangeApplied, nil
+}
+
+func applyBoardChecklistChange(tx *gorm.DB, boardID uint, change entity.BoardChange) (string, error) {
+	var checklist model.BoardCardChecklist
+	exists, err := lockBoardRow(tx, &checklist, change.TargetID, boardID)
+	if err != nil {
+		return "", fmt.Errorf("체크리스트 조회 중 DB 오류: %w", err)
+	}
+
+	if change.Action == "create" {
+		if exists {
+			return entity.BoardChangeConflict, nil
+		}
+		// 다른 보드에서 쓰는 ID면 PK 충돌로 묶음 전체가 실패하므로 이 변경사항만 충돌 처리
+		if taken, err := boardRowIDTaken(tx, &checklist, change.TargetID); err != nil || taken {
+			return entity.BoardChangeConflict, err
+		}
+
+		var card model.BoardCard
+		cardExists, err := lockBoardRow(tx, &card, change.CardID, boardID)
+		if err != nil {
+			return "", fmt.Errorf("카드 조회 중 DB 오류: %w", err)
+		}
+		if !cardExists {
+			return entity.BoardChangeConflict, nil
+		}
+
+		position, err := nextSiblingPosition(tx, &model.BoardCardChecklist{}, "card_id", card.ID)
+		if err != nil {
+			return "", fmt.Errorf("체크리스트 위치 조회 중 DB 오류: %w", err)
+		}
+
+		checklist = model.BoardCardChecklist{
+			ID:       change.TargetID,
+			CardID:   card.ID,
+			BoardID:  boardID,
+			Name:     *change.Name,
+			Position: position,
+			Version:  1,
+		}
+		if err := tx.Create(&checklist).Error; err != nil {
+			return "", fmt.Errorf("체크리스트 생성 중 DB 오류: %w", err)
+		}
+		return entity.BoardChangeApplied, nil
+	}
+
+	if !exists {
+		return entity.BoardChangeConflict, nil
+	}
+
+	stale := isStaleVersion(change.Version, checklist.Version)
+	switch change.Action {
+	case "update":
+		if stale {
+			return entity.BoardChangeConflict, nil
+		}
+		updates := map[string]interface{}{"version": gorm.Expr("version + 1")}
+		if change.Name != nil {
+			updates["name"] = *change.Name
+		}
+		if err := tx.Model(&checklist).Updates(updates).Error; err != nil {
+			return "", fmt.Errorf("체크리스트 수정 중 DB 오류: %w", err)
+		}
+	case "delete":
+		if stale {
+			return entity.BoardChangeConflict, nil
+		}
+		if err := tx.Where("checklist_id = ?", checklist.ID).Delete(&model.BoardCardChecklistItem{}).Error; err != nil {
+			return "", fmt.Errorf("체크리스트 항목 삭제 중 DB 오류: %w", err)
+		}
+		if err := tx.Delete(&checklist).Error; err != nil {
+			return "", fmt.Errorf("체크리스트 삭제 중 DB 오류: %w", err)
+		}
+		if err := compactSiblingPositions(tx, &model.BoardCardChecklist{}, "card_id", checklist.CardID, checklist.Position); err != nil {
+			return "", fmt.Errorf("체크리스트 위치 조정 중 DB 오류: %w", err)
+		}
+	case "move":
+		if err := moveSiblingPosition(tx, &model.BoardCardChecklist{}, "card_id", checklist.CardID, checklist.ID, checklist.Position, *change.Position); err != nil {
+			return "", fmt.Errorf("체크리스트 이동 중 DB 오류: %w", err)
+		}
+		if err := tx.Model(&checklist).Update("version", gorm.Expr("version + 1")).Error; err != nil {
+			return "", fmt.Errorf("체크리스트 버전 갱신 중 DB 오류: %w", err)
+		}
+		if stale {
+			return entity.BoardChangeMerged, nil
+		}
+	}
+
+	return entity.BoardChangeApplied, nil
+}
+
+func applyBoardChecklistItemChange(tx *gorm.DB, boardID uint, change entity.BoardChange) (string, error) {
+	var item model.BoardCardChecklistItem
+	exists, err := lockBoardRow(tx, &item, change.TargetID, boardID)
+	if err != nil {
+		return "", fmt.Errorf("체크리스트 항목 조회 중 DB 오류: %w", err)
+	}
+
+	if change.Action == "create" {
+		if exists {
+			return entity.BoardChangeConflict, nil
+		}
+		// 다른 보드에서 쓰는 ID면 PK 충돌로 묶음 전체가 실패하므로 이 변경사항만 충돌 처리
+		if taken, err := boardRowIDTaken(tx, &item, change.TargetID); err != nil || taken {
+			return entity.BoardChangeConflict, err
+		}
+
+		var checklist model.BoardCardChecklist
+		checklistExists, err := lockBoardRow(tx, &checklist, *change.ChecklistID, boardID)
+		if err != nil {
+			return "", fmt.Errorf("체크리스트 조회 중 DB 오류: %w", err)
+		}
+		if !checklistExists {
+			return entity.BoardChangeConflict, nil
+		}
+
+		position, err := nextSiblingPosition(tx, &model.BoardCardChecklistItem{}, "checklist_id", checklist.ID)
+		if err != nil {
+			return "", fmt.Errorf("체크리스트 항목 위치 조회 중 DB 오류: %w", err)
+		}
+
+		item = model.BoardCardChecklistItem{
+			ID:          change.TargetID,
+			ChecklistID: checklist.ID,
+			CardID:      checklist.CardID,
+			BoardID:     boardID,
+			Content:     *change.Content,
+			Position:    position,
+			Version:     1,
+		}
+		if change.Checked != nil {
+			item.Checked = *change.Checked
+		}
+		if err := tx.Create(&item).Error; err != nil {
+			return "", fmt.Errorf("체크리스트 항목 생성 중 DB 오류: %w", err)
+		}
+		return entity.BoardChangeApplied, nil
+	}
+
+	if !exists {
+		return entity.BoardChangeConflict, nil
+	}
+
+	stale := isStaleVersion(change.Version, item.Version)
+	switch change.Action {
+	case "update":
+		if stale {
+			return entity.BoardChangeConflict, nil
+		}
+		updates := map[string]interface{}{"version": gorm.Expr("version + 1")}
+		if change.Content != nil {
+			updates["content"] = *change.Content
+		}
+		if change.Checked != nil {
+			updates["checked"] = *change.Checked
+		}
+		if err := tx.Model(&item).Updates(updates).Error; err != nil {
+			return "", fmt.Errorf("체크리스트 항목 수정 중 DB 오류: %w", err)
+		}
+	case "delete":
+		if stale {
+			return entity.BoardChangeConflict, nil
+		}
+		if err := tx.Delete(&item).Error; err != nil {
+			return "", fmt.Errorf("체크리스트 항목 삭제 중 DB 오류: %w", err)
+		}
+		if err := compactSiblingPositions(tx, &model.BoardCardChecklistItem{}, "checklist_id", item.ChecklistID, item.Position); err != nil {
+			return "", fmt.Errorf("체크리스트 항목 위치 조정 중 DB 오류: %w", err)
+		}
+	case "move":
+		if change.ChecklistID != nil && *change.ChecklistID != item.ChecklistID {
+			// 다른 체크리스트로 이동 (다른 카드의 체크리스트도 가능)
+			var checklist model.BoardCardChecklist
+			checklistExists, err := lockBoardRow(tx, &checklist, *change.ChecklistID, boardID)
+			if err != nil {
+				return "", fmt.Errorf("체크리스트 조회 중 DB 오류: %w", err)
+			}
+			if !checklistExists {
+				return entity.BoardChangeConflict, nil
+			}
+			if err := moveChecklistItemToChecklist(tx, &item, &checklist, change.Position); err != nil {
+				return "", fmt.Errorf("체크리스트 항목 이동 중 DB 오류: %w", err)
+			}
+		} else if change.Position != nil {
+			if err := moveSiblingPosition(tx, &model.BoardCardChecklistItem{}, "checklist_id", item.ChecklistID, item.ID, item.Position, *change.Position); err != nil {
+				return "", fmt.Errorf("체크리스트 항목 이동 중 DB 오류: %w", err)
+			}
+		}
+		if err := tx.Model(&item).Update("version", gorm.Expr("version + 1")).Error; err != nil {
+			return "", fmt.Errorf("체크리스트 항목 버전 갱신 중 DB 오류: %w", err)
+		}
+		if stale {
+			return entity.BoardChangeMerged, nil
+		}
+	}
+
+	return entity.BoardChangeApplied, nil
+}
+
+// 항목을 다른 체크리스트로 옮김 - 위치가 없거나 범위를 넘으면 마지막으로
+func moveChecklistItemToChecklist(tx *gorm.DB, item *model.BoardCardChecklistItem, checklist *model.BoardCardChecklist, newPosition *uint) error {
+	if err := compactSiblingPositions(tx, &model.BoardCardChecklistItem{}, "checklist_id", item.ChecklistID, item.Position); err != nil {
+		return err
+	}
+
+	position, err := nextSiblingPosition(tx, &model.BoardCardChecklistItem{}, "checklist_id", checklist.ID)
+	if err != nil {
+		return err
+	}
+	if newPosition != nil && *newPosition < position {
+		position = *newPosition
+		if err := tx.Model(&model.BoardCardChecklistItem{}).
+			Where("checklist_id = ? AND position >= ?", checklist.ID, position).
+			Update("position", gorm.Expr("position + 1")).Error; err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Model(item).Updates(map[string]interface{}{
+		"checklist_id": checklist.ID,
+		"card_id":      checklist.CardID,
+		"position":     position,
+	}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func applyBoardAttachmentChange(tx *gorm.DB, boardID uint, change entity.BoardChange) (string, error) {
+	var attachment model.BoardCardAttachment
+	exists, err := lockBoardRow(tx, &attachment, change.TargetID, boardID)
+	if err != nil {
+		return "", fmt.Errorf("첨부 파일 조회 중 DB 오류: %w", err)
+	}
+
+	if change.Action == "create" {
+		if exists {
+			return entity.BoardChangeConflict, nil
+		}
+		// 다른 보드에서 쓰는 ID면 PK 충돌로 묶음 전체가 실패하므로 이 변경사항만 충돌 처리
+		if taken, err := boardRowIDTaken(tx, &attachment, change.TargetID); err != nil || taken {
+			return entity.BoardChangeConflict, err
+		}
+
+		var card model.BoardCard
+		cardExists, err := lockBoardRow(tx, &card, change.CardID, boardID)
+		if err != nil {
+			return "", fmt.Errorf("카드 조회 중 DB 오류: %w", err)
+		}
+		if !cardExists {
+			return entity.BoardChangeConflict, nil
+		}
+
+		attachment = model.BoardCardAttachment{
+			ID:         change.TargetID,
+			CardID:     card.ID,
+			BoardID:    boardID,
+			URL:        *change.URL,
+			Name:       *change.Name,
+			UploadedBy: change.UserID,
+		}
+		if change.Size != nil {
+			attachment.Size = *change.Size
+		}
+		if change.ContentType != nil {
+			attachment.ContentType = *change.ContentType
+		}
+		if err := tx.Create(&attachment).Error; err != nil {
+			return "", fmt.Errorf("첨부 파일 생성 중 DB 오류: %w", err)
+		}
+		return entity.BoardChangeApplied, nil
+	}
+
+	// 첨부 파일은 버전 없이 이름 변경, 삭제만 가능
+	if !exists {
+		return entity.BoardChangeConflict, nil
+	}
+
+	switch change.Action {
+	case "update":
+		if change.Name != nil {
+			if err := tx.Model(&attachment).Update("name", *change.Name).Error; err != nil {
+				return "", fmt.Errorf("첨부 파일 수정 중 DB 오류: %w", err)
+			}
+		}
+	case "delete":
+		if err := tx.Delete(&attachment).Error; err != nil {
+			return "", fmt.Errorf("첨부 파일 삭제 중 DB 오류: %w", err)
+		}
+	}
+
+	return entity.BoardChangeApplied, nil
+}
+
+// 라벨 상태 조회 (없거나 삭제되었으면 nil)
+func findBoardLabel(tx *gorm.DB, boardID uint, labelID uuid.UUID) (*entity.BoardLabel, error) {
+	var label model.BoardLabel
+	if err := tx.Where("id = ? AND board_id = ?", labelID, boardID).First(&label).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("라벨 조회 중 DB 오류: %w", err)
+	}
+
+	labelEntity := toBoardLabelEntity(label)
+	return &labelEntity, nil
+}
+
+func findBoardCardComment(tx *gorm.DB, boardID uint, commentID uuid.UUID) (*entity.BoardCardComment, error) {
+	var comment model.BoardCardComment
+	if err := tx.Preload("Mentions").Where("id = ? AND board_id = ?", commentID, boardID).First(&comment).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("댓글 조회 중 DB 오류: %w", err)
+	}
+
+	commentEntity := toBoardCardCommentEntity(comment)
+	return &commentEntity, nil
+}
+
+func findBoardCardChecklist(tx *gorm.DB, boardID uint, checklistID uuid.UUID) (*entity.BoardCardChecklist, error) {
+	var checklist model.BoardCardChecklist
+	if err := tx.Preload("Items", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position ASC")
+	}).Where("id = ? AND board_id = ?", checklistID, boardID).First(&checklist).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("체크리스트 조회 중 DB 오류: %w", err)
+	}
+
+	checklistEntity := toBoardCardChecklistEntity(checklist)
+	return &checklistEntity, nil
+}
+
+func findBoardCardChecklistItem(tx *gorm.DB, boardID uint, itemID uuid.UUID) (*entity.BoardCardChecklistItem, error) {
+	var item model.BoardCardChecklistItem
+	if err := tx.Where("id = ? AND board_id = ?", itemID, boardID).First(&item).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("체크리스트 항목 조회 중 DB 오류: %w", err)
+	}
+
+	itemEntity := toBoardCardChecklistItemEntity(item)
+	return &itemEntity, nil
+}
+
+func findBoardCardAttachment(tx *gorm.DB, boardID uint, attachmentID uuid.UUID) (*entity.BoardCardAttachment, error) {
+	var attachment model.BoardCardAttachment
+	if err := tx.Where("id = ? AND board_id = ?", attachmentID, boardID).First(&attachment).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("첨부 파일 조회 중 DB 오류: %w", err)
+	}
+
+	attachmentEntity := toBoardCardAttachmentEntity(attachment)
+	return &attachmentEntity, nil
+}
+
+func toBoardLabelEntity(label model.BoardLabel) entity.BoardLabel {
+	return entity.BoardLabel{
+		ID:        label.ID,
+		BoardID:   label.BoardID,
+		Name:      label.Name,
+		Color:     label.Color,
+		Version:   label.Version,
+		CreatedAt: label.CreatedAt,
+		UpdatedAt: label.UpdatedAt,
+	}
+}
+
+func toBoardCardCommentEntity(comment model.BoardCardComment) entity.BoardCardComment {
+	commentEntity := entity.BoardCardComment{
+		ID:        comment.ID,
+		CardID:    comment.CardID,
+		BoardID:   comment.BoardID,
+		ParentID:  comment.ParentID,
+		UserID:    comment.UserID,
+		Content:   comment.Content,
+		Mentions:  make([]uint, len(comment.Mentions)),
+		Version:   comment.Version,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+	}
+	for i, mention := range comment.Mentions {
+		commentEntity.Mentions[i] = mention.UserID
+	}
+	return commentEntity
+}
+
+func toBoardCardChecklistEntity(checklist model.BoardCardChecklist) entity.BoardCardChecklist {
+	checklistEntity := entity.BoardCardChecklist{
+		ID:        checklist.ID,
+		CardID:    checklist.CardID,
+		BoardID:   checklist.BoardID,
+		Name:      checklist.Name,
+		Position:  checklist.Position,
+		Version:   checklist.Version,
+		CreatedAt: checklist.CreatedAt,
+		UpdatedAt: checklist.UpdatedAt,
+		Items:     make([]entity.BoardCardChecklistItem, len(checklist.Items)),
+	}
+	for i, item := range checklist.Items {
+		checklistEntity.Items[i] = toBoardCardChecklistItemEntity(item)
+	}
+	return checklistEntity
+}
+
+func toBoardCardChecklistItemEntity(item model.BoardCardChecklistItem) entity.BoardCardChecklistItem {
+	return entity.BoardCardChecklistItem{
+		ID:          item.ID,
+		ChecklistID: item.ChecklistID,
+		CardID:      item.CardID,
+		BoardID:     item.BoardID,
+		Content:     item.Content,
+		Checked:     item.Checked,
+		Position:    item.Position,
+		Version:     item.Version,
+		CreatedAt:   item.CreatedAt,
+		UpdatedAt:   item.UpdatedAt,
+	}
+}
+
+func toBoardCardAttachmentEntity(attachment model.BoardCardAttachment) entity.BoardCardAttachment {
+	return entity.BoardCardAttachment{
+		ID:          attachment.ID,
+		CardID:      attachment.CardID,
+		BoardID:     attachment.BoardID,
+		URL:         attachment.URL,
+		Name:        attachment.Name,
+		Size:        attachment.Size,
+		ContentType: attachment.ContentType,
+		UploadedBy:  attachment.UploadedBy,
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
+
+// ! 카드 라벨, 댓글, 체크리스트, 첨부 파일 관련 (칸반보드 조회용 - 보드 단위로 한 번에 조회)
+func (p *BoardPersistence) GetBoardLabels(boardID uint) ([]entity.BoardLabel, error) {
+	var labels []model.BoardLabel
+	if err := p.db.Where("board_id = ?", boardID).Order("created_at ASC").Find(&labels).Error; err != nil {
+		return nil, fmt.Errorf("라벨 조회 중 DB 오류: %w", err)
+	}
+
+	labelEntities := make([]entity.BoardLabel, len(labels))
+	for i, label := range labels {
+		labelEntities[i] = toBoardLabelEntity(label)
+	}
+	return labelEntities, nil
+}
+
+// 카드별 라벨 ID
+func (p *BoardPersistence) GetBoardCardLabels(boardID uint) (map[uuid.UUID][]uuid.UUID, error) {
+	var cardLabels []model.CardLabel
+	if err := p.db.Select("card_labels.card_id, card_labels.label_id").
+		Joins("JOIN board_cards ON board_cards.id = card_labels.card_id").
+		Where("board_cards.board_id = ?", boardID).
+		Find(&cardLabels).Error; err != nil {
+		return nil, fmt.Errorf("카드 라벨 조회 중 DB 오류: %w", err)
+	}
+
+	labelsByCard := make(map[uuid.UUID][]uuid.UUID)
+	for _, cardLabel := range cardLabels {
+		labelsByCard[cardLabel.CardID] = append(labelsByCard[cardLabel.CardID], cardLabel.LabelID)
+	}
+	return labelsByCard, nil
+}
+
+// 작성 순서대로 조회
+func (p *BoardPersistence) GetBoardCardComments(boardID uint) ([]entity.BoardCardComment, error) {
+	var comments []model.BoardCardComment
+	if err := p.db.Preload("Mentions").Where("board_id = ?", boardID).Order("created_at ASC").Find(&comments).Error; err != nil {
+		return nil, fmt.Errorf("댓글 조회 중 DB 오류: %w", err)
+	}
+
+	commentEntities := make([]entity.BoardCardComment, len(comments))
+	for i, comment := range comments {
+		commentEntities[i] = toBoardCardCommentEntity(comment)
+	}
+	return commentEntities, nil
+}
+
+func (p *BoardPersistence) GetBoardCardCommentsByIDs(boardID uint, commentIDs []uuid.UUID) ([]entity.BoardCardComment, error) {
+	var comments []model.BoardCardComment
+	if err := p.db.Preload("Mentions").Where("board_id = ? AND id IN ?", boardID, commentIDs).Find(&comments).Error; err != nil {
+		return nil, fmt.Errorf("댓글 조회 중 DB 오류: %w", err)
+	}
+
+	commentEntities := make([]entity.BoardCardComment, len(comments))
+	for i, comment := range comments {
+		commentEntities[i] = toBoardCardCommentEntity(comment)
+	}
+	return commentEntities, nil
+}
+
+func (p *BoardPersistence) GetBoardCardChecklists(boardID uint) ([]entity.BoardCardChecklist, error) {
+	var checklists []model.BoardCardChecklist
+	if err := p.db.Preload("Items", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position ASC")
+	}).Where("board_id = ?", boardID).Order("position ASC").Find(&checklists).Error; err != nil {
+		return nil, fmt.Errorf("체크리스트 조회 중 DB 오류: %w", err)
+	}
+
+	checklistEntities := make([]entity.BoardCardChecklist, len(checklists))
+	for i, checklist := range checklists {
+		checklistEntities[i] = toBoardCardChecklistEntity(checklist)
+	}
+	return checklistEntities, nil
+}
+
+func (p *BoardPersistence) GetBoardCardAttachments(boardID uint) ([]entity.BoardCardAttachment, error) {
+	var attachments []model.BoardCardAttachment
+	if err := p.db.Where("board_id = ?", boardID).Order("created_at ASC").Find(&attachments).Error; err != nil {
+		return nil, fmt.Errorf("첨부 파일 조회 중 DB 오류: %w", err)
+	}
+
+	attachmentEntities := make([]entity.BoardCardAttachment, len(attachments))
+	for i, attachment := range attachments {
+		attachmentEntities[i] = toBoardCardAttachmentEntity(attachment)
+	}
+	return attachmentEntities, nil
+}
+
+// ! 보드 템플릿 관련
+func (p *BoardPersistence) CreateBoardTemplate(template *entity.BoardTemplate) error {
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		templateModel := model.BoardTemplate{
+			CompanyID:   template.CompanyID,
+			Name:        template.Name,
+			Description: template.Description,
+			CreatedBy:   template.CreatedBy,
+		}
+		if err := tx.Omit(clause.Associations).Create(&templateModel).Error; err != nil {
+			return fmt.Errorf("보드 템플릿 생성 중 DB 오류: %w", err)
+		}
+
+		columns := make([]model.BoardTemplateColumn, len(template.Columns))
+		for i, column := range template.Columns {
+			columns[i] = model.BoardTemplateColumn{
+				ID:         column.ID,
+				TemplateID: templateModel.ID,
+				Name:       column.Name,
+				Position:   column.Position,
+				WipLimit:   column.WipLimit,
+			}
+		}
+		if len(columns) > 0 {
+			if err := tx.Create(&columns).Error; err != nil {
+				return fmt.Errorf("보드 템플릿 컬럼 생성 중 DB 오류: %w", err)
+			}
+		}
+
+		labels := make([]model.BoardTemplateLabel, len(template.Labels))
+		for i, label := range template.Labels {
+			labels[i] = model.BoardTemplateLabel{
+				ID:         label.ID,
+				TemplateID: templateModel.ID,
+				Name:       label.Name,
+				Color:      label.Color,
+			}
+		}
+		if len(labels) > 0 {
+			if err := tx.Create(&labels).Error; err != nil {
+				return fmt.Errorf("보드 템플릿 라벨 생성 중 DB 오류: %w", err)
+			}
+		}
+
+		cards := make([]model.BoardTemplateCard, len(template.Cards))
+		cardLabels := []model.BoardTemplateCardLabel{}
+		for i, card := range template.Cards {
+			cards[i] = model.BoardTemplateCard{
+				ID:         card.ID,
+				TemplateID: templateModel.ID,
+				ColumnID:   card.ColumnID,
+				Name:       card.Name,
+				Content:    card.Content,
+				Position:   card.Position,
+			}
+			for _, labelID := range card.Labels {
+				cardLabels = append(cardLabels, model.BoardTemplateCardLabel{CardID: card.ID, LabelID: labelID})
+			}
+		}
+		if len(cards) > 0 {
+			if err := tx.Omit(clause.Associations).Create(&cards).Error; err != nil {
+				return fmt.Errorf("보드 템플릿 카드 생성 중 DB 오류: %w", err)
+			}
+		}
+		if len(cardLabels) > 0 {
+			if err := tx.Omit(clause.Associations).Create(&cardLabels).Error; err != nil {
+				return fmt.Errorf("보드 템플릿 카드 라벨 생성 중 DB 오류: %w", err)
+			}
+		}
+
+		template.ID = templateModel.ID
+		template.CreatedAt = templateModel.CreatedAt
+		template.UpdatedAt = templateModel.UpdatedAt
+		return nil
+	})
+}
+
+// 최근 생성 순서
+func (p *BoardPersistence) GetBoardTemplatesByCompanyID(companyID uint) ([]entity.BoardTemplate, error) {
+	var templates []model.BoardTemplate
+	if err := preloadBoardTemplate(p.db).Where("company_id = ?", companyID).Order("created_at DESC").Find(&templates).Error; err != nil {
+		return nil, fmt.Errorf("보드 템플릿 조회 중 DB 오류: %w", err)
+	}
+
+	templateEntities := make([]entity.BoardTemplate, len(templates))
+	for i, template := range templates {
+		templateEntities[i] = toBoardTemplateEntity(template)
+	}
+	return templateEntities, nil
+}
+
+// 템플릿 조회 (없으면 nil)
+func (p *BoardPersistence) GetBoardTemplateByID(templateID uint) (*entity.BoardTemplate, error) {
+	var template model.BoardTemplate
+	if err := preloadBoardTemplate(p.db).Where("id = ?", templateID).First(&template).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("보드 템플릿 조회 중 DB 오류: %w", err)
+	}
+
+	templateEntity := toBoardTemplateEntity(template)
+	return &templateEntity, nil
+}
+
+func (p *BoardPersistence) DeleteBoardTemplate(templateID uint) error {
+	if err := p.db.Where("id = ?", templateID).Delete(&model.BoardTemplate{}).Error; err != nil {
+		return fmt.Errorf("보드 템플릿 삭제 중 DB 오류: %w", err)
+	}
+	return nil
+}
+
+func preloadBoardTemplate(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Columns", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
+		Preload("Labels", func(db *gorm.DB) *gorm.DB {
+			return db.Order("name ASC")
+		}).
+		Preload("Cards", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
+		Preload("Cards.Labels")
+}
+
+func toBoardTemplateEntity(template model.BoardTemplate) entity.BoardTemplate {
+	templateEntity := entity.BoardTemplate{
+		ID:          template.ID,
+		CompanyID:   template.CompanyID,
+		Name:        template.Name,
+		Description: template.Description,
+		CreatedBy:   template.CreatedBy,
+		CreatedAt:   template.CreatedAt,
+		UpdatedAt:   template.UpdatedAt,
+		Columns:     make([]entity.BoardTemplateColumn, len(template.Columns)),
+		Labels:      make([]entity.BoardTemplateLabel, len(template.Labels)),
+		Cards:       make([]entity.BoardTemplateCard, len(template.Cards)),
+	}
+	for i, column := range template.Columns {
+		templateEntity.Columns[i] = entity.BoardTemplateColumn{
+			ID:       column.ID,
+			Name:     column.Name,
+			Position: column.Position,
+			WipLimit: column.WipLimit,
+		}
+	}
+	for i, label := range template.Labels {
+		templateEntity.Labels[i] = entity.BoardTemplateLabel{
+			ID:    label.ID,
+			Name:  label.Name,
+			Color: label.Color,
+		}
+	}
+	for i, card := range template.Cards {
+		templateEntity.Cards[i] = entity.BoardTemplateCard{
+			ID:       card.ID,
+			ColumnID: card.ColumnID,
+			Name:     card.Name,
+			Content:  card.Content,
+			Position: card.Position,
+			Labels:   make([]uuid.UUID, len(card.Labels)),
+		}
+		for j, cardLabel := range card.Labels {
+			templateEntity.Cards[i].Labels[j] = cardLabel.LabelID
+		}
+	}
+	return templateEntity
+}
+
+// ! 카드 협업 편집 관련
+// redis에 카드별 문서(hash)와 최근 연산(list)을 두고, 바뀐 카드는 dirty SET에 모아 주기적으로 postgres에 스냅샷 저장
+//
+//	board:card:{<cardId>}:doc   board_id, session, content, revision, updated_by, updated_at
+//	board:card:{<cardId>}:ops   리비전 순서의 연산 JSON (최근 CardOperationHistorySize개)
+//	board:card:documents:dirty  스냅샷 저장이 필요한 카드 ID
+//	board:<boardId>:card_cursors  <cardId>:<clientId> -> 커서 JSON
+const (
+	cardDocumentDirtyKey      = "board:card:documents:dirty"
+	cardDocumentDrainSize     = 500
+	cardSnapshotKeepPerCard   = 20
+	boardCardCursorsKeyFormat = "board:%d:card_cursors"
+)
+
+func cardDocumentKey(cardID uuid.UUID) string   { return fmt.Sprintf("board:card:{%s}:doc", cardID) }
+func cardOperationsKey(cardID uuid.UUID) string { return fmt.Sprintf("board:card:{%s}:ops", cardID) }
+
+// 문서가 없을 때만 올림 (동시에 여러 노드가 올려도 하나만 반영)
+var initCardDocumentScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	redis.call("HSET", KEYS[1], "board_id", ARGV[1], "session", ARGV[2], "content", ARGV[3], "revision", ARGV[4], "updated_by", ARGV[5], "updated_at", ARGV[6])
+	redis.call("DEL", KEYS[2])
+end
+redis.call("EXPIRE", KEYS[1], ARGV[7])
+return redis.call("HGETALL", KEYS[1])
+`)
+
+// 현재 리비전이 기대한 값일 때만 새 내용과 연산을 저장 (다른 연산이 먼저 저장되었으면 0)
+var saveCardOperationScript = redis.NewScript(`
+local current = redis.call("HGET", KEYS[1], "revision")
+if (not current) or redis.call("HGET", KEYS[1], "session") ~= ARGV[1] or tonumber(current) ~= tonumber(ARGV[2]) then
+	return 0
+end
+redis.call("HSET", KEYS[1], "content", ARGV[3], "revision", tonumber(current) + 1, "updated_by", ARGV[4], "updated_at", ARGV[5])
+redis.call("RPUSH", KEYS[2], ARGV[6])
+redis.call("LTRIM", KEYS[2], -tonumber(ARGV[7]), -1)
+redis.call("EXPIRE", KEYS[1], ARGV[8])
+redis.call("EXPIRE", KEYS[2], ARGV[8])
+return 1
+`)
+
+func parseCardDocument(cardID uuid.UUID, fields map[string]string) (*entity.CardDocument, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	boardID, err := strconv.ParseUint(fields["board_id"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("카드 문서 보드 ID 파싱 실패: %w", err)
+	}
+	revision, err := strconv.Atoi(fields["revision"])
+	if err != nil {
+		return nil, fmt.Errorf("카드 문서 리비전 파싱 실패: %w", err)
+	}
+	updatedBy, _ := strconv.ParseUint(fields["updated_by"], 10, 64)
+	updatedAt, _ := time.Parse(time.RFC3339Nano, fields["updated_at"])
+
+	return &entity.CardDocument{
+		CardID:    cardID,
+		BoardID:   uint(boardID),
+		Session:   fields["session"],
+		Content:   fields["content"],
+		Revision:  revision,
+		UpdatedBy: uint(updatedBy),
+		UpdatedAt: updatedAt,
+	}, nil
+}
+
+// TODO redis에 올라와 있는 카드 문서 조회 (없으면 nil)
+func (p *BoardPersistence) GetCardDocument(cardID uuid.UUID) (*entity.CardDocument, error) {
+	fields, err := p.redisClient.HGetAll(context.Background(), cardDocumentKey(cardID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("카드 문서 조회 중 redis 오류: %w", err)
+	}
+	return parseCardDocument(cardID, fields)
+}
+
+// TODO 카드 문서를 redis에 올림 - 이미 있으면 기존 문서를 돌려줌
+func (p *BoardPersistence) InitCardDocument(document *entity.CardDocument) (*entity.CardDocument, error) {
+	values, err := initCardDocumentScript.Run(context.Background(), p.redisClient,
+		[]string{cardDocumentKey(document.CardID), cardOperationsKey(document.CardID)},
+		document.BoardID, document.Session, document.Content, document.Revision,
+		document.UpdatedBy, document.UpdatedAt.Format(time.RFC3339Nano), int(entity.CardDocumentTTL.Seconds()),
+	).StringSlice()
+	if err != nil {
+		return nil, fmt.Errorf("카드 문서 저장 중 redis 오류: %w", err)
+	}
+
+	fields := make(map[string]string, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		fields[values[i]] = values[i+1]
+	}
+	return parseCardDocument(document.CardID, fields)
+}
+
+// TODO afterRevision 이후에 적용된 연산 (보관 범위를 벗어난 연산은 빠져 있음)
+func (p *BoardPersistence) GetCardOperations(cardID uuid.UUID, afterRevision int) ([]entity.CardOperation, error) {
+	values, err := p.redisClient.LRange(context.Background(), cardOperationsKey(cardID), 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("카드 연산 조회 중 redis 오류: %w", err)
+	}
+
+	operations := make([]entity.CardOperation, 0)
+	for _, value := range values {
+		var operation entity.CardOperation
+		if err := json.Unmarshal([]byte(value), &operation); err != nil {
+			return nil, fmt.Errorf("카드 연산 파싱 실패: %w", err)
+		}
+		if operation.Revision > afterRevision {
+			operations = append(operations, operation)
+		}
+	}
+	return operations, nil
+}
+
+// TODO 새 리비전 저장 - document.Revision-1이 현재 리비전일 때만 저장되고, 아니면 false
+func (p *BoardPersistence) SaveCardOperation(document *entity.CardDocument, operation *entity.CardOperation) (bool, error) {
+	ctx := context.Background()
+
+	operationJSON, err := json.Marshal(operation)
+	if err != nil {
+		return false, fmt.Errorf("카드 연산 직렬화 실패: %w", err)
+	}
+
+	// 저장 전에 표시해도 스냅샷이 한 번 더 저장될 뿐이므로 먼저 추가
+	if err := p.redisClient.SAdd(ctx, cardDocumentDirtyKey, document.CardID.String()).Err(); err != nil {
+		return false, fmt.Errorf("카드 문서 변경 표시 중 redis 오류: %w", err)
+	}
+
+	saved, err := saveCardOperationScript.Run(ctx, p.redisClient,
+		[]string{cardDocumentKey(document.CardID), cardOperationsKey(document.CardID)},
+		document.Session, document.Revision-1, document.Content, document.UpdatedBy,
+		document.UpdatedAt.Format(time.RFC3339Nano), operationJSON,
+		entity.CardOperationHistorySize, int(entity.CardDocumentTTL.Seconds()),
+	).Int()
+	if err != nil {
+		return false, fmt.Errorf("카드 연산 저장 중 redis 오류: %w", err)
+	}
+
+	return saved == 1, nil
+}
+
+func (p *BoardPersistence) GetLatestCardSnapshotRevision(cardID uuid.UUID) (int, error) {
+	var revision int
+	if err := p.db.Model(&model.BoardCardSnapshot{}).
+		Select("COALESCE(MAX(revision), 0)").
+		Where("card_id = ?", cardID).
+		Scan(&revision).Error; err != nil {
+		return 0, fmt.Errorf("카드 스냅샷 조회 중 DB 오류: %w", err)
+	}
+	return revision, nil
+}
+
+// TODO 스냅샷 저장이 필요한 카드 문서를 가져오고 dirty 표시 제거 (실패 시 RestoreDirtyCardDocuments로 되돌림)
+func (p *BoardPersistence) DrainDirtyCardDocuments() ([]entity.CardDocument, error) {
+	ctx := context.Background()
+
+	cardIDs, err := p.redisClient.SPopN(ctx, cardDocumentDirtyKey, cardDocumentDrainSize).Result()
+	if err != nil {
+		return nil, fmt.Errorf("변경된 카드 문서 조회 중 redis 오류: %w", err)
+	}
+	if len(cardIDs) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(cardIDs))
+	for _, cardID := range cardIDs {
+		if id, err := uuid.Parse(cardID); err == nil {
+			ids = append(ids, id)
+		}
+	}
+
+	pipe := p.redisClient.Pipeline()
+	commands := make([]*redis.StringStringMapCmd, len(ids))
+	for i, id := range ids {
+		commands[i] = pipe.HGetAll(ctx, cardDocumentKey(id))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		_ = p.RestoreDirtyCardDocuments(ids)
+		return nil, fmt.Errorf("카드 문서 조회 중 redis 오류: %w", err)
+	}
+
+	documents := make([]entity.CardDocument, 0, len(ids))
+	for i, id := range ids {
+		// 만료된 문서는 저장할 내용이 없음
+		document, err := parseCardDocument(id, commands[i].Val())
+		if err != nil || document == nil {
+			continue
+		}
+		documents = append(documents, *document)
+	}
+
+	return documents, nil
+}
+
+func (p *BoardPersistence) RestoreDirtyCardDocuments(cardIDs []uuid.UUID) error {
+	if len(cardIDs) == 0 {
+		return nil
+	}
+
+	members := make([]interface{}, len(cardIDs))
+	for i, cardID := range cardIDs {
+		members[i] = cardID.String()
+	}
+	if err := p.redisClient.SAdd(context.Background(), cardDocumentDirtyKey, members...).Err(); err != nil {
+		return fmt.Errorf("카드 문서 변경 표시 복구 중 redis 오류: %w", err)
+	}
+	return nil
+}
+
+// TODO 카드 문서를 board_cards.content에 반영하고 스냅샷 기록 (카드별 최근 cardSnapshotKeepPerCard개만 보관)
+// 이미 삭제된 카드는 건너뜀
+func (p *BoardPersistence) SaveCardDocumentSnapshots(documents []entity.CardDocument) error {
+	if len(documents) == 0 {
+		return nil
+	}
+
+	return p.db.Transaction(func(tx *gorm.DB) error {
+		cardIDs := make([]uuid.UUID, len(documents))
+		for i, document := range documents {
+			cardIDs[i] = document.CardID
+		}
+
+		var existingIDs []uuid.UUID
+		if err := tx.Model(&model.BoardCard{}).Where("id IN ?", cardIDs).Pluck("id", &existingIDs).Error; err != nil {
+			return fmt.Errorf("카드 조회 중 DB 오류: %w", err)
+		}
+		existing := make(map[uuid.UUID]bool, len(existingIDs))
+		for _, id := range existingIDs {
+			existing[id] = true
+		}
+
+		for _, document := range documents {
+			if !existing[document.CardID] {
+				continue
+			}
+
+			// 자동 저장이 이전 내용 기준으로 덮어쓰지 않도록 내용 버전만 올림 (카드 버전은 그대로라 다른 필드 수정은 충돌하지 않음)
+			if err := tx.Model(&model.BoardCard{}).Where("id = ?", document.CardID).Updates(map[string]interface{}{
+				"content":         document.Content,
+				"updated_at":      document.UpdatedAt,
+				"content_version": gorm.Expr("content_version + 1"),
+			}).Error; err != nil {
+				return fmt.Errorf("카드 내용 저장 중 DB 오류: %w", err)
+			}
+
+			snapshot := model.BoardCardSnapshot{
+				CardID:    document.CardID,
+				Revision:  document.Revision,
+				Content:   document.Content,
+				UpdatedBy: document.UpdatedBy,
+			}
+			if err := tx.Create(&snapshot).Error; err != nil {
+				return fmt.Errorf("카드 스냅샷 저장 중 DB 오류: %w", err)
+			}
+
+			if err := tx.Where("card_id = ? AND id NOT IN (?)", document.CardID,
+				tx.Model(&model.BoardCardSnapshot{}).Select("id").Where("card_id = ?", document.CardID).
+					Order("id DESC").Limit(cardSnapshotKeepPerCard),
+			).Delete(&model.BoardCardSnapshot{}).Error; err != nil {
+				return fmt.Errorf("오래된 카드 스냅샷 삭제 중 DB 오류: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+func (p *BoardPersistence) GetBoardCardCursors(boardID uint) ([]entity.CardCursor, error) {
+	values, err := p.redisClient.HGetAll(context.Background(), fmt.Sprintf(boardCardCursorsKeyFormat, boardID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("카드 커서 조회 중 redis 오류: %w", err)
+	}
+
+	cursors := make([]entity.CardCursor, 0, len(values))
+	for _, value := range values {
+		var cursor entity.CardCursor
+		if err := json.Unmarshal([]byte(value), &cursor); err != nil {
+			continue
+		}
+		cursors = append(cursors, cursor)
+	}
+	return cursors, nil
+}
+
+func (p *BoardPersistence) SaveBoardCardCursor(boardID uint, cursor *entity.CardCursor) error {
+	ctx := context.Background()
+	key := fmt.Sprintf(boardCardCursorsKeyFormat, boardID)
+
+	cursorJSON, err := json.Marshal(cursor)
+	if err != nil {
+		return fmt.Errorf("카드 커서 직렬화 실패: %w", err)
+	}
+
+	pipe := p.redisClient.TxPipeline()
+	pipe.HSet(ctx, key, fmt.Sprintf("%s:%s", cursor.CardID, cursor.ClientID), cursorJSON)
+	pipe.Expire(ctx, key, entity.CardDocumentTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("카드 커서 저장 중 redis 오류: %w", err)
+	}
+	return nil
+}
+
+func (p *BoardPersistence) DeleteBoardCardCursors(boardID uint, cursors []entity.CardCursor) error {
+	if len(cursors) == 0 {
+		return nil
+	}
+
+	fields := make([]string, len(cursors))
+	for i, cursor := range cursors {
+		fields[i] = fmt.Sprintf("%s:%s", cursor.CardID, cursor.ClientID)
+	}
+	if err := p.redisClient.HDel(context.Background(), fmt.Sprintf(boardCardCursorsKeyFormat, boardID), fields...).Err(); err != nil {
+		return fmt.Errorf("카드 커서 삭제 중 redis 오류: %w", err)
+	}
+	return nil
+}
+
+// ! 보드 활동 기록 관련 (몽고 디비 board_activities 컬렉션)
+func (p *BoardPersistence) CreateCardActivities(activities []entity.CardActivity) error {
+	if len(activities) == 0 {
+		return nil
+	}
+
+	documents := make([]interface{}, len(activities))
+	for i, activity := range activities {
+		activityLog := model.CardActivityLog{
+			UserID:         activity.UserID,
+			UserName:       activity.UserName,
+			ProjectID:      activity.ProjectID,
+			BoardID:        activity.BoardID,
+			TargetType:     activity.TargetType,
+			TargetID:       uuidToString(activity.TargetID),
+			CardID:         uuidToString(activity.CardID),
+			ColumnID:       uuidToString(activity.ColumnID),
+			Name:           activity.Name,
+			Action:         activity.Action,
+			FromColumnID:   uuidToString(activity.FromColumnID),
+			FromColumnName: activity.FromColumnName,
+			ToColumnID:     uuidToString(activity.ToColumnID),
+			ToColumnName:   activity.ToColumnName,
+			FromPosition:   activity.FromPosition,
+			ToPosition:     activity.ToPosition,
+			CreatedAt:      activity.CreatedAt,
+		}
+		for _, change := range activity.Changes {
+			activityLog.Changes = append(activityLog.Changes, model.CardActivityChangeLog{
+				Field:  change.Field,
+				Before: change.Before,
+				After:  change.After,
+			})
+		}
+		documents[i] = activityLog
+	}
+
+	collection := p.mongo.Database("link").Collection("board_activities")
+	if _, err := collection.InsertMany(context.Background(), documents); err != nil {
+		return fmt.Errorf("보드 활동 저장 중 MongoDB 오류: %w", err)
+	}
+
+	return nil
+}
+
+// 최신순 조회 - Limit보다 하나 더 가져와서 다음 페이지 여부 판단
+func (p *BoardPersistence) GetCardActivities(filter entity.CardActivityFilter) ([]entity.CardActivity, bool, error) {
+	query := bson.M{"board_id": filter.BoardID}
+	if filter.CardID != nil {
+		query["card_id"] = filter.CardID.String()
+	}
+	if filter.UserID != 0 {
+		query["user_id"] = filter.UserID
+	}
+	if filter.Action != "" {
+		query["action"] = filter.Action
+	}
+	if filter.TargetType != "" {
+		query["target_type"] = filter.TargetType
+	}
+	if filter.Cursor != "" {
+		cursorID, err := primitive.ObjectIDFromHex(filter.Cursor)
+		if err != nil {
+			return nil, false, fmt.Errorf("잘못된 커서: %w", err)
+		}
+		query["_id"] = bson.M{"$lt": cursorID}
+	}
+
+	collection := p.mongo.Database("link").Collection("board_activities")
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: -1}}).
+		SetLimit(int64(filter.Limit + 1))
+
+	cursor, err := collection.Find(context.Background(), query, findOptions)
+	if err != nil {
+		return nil, false, fmt.Errorf("보드 활동 조회 중 MongoDB 오류: %w", err)
+	}
+	defer cursor.Close(context.Background())
+
+	var activityLogs []model.CardActivityLog
+	if err := cursor.All(context.Background(), &activityLogs); err != nil {
+		return nil, false, fmt.Errorf("보드 활동 조회 중 MongoDB 오류: %w", err)
+	}
+
+	hasMore := len(activityLogs) > filter.Limit
+	if hasMore {
+		activityLogs = activityLogs[:filter.Limit]
+	}
+
+	activities := make([]entity.CardActivity, len(activityLogs))
+	for i, activityLog := range activityLogs {
+		activities[i] = entity.CardActivity{
+			ID:             activityLog.ID.Hex(),
+			UserID:         activityLog.UserID,
+			UserName:       activityLog.UserName,
+			ProjectID:      activityLog.ProjectID,
+			BoardID:        activityLog.BoardID,
+			TargetType:     activityLog.TargetType,
+			TargetID:       parseUUIDString(activityLog.TargetID),
+			CardID:         parseUUIDString(activityLog.CardID),
+			ColumnID:       parseUUIDString(activityLog.ColumnID),
+			Name:           activityLog.Name,
+			Action:         activityLog.Action,
+			FromColumnID:   parseUUIDString(activityLog.FromColumnID),
+			FromColumnName: activityLog.FromColumnName,
+			ToColumnID:     parseUUIDString(activityLog.ToColumnID),
+			ToColumnName:   activityLog.ToColumnName,
+			FromPosition:   activityLog.FromPosition,
+			ToPosition:     activityLog.ToPosition,
+			CreatedAt:      activityLog.CreatedAt,
+		}
+		for _, change := range activityLog.Changes {
+			activities[i].Changes = append(activities[i].Changes, entity.CardActivityChange{
+				Field:  change.Field,
+				Before: change.Before,
+				After:  change.After,
+			})
+		}
+	}
+
+	return activities, hasMore, nil
+}
+
+// 삭제된 카드의 보드 찾기 - 카드의 마지막 활동 기록 기준 (기록이 없으면 0)
+func (p *BoardPersistence) GetCardActivityBoardID(cardID uuid.UUID) (uint, error) {
+	collection := p.mongo.Database("link").Collection("board_activities")
+	findOptions := options.FindOne().
+		SetSort(bson.D{{Key: "_id", Value: -1}}).
+		SetProjection(bson.M{"board_id": 1})
+
+	var activityLog model.CardActivityLog
+	err := collection.FindOne(context.Background(), bson.M{"card_id": cardID.String()}, findOptions).Decode(&activityLog)
+	if err == mongo.ErrNoDocuments {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("보드 활동 조회 중 MongoDB 오류: %w", err)
+	}
+
+	return activityLog.BoardID, nil
+}
+
+func uuidToString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+func parseUUIDString(value string) *uuid.UUID {
+	if value == "" {
+		return nil
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil
+	}
+	return &id
 }
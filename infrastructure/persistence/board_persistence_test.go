@@ -0,0 +1,88 @@
+package persistence
+
+import (
+	"testing"
+
+	"link/infrastructure/model"
+	"link/internal/board/entity"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func stringPtr(v string) *string {
+	return &v
+}
+
+func TestIsStaleVersion(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestVersion *int
+		currentVersion int
+		want           bool
+	}{
+		{"같은 버전", intPtr(3), 3, false},
+		{"이전 버전", intPtr(2), 3, true},
+		{"앞선 버전", intPtr(4), 3, true},
+		{"버전 없음", nil, 3, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isStaleVersion(tt.requestVersion, tt.currentVersion); got != tt.want {
+				t.Errorf("isStaleVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsStaleCardUpdate(t *testing.T) {
+	// 협업 편집 스냅샷으로 내용 버전만 올라간 카드
+	card := &model.BoardCard{Version: 3, ContentVersion: 5}
+
+	tests := []struct {
+		name   string
+		change entity.BoardChange
+		want   bool
+	}{
+		{"이름 수정 - 카드 버전 일치", entity.BoardChange{Version: intPtr(3), Name: stringPtr("이름")}, false},
+		{"이름 수정 - 내용 버전이 예전이어도 내용을 안 바꾸면 적용", entity.BoardChange{Version: intPtr(3), ContentVersion: intPtr(4), Name: stringPtr("이름")}, false},
+		{"이름 수정 - 카드 버전 불일치", entity.BoardChange{Version: intPtr(2), Name: stringPtr("이름")}, true},
+		{"내용 수정 - 두 버전 모두 일치", entity.BoardChange{Version: intPtr(3), ContentVersion: intPtr(5), Content: stringPtr("내용")}, false},
+		{"내용 수정 - 스냅샷 이전 내용 기준", entity.BoardChange{Version: intPtr(3), ContentVersion: intPtr(4), Content: stringPtr("내용")}, true},
+		{"내용 수정 - 내용 버전 없음", entity.BoardChange{Version: intPtr(3), Content: stringPtr("내용")}, true},
+		{"내용 수정 - 카드 버전 불일치", entity.BoardChange{Version: intPtr(2), ContentVersion: intPtr(5), Content: stringPtr("내용")}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isStaleCardUpdate(tt.change, card); got != tt.want {
+				t.Errorf("isStaleCardUpdate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsWipLimitReached(t *testing.T) {
+	tests := []struct {
+		name      string
+		wipLimit  uint
+		cardCount int64
+		want      bool
+	}{
+		{"제한 없음", 0, 100, false},
+		{"여유 있음", 3, 2, false},
+		{"가득 참", 3, 3, true},
+		{"이미 초과", 3, 5, true},
+		{"빈 컬럼", 1, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isWipLimitReached(tt.wipLimit, tt.cardCount); got != tt.want {
+				t.Errorf("isWipLimitReached(%d, %d) = %v, want %v", tt.wipLimit, tt.cardCount, got, tt.want)
+			}
+		})
+	}
+}
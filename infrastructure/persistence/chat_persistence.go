@@ -6,13 +6,17 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/go-redis/redis/v8"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"link/infrastructure/model"
 	chatEntity "link/internal/chat/entity"
@@ -33,8 +37,10 @@ func NewChatPersistence(db *gorm.DB, mongo *mongo.Client, redis *redis.Client) r
 func (r *chatPersistence) CreateChatRoom(chatRoom *chatEntity.ChatRoom) error {
 	// entity.ChatRoom을 model.ChatRoom으로 변환
 	modelChatRoom := model.ChatRoom{
-		Name:      chatRoom.Name,
-		IsPrivate: chatRoom.IsPrivate,
+		Name:           chatRoom.Name,
+		IsPrivate:      chatRoom.IsPrivate,
+		IsAnnouncement: chatRoom.IsAnnouncement,
+		CompanyID:      chatRoom.CompanyID,
 	}
 
 	tx := r.db.Begin()
@@ -66,6 +72,9 @@ func (r *chatPersistence) CreateChatRoom(chatRoom *chatEntity.ChatRoom) error {
 			}
 		} else {
 			chatRoomUser.ChatRoomAlias = modelChatRoom.Name
+			if *user.ID == chatRoom.OwnerID {
+				chatRoomUser.Role = chatEntity.ChatRoomRoleOwner
+			}
 		}
 
 		if err := tx.Create(&chatRoomUser).Error; err != nil {
@@ -79,6 +88,7 @@ func (r *chatPersistence) CreateChatRoom(chatRoom *chatEntity.ChatRoom) error {
 		return fmt.Errorf("트랜잭션 커밋 실패: %w", err)
 	}
 
+	chatRoom.ID = modelChatRoom.ID
 	return nil
 }
 
@@ -114,10 +124,12 @@ func (r *chatPersistence) GetChatRoomList(userId uint) ([]*chatEntity.ChatRoom,
 			}
 		}
 		result[i] = &chatEntity.ChatRoom{
-			ID:        chatRoom.ID,
-			Name:      chatRoom.Name,
-			IsPrivate: chatRoom.IsPrivate,
-			Users:     users,
+			ID:             chatRoom.ID,
+			Name:           chatRoom.Name,
+			IsPrivate:      chatRoom.IsPrivate,
+			Users:          users,
+			IsAnnouncement: chatRoom.IsAnnouncement,
+			CompanyID:      chatRoom.CompanyID,
 		}
 	}
 
@@ -170,7 +182,16 @@ func (r *chatPersistence) SaveMessage(chat *chatEntity.Chat) error {
 		unreadBy[i] = user.ID
 	}
 
+	// 메시지 ID는 usecase에서 발급 (응답, 답장/수정/반응 대상과 같은 ID)
+	objectID, err := primitive.ObjectIDFromHex(chat.ID)
+	if err != nil {
+		return fmt.Errorf("잘못된 메시지 ID: %w", err)
+	}
+
 	chatModel := model.Chat{
+		ID:          objectID,
+		ReplyTo:     chat.ReplyTo,
+		Attachments: toChatAttachmentModels(chat.Attachments),
 		Content:     chat.Content,
 		ChatRoomID:  chat.ChatRoomID,
 		SenderID:    chat.SenderID,
@@ -212,6 +233,7 @@ func (r *chatPersistence) GetChatRoomById(chatRoomID uint) (*chatEntity.ChatRoom
 	}
 
 	// Users를 chatEntity.User로 변환
+	var ownerID uint
 	users := make([]*userEntity.User, len(chatRoom.ChatRoomUsers))
 	for i, chatRoomUser := range chatRoom.ChatRoomUsers {
 		users[i] = &userEntity.User{
@@ -226,9 +248,13 @@ func (r *chatPersistence) GetChatRoomById(chatRoomID uint) (*chatEntity.ChatRoom
 					"alias_name": chatRoomUser.ChatRoomAlias,
 					"joined_at":  chatRoomUser.JoinedAt,
 					"left_at":    chatRoomUser.LeftAt,
+					"role":       chatRoomUser.Role,
 				},
 			},
 		}
+		if chatRoomUser.Role == chatEntity.ChatRoomRoleOwner && chatRoomUser.LeftAt.IsZero() {
+			ownerID = chatRoomUser.UserID
+		}
 	}
 
 	return &chatEntity.ChatRoom{
@@ -236,6 +262,10 @@ func (r *chatPersistence) GetChatRoomById(chatRoomID uint) (*chatEntity.ChatRoom
 		Users:     users, // 변환된 사용자 리스트 설정
 		Name:      chatRoom.Name,
 		IsPrivate: chatRoom.IsPrivate,
+		OwnerID:   ownerID,
+
+		IsAnnouncement: chatRoom.IsAnnouncement,
+		CompanyID:      chatRoom.CompanyID,
 	}, nil
 }
 
@@ -279,6 +309,20 @@ func (r *chatPersistence) GetChatMessages(chatRoomID uint, queryOptions map[stri
 				{"$sort": bson.M{"created_at": 1}},
 			}
 
+			//TODO 검색에서 메시지로 이동한 뒤 아래로 스크롤할 때는 커서 이후 메시지
+			if direction, _ := cursor["direction"].(string); direction == "newer" {
+				pipeline = []bson.M{
+					{
+						"$match": bson.M{
+							"chat_room_id": chatRoomID,
+							"created_at":   bson.M{"$gt": primitive.NewDateTimeFromTime(parsedTime.UTC())},
+						},
+					},
+					{"$sort": bson.M{"created_at": 1}},
+					{"$limit": int64(limit)},
+				}
+			}
+
 			cursor, err := collection.Aggregate(context.Background(), pipeline)
 			if err != nil {
 				return nil, nil, fmt.Errorf("이전 메시지 조회 중 MongoDB 오류: %w", err)
@@ -308,34 +352,9 @@ func (r *chatPersistence) GetChatMessages(chatRoomID uint, queryOptions map[stri
 		}
 	}
 
-	senderIdMap := make(map[uint]struct{})
-	for _, msg := range chatMessages {
-		senderIdMap[msg.SenderID] = struct{}{}
-	}
-
-	uniqueSenderIds := make([]uint, 0, len(senderIdMap))
-	for senderId := range senderIdMap {
-		uniqueSenderIds = append(uniqueSenderIds, senderId)
-	}
-
-	// 사용자 프로필 이미지 조회
-	// 사용자 프로필 이미지 조회
-	var userProfiles []struct {
-		UserID uint    `gorm:"column:user_id"`
-		Image  *string `gorm:"column:image"`
-	}
-
-	if err := r.db.Table("user_profiles").
-		Select("user_id, image").
-		Where("user_id IN ?", uniqueSenderIds).
-		Scan(&userProfiles).Error; err != nil {
-		return nil, nil, fmt.Errorf("사용자 프로필 이미지 조회 중 오류: %w", err)
-	}
-
-	// 이미지 매핑을 위한 맵 생성
-	profileImageMap := make(map[uint]*string)
-	for _, profile := range userProfiles {
-		profileImageMap[profile.UserID] = profile.Image
+	entityChatMessages, err := r.toChatEntities(chatMessages)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	//TODO 전체 채팅 카운트
@@ -343,26 +362,6 @@ func (r *chatPersistence) GetChatMessages(chatRoomID uint, queryOptions map[stri
 	if err != nil {
 		return nil, nil, fmt.Errorf("채팅 카운트 조회 중 MongoDB 오류: %w", err)
 	}
-	// 조회한 데이터를 entity로 변환
-	entityChatMessages := make([]*chatEntity.Chat, len(chatMessages))
-	for i, chatMessage := range chatMessages {
-
-		var senderImage string
-		if img := profileImageMap[chatMessage.SenderID]; img != nil {
-			senderImage = *img
-		}
-
-		entityChatMessages[i] = &chatEntity.Chat{
-			ID:          chatMessage.ID.Hex(),
-			Content:     chatMessage.Content,
-			ChatRoomID:  chatMessage.ChatRoomID,
-			SenderID:    chatMessage.SenderID,
-			SenderName:  chatMessage.SenderName,
-			SenderEmail: chatMessage.SenderEmail,
-			SenderImage: senderImage,
-			CreatedAt:   chatMessage.CreatedAt,
-		}
-	}
 
 	// hasMore 계산
 	hasMore := false
@@ -396,6 +395,243 @@ func (r *chatPersistence) GetChatMessages(chatRoomID uint, queryOptions map[stri
 	}, entityChatMessages, nil
 }
 
+// TODO 특정 메시지 주변 메시지 조회 (검색 결과에서 메시지로 이동)
+// 대상 메시지 이전 limit/2개, 대상 메시지, 이후 메시지를 합쳐 limit개 - 대상 메시지가 없으면 nil
+func (r *chatPersistence) GetChatMessagesAround(chatRoomID uint, chatMessageID string, limit int) (*chatEntity.ChatMeta, []*chatEntity.Chat, error) {
+	target, err := r.GetChatMessageByID(chatRoomID, chatMessageID)
+	if err != nil || target == nil {
+		return nil, nil, err
+	}
+
+	collection := r.mongo.Database("link").Collection("messages")
+	targetTime := primitive.NewDateTimeFromTime(target.CreatedAt)
+	beforeLimit, afterLimit := chatAroundLimits(limit)
+
+	// SetLimit(0)은 제한 없음이므로 이전 메시지가 필요 없으면 조회하지 않음
+	var olderMessages []model.Chat
+	if beforeLimit > 0 {
+		olderCursor, err := collection.Find(context.Background(),
+			bson.M{"chat_room_id": chatRoomID, "created_at": bson.M{"$lt": targetTime}},
+			options.Find().SetSort(bson.M{"created_at": -1}).SetLimit(int64(beforeLimit)))
+		if err != nil {
+			return nil, nil, fmt.Errorf("이전 메시지 조회 중 MongoDB 오류: %w", err)
+		}
+		defer olderCursor.Close(context.Background())
+		if err := olderCursor.All(context.Background(), &olderMessages); err != nil {
+			return nil, nil, fmt.Errorf("MongoDB 커서 처리 중 오류: %w", err)
+		}
+	}
+
+	var newerMessages []model.Chat
+	newerCursor, err := collection.Find(context.Background(),
+		bson.M{"chat_room_id": chatRoomID, "created_at": bson.M{"$gte": targetTime}},
+		options.Find().SetSort(bson.M{"created_at": 1}).SetLimit(int64(afterLimit+1)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("이후 메시지 조회 중 MongoDB 오류: %w", err)
+	}
+	defer newerCursor.Close(context.Background())
+	if err := newerCursor.All(context.Background(), &newerMessages); err != nil {
+		return nil, nil, fmt.Errorf("MongoDB 커서 처리 중 오류: %w", err)
+	}
+
+	// 오래된 순으로 합치기
+	chatMessages := make([]model.Chat, 0, len(olderMessages)+len(newerMessages))
+	for i := len(olderMessages) - 1; i >= 0; i-- {
+		chatMessages = append(chatMessages, olderMessages[i])
+	}
+	chatMessages = append(chatMessages, newerMessages...)
+
+	entityChatMessages, err := r.toChatEntities(chatMessages)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	totalCount, err := collection.CountDocuments(context.Background(), bson.M{"chat_room_id": chatRoomID})
+	if err != nil {
+		return nil, nil, fmt.Errorf("채팅 카운트 조회 중 MongoDB 오류: %w", err)
+	}
+
+	meta := &chatEntity.ChatMeta{
+		TotalCount: int(totalCount),
+		TotalPages: int(math.Ceil(float64(totalCount) / float64(limit))),
+		PageSize:   limit,
+	}
+
+	hasMore, hasNewer := false, false
+	if len(entityChatMessages) > 0 {
+		oldest := entityChatMessages[0].CreatedAt
+		newest := entityChatMessages[len(entityChatMessages)-1].CreatedAt
+
+		olderCount, err := collection.CountDocuments(context.Background(), bson.M{
+			"chat_room_id": chatRoomID,
+			"created_at":   bson.M{"$lt": oldest},
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("이전 메시지 확인 중 오류: %w", err)
+		}
+		newerCount, err := collection.CountDocuments(context.Background(), bson.M{
+			"chat_room_id": chatRoomID,
+			"created_at":   bson.M{"$gt": newest},
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("이후 메시지 확인 중 오류: %w", err)
+		}
+
+		hasMore, hasNewer = olderCount > 0, newerCount > 0
+		if hasMore {
+			meta.NextCursor = oldest.Format(time.RFC3339Nano)
+		}
+		if hasNewer {
+			meta.NewerCursor = newest.Format(time.RFC3339Nano)
+		}
+	}
+	meta.HasMore = &hasMore
+	meta.HasNewer = &hasNewer
+
+	return meta, entityChatMessages, nil
+}
+
+// 주변 메시지 조회 시 대상 이전/이후 개수 (대상 메시지 1개 포함해 limit개)
+func chatAroundLimits(limit int) (int, int) {
+	if limit < 1 {
+		limit = 1
+	}
+	beforeLimit := limit / 2
+	return beforeLimit, limit - beforeLimit - 1
+}
+
+// 검색어를 공백으로 나눠 각 단어를 부분 일치로 검색 (모든 단어 포함)
+// 한국어는 조사가 붙어 공백 단위 텍스트 인덱스로는 "회의"로 "회의는"을 찾을 수 없음
+func chatSearchConditions(query string) []bson.M {
+	terms := strings.Fields(query)
+	conditions := make([]bson.M, len(terms))
+	for i, term := range terms {
+		conditions[i] = bson.M{"content": bson.M{"$regex": regexp.QuoteMeta(term), "$options": "i"}}
+	}
+	return conditions
+}
+
+// 검색 관련도 - 검색어 단어가 내용에 나온 횟수
+func chatSearchScore(content string, query string) float64 {
+	lowerContent := strings.ToLower(content)
+	score := 0
+	for _, term := range strings.Fields(strings.ToLower(query)) {
+		score += strings.Count(lowerContent, term)
+	}
+	return float64(score)
+}
+
+// TODO 채팅 메시지 검색 - 단어별 부분 일치, 최신순
+func (r *chatPersistence) SearchChatMessages(filter *chatEntity.ChatSearchFilter) ([]*chatEntity.Chat, int64, error) {
+	collection := r.mongo.Database("link").Collection("messages")
+
+	query := bson.M{
+		"$and":         chatSearchConditions(filter.Query),
+		"chat_room_id": bson.M{"$in": filter.ChatRoomIDs},
+	}
+	if filter.SenderID != 0 {
+		query["sender_id"] = filter.SenderID
+	}
+	if filter.From != nil || filter.To != nil {
+		createdAt := bson.M{}
+		if filter.From != nil {
+			createdAt["$gte"] = *filter.From
+		}
+		if filter.To != nil {
+			createdAt["$lt"] = *filter.To
+		}
+		query["created_at"] = createdAt
+	}
+
+	totalCount, err := collection.CountDocuments(context.Background(), query)
+	if err != nil {
+		return nil, 0, fmt.Errorf("채팅 검색 카운트 조회 중 MongoDB 오류: %w", err)
+	}
+
+	findOptions := options.Find().
+		SetProjection(bson.M{"content": 1, "chat_room_id": 1, "sender_id": 1,
+			"sender_name": 1, "sender_email": 1, "sender_image": 1, "created_at": 1, "reply_to": 1, "attachments": 1, "edited_at": 1}).
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetSkip(int64((filter.Page - 1) * filter.Limit)).
+		SetLimit(int64(filter.Limit))
+
+	cursor, err := collection.Find(context.Background(), query, findOptions)
+	if err != nil {
+		return nil, 0, fmt.Errorf("채팅 검색 중 MongoDB 오류: %w", err)
+	}
+	defer cursor.Close(context.Background())
+
+	var chatMessages []model.Chat
+	if err := cursor.All(context.Background(), &chatMessages); err != nil {
+		return nil, 0, fmt.Errorf("MongoDB 커서 처리 중 오류: %w", err)
+	}
+
+	entityChatMessages, err := r.toChatEntities(chatMessages)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := range entityChatMessages {
+		entityChatMessages[i].Score = chatSearchScore(entityChatMessages[i].Content, filter.Query)
+	}
+
+	return entityChatMessages, totalCount, nil
+}
+
+// 조회한 메시지를 entity로 변환 - 송신자 프로필 이미지와 답장 대상 미리보기 포함
+func (r *chatPersistence) toChatEntities(chatMessages []model.Chat) ([]*chatEntity.Chat, error) {
+	senderIdMap := make(map[uint]struct{})
+	for _, msg := range chatMessages {
+		senderIdMap[msg.SenderID] = struct{}{}
+	}
+
+	uniqueSenderIds := make([]uint, 0, len(senderIdMap))
+	for senderId := range senderIdMap {
+		uniqueSenderIds = append(uniqueSenderIds, senderId)
+	}
+
+	// 사용자 프로필 이미지 조회
+	var userProfiles []struct {
+		UserID uint    `gorm:"column:user_id"`
+		Image  *string `gorm:"column:image"`
+	}
+
+	if len(uniqueSenderIds) > 0 {
+		if err := r.db.Table("user_profiles").
+			Select("user_id, image").
+			Where("user_id IN ?", uniqueSenderIds).
+			Scan(&userProfiles).Error; err != nil {
+			return nil, fmt.Errorf("사용자 프로필 이미지 조회 중 오류: %w", err)
+		}
+	}
+
+	// 이미지 매핑을 위한 맵 생성
+	profileImageMap := make(map[uint]*string)
+	for _, profile := range userProfiles {
+		profileImageMap[profile.UserID] = profile.Image
+	}
+
+	//TODO 답장 대상 메시지 미리보기 조회
+	replyToMap, err := r.getReplyToMessages(chatMessages)
+	if err != nil {
+		return nil, err
+	}
+
+	entityChatMessages := make([]*chatEntity.Chat, len(chatMessages))
+	for i, chatMessage := range chatMessages {
+
+		var senderImage string
+		if img := profileImageMap[chatMessage.SenderID]; img != nil {
+			senderImage = *img
+		}
+
+		entityChatMessages[i] = toChatEntity(&chatMessage)
+		entityChatMessages[i].SenderImage = senderImage
+		entityChatMessages[i].ReplyToChat = replyToMap[chatMessage.ReplyTo]
+	}
+
+	return entityChatMessages, nil
+}
+
 // TODO 메시지 삭제
 func (r *chatPersistence) DeleteChatMessage(senderID uint, chatRoomID uint, chatMessageID string) error {
 	// MongoDB에서 삭제
@@ -433,6 +669,317 @@ func (r *chatPersistence) DeleteChatMessage(senderID uint, chatRoomID uint, chat
 	return nil
 }
 
+// TODO 메시지 단건 조회 - 없으면 nil
+func (r *chatPersistence) GetChatMessageByID(chatRoomID uint, chatMessageID string) (*chatEntity.Chat, error) {
+	chatMessageIDObject, err := primitive.ObjectIDFromHex(chatMessageID)
+	if err != nil {
+		return nil, fmt.Errorf("채팅 메시지 ID 변환 중 오류: %w", err)
+	}
+
+	collection := r.mongo.Database("link").Collection("messages")
+
+	var chatMessage model.Chat
+	err = collection.FindOne(context.Background(), bson.M{"_id": chatMessageIDObject, "chat_room_id": chatRoomID}).Decode(&chatMessage)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("채팅 메시지 조회 중 MongoDB 오류: %w", err)
+	}
+
+	return toChatEntity(&chatMessage), nil
+}
+
+// TODO 메시지 수정 - 수정 전 내용은 edit_history에 쌓음
+// 조회한 뒤 다른 곳에서 먼저 수정했으면(content가 달라졌으면) nil
+func (r *chatPersistence) UpdateChatMessage(chat *chatEntity.Chat, content string) (*chatEntity.Chat, error) {
+	chatMessageIDObject, err := primitive.ObjectIDFromHex(chat.ID)
+	if err != nil {
+		return nil, fmt.Errorf("채팅 메시지 ID 변환 중 오류: %w", err)
+	}
+
+	collection := r.mongo.Database("link").Collection("messages")
+	now := time.Now()
+	filter := bson.M{
+		"_id":     chatMessageIDObject,
+		"content": chat.Content,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"content":   content,
+			"edited_at": now,
+		},
+		"$push": bson.M{
+			"edit_history": model.ChatEdit{Content: chat.Content, EditedAt: now},
+		},
+	}
+
+	var chatMessage model.Chat
+	err = collection.FindOneAndUpdate(context.Background(), filter, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&chatMessage)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("채팅 메시지 수정 중 MongoDB 오류: %w", err)
+	}
+
+	return toChatEntity(&chatMessage), nil
+}
+
+// TODO 메시지 이모지 반응 추가 - 같은 이모지가 있으면 사용자만 추가
+func (r *chatPersistence) AddChatReaction(userId uint, chatMessageID string, reaction *chatEntity.ChatReaction) ([]chatEntity.ChatReaction, error) {
+	chatMessageIDObject, err := primitive.ObjectIDFromHex(chatMessageID)
+	if err != nil {
+		return nil, fmt.Errorf("채팅 메시지 ID 변환 중 오류: %w", err)
+	}
+
+	// 1. 이모지 확인/생성 (게시글 좋아요와 같은 emojis 테이블)
+	var emoji model.Emoji
+	if err := r.db.Where(model.Emoji{Unified: reaction.Unified}).
+		Attrs(model.Emoji{Content: reaction.Content}).
+		FirstOrCreate(&emoji).Error; err != nil {
+		return nil, fmt.Errorf("이모지 조회 실패: %w", err)
+	}
+
+	collection := r.mongo.Database("link").Collection("messages")
+	addUser := func() (int64, error) {
+		result, err := collection.UpdateOne(context.Background(),
+			bson.M{"_id": chatMessageIDObject, "reactions.emoji_id": emoji.ID},
+			bson.M{"$addToSet": bson.M{"reactions.$.user_ids": userId}})
+		if err != nil {
+			return 0, fmt.Errorf("이모지 반응 추가 중 MongoDB 오류: %w", err)
+		}
+		return result.MatchedCount, nil
+	}
+
+	// 2. 같은 이모지 반응이 있으면 사용자 추가
+	matched, err := addUser()
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 없으면 새 반응 추가 - 동시에 먼저 추가된 경우 다시 사용자 추가
+	if matched == 0 {
+		result, err := collection.UpdateOne(context.Background(),
+			bson.M{"_id": chatMessageIDObject, "reactions.emoji_id": bson.M{"$ne": emoji.ID}},
+			bson.M{"$push": bson.M{"reactions": model.ChatReaction{
+				EmojiID: emoji.ID,
+				Unified: emoji.Unified,
+				Content: emoji.Content,
+				UserIDs: []uint{userId},
+			}}})
+		if err != nil {
+			return nil, fmt.Errorf("이모지 반응 추가 중 MongoDB 오류: %w", err)
+		}
+		if result.MatchedCount == 0 {
+			if _, err := addUser(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return r.getChatReactions(chatMessageIDObject)
+}
+
+// TODO 메시지 이모지 반응 취소 - 반응한 사용자가 없으면 반응 제거
+func (r *chatPersistence) RemoveChatReaction(userId uint, chatMessageID string, unified string) ([]chatEntity.ChatReaction, error) {
+	chatMessageIDObject, err := primitive.ObjectIDFromHex(chatMessageID)
+	if err != nil {
+		return nil, fmt.Errorf("채팅 메시지 ID 변환 중 오류: %w", err)
+	}
+
+	collection := r.mongo.Database("link").Collection("messages")
+	_, err = collection.UpdateOne(context.Background(),
+		bson.M{"_id": chatMessageIDObject, "reactions.unified": unified},
+		bson.M{"$pull": bson.M{"reactions.$.user_ids": userId}})
+	if err != nil {
+		return nil, fmt.Errorf("이모지 반응 취소 중 MongoDB 오류: %w", err)
+	}
+
+	_, err = collection.UpdateOne(context.Background(),
+		bson.M{"_id": chatMessageIDObject},
+		bson.M{"$pull": bson.M{"reactions": bson.M{"user_ids": bson.M{"$size": 0}}}})
+	if err != nil {
+		return nil, fmt.Errorf("이모지 반응 정리 중 MongoDB 오류: %w", err)
+	}
+
+	return r.getChatReactions(chatMessageIDObject)
+}
+
+func (r *chatPersistence) getChatReactions(chatMessageID primitive.ObjectID) ([]chatEntity.ChatReaction, error) {
+	collection := r.mongo.Database("link").Collection("messages")
+
+	var chatMessage model.Chat
+	err := collection.FindOne(context.Background(), bson.M{"_id": chatMessageID},
+		options.FindOne().SetProjection(bson.M{"reactions": 1})).Decode(&chatMessage)
+	if err != nil {
+		return nil, fmt.Errorf("이모지 반응 조회 중 MongoDB 오류: %w", err)
+	}
+
+	return toChatEntity(&chatMessage).Reactions, nil
+}
+
+// 답장 대상 메시지 조회 (메시지 ID -> 메시지)
+func (r *chatPersistence) getReplyToMessages(chatMessages []model.Chat) (map[string]*chatEntity.Chat, error) {
+	replyToMap := make(map[string]*chatEntity.Chat)
+
+	replyToIDs := make([]primitive.ObjectID, 0)
+	for _, chatMessage := range chatMessages {
+		if chatMessage.ReplyTo == "" {
+			continue
+		}
+		if replyToID, err := primitive.ObjectIDFromHex(chatMessage.ReplyTo); err == nil {
+			replyToIDs = append(replyToIDs, replyToID)
+		}
+	}
+	if len(replyToIDs) == 0 {
+		return replyToMap, nil
+	}
+
+	collection := r.mongo.Database("link").Collection("messages")
+	cursor, err := collection.Find(context.Background(), bson.M{"_id": bson.M{"$in": replyToIDs}})
+	if err != nil {
+		return nil, fmt.Errorf("답장 대상 메시지 조회 중 MongoDB 오류: %w", err)
+	}
+	defer cursor.Close(context.Background())
+
+	var replyToMessages []model.Chat
+	if err := cursor.All(context.Background(), &replyToMessages); err != nil {
+		return nil, fmt.Errorf("MongoDB 커서 처리 중 오류: %w", err)
+	}
+
+	for _, replyToMessage := range replyToMessages {
+		replyToMap[replyToMessage.ID.Hex()] = toChatEntity(&replyToMessage)
+	}
+
+	return replyToMap, nil
+}
+
+func toChatEntity(chatMessage *model.Chat) *chatEntity.Chat {
+	chat := &chatEntity.Chat{
+		ID:          chatMessage.ID.Hex(),
+		Content:     chatMessage.Content,
+		ChatRoomID:  chatMessage.ChatRoomID,
+		SenderID:    chatMessage.SenderID,
+		SenderName:  chatMessage.SenderName,
+		SenderEmail: chatMessage.SenderEmail,
+		SenderImage: chatMessage.SenderImage,
+		CreatedAt:   chatMessage.CreatedAt,
+		ReplyTo:     chatMessage.ReplyTo,
+		EditedAt:    chatMessage.EditedAt,
+		PinnedBy:    chatMessage.PinnedBy,
+		PinnedAt:    chatMessage.PinnedAt,
+	}
+
+	for _, attachment := range chatMessage.Attachments {
+		chat.Attachments = append(chat.Attachments, chatEntity.ChatAttachment{
+			URL:         attachment.URL,
+			Name:        attachment.Name,
+			Size:        attachment.Size,
+			ContentType: attachment.ContentType,
+		})
+	}
+	for _, reaction := range chatMessage.Reactions {
+		chat.Reactions = append(chat.Reactions, chatEntity.ChatReaction{
+			EmojiID: reaction.EmojiID,
+			Unified: reaction.Unified,
+			Content: reaction.Content,
+			UserIDs: reaction.UserIDs,
+		})
+	}
+	for _, edit := range chatMessage.EditHistory {
+		chat.EditHistory = append(chat.EditHistory, chatEntity.ChatEdit{
+			Content:  edit.Content,
+			EditedAt: edit.EditedAt,
+		})
+	}
+
+	return chat
+}
+
+func toChatAttachmentModels(attachments []chatEntity.ChatAttachment) []model.ChatAttachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+	result := make([]model.ChatAttachment, len(attachments))
+	for i, attachment := range attachments {
+		result[i] = model.ChatAttachment{
+			URL:         attachment.URL,
+			Name:        attachment.Name,
+			Size:        attachment.Size,
+			ContentType: attachment.ContentType,
+		}
+	}
+	return result
+}
+
+// TODO lastMessageID까지의 메시지에서 사용자를 unread_by에서 한 번에 제거 (ObjectID는 생성 순서대로 증가)
+func (r *chatPersistence) MarkMessagesAsRead(userId uint, chatRoomID uint, lastMessageID string) (int64, error) {
+	lastMessageIDObject, err := primitive.ObjectIDFromHex(lastMessageID)
+	if err != nil {
+		return 0, fmt.Errorf("채팅 메시지 ID 변환 중 오류: %w", err)
+	}
+
+	collection := r.mongo.Database("link").Collection("messages")
+	filter := bson.M{
+		"chat_room_id": chatRoomID,
+		"_id":          bson.M{"$lte": lastMessageIDObject},
+		"unread_by":    userId,
+	}
+	update := bson.M{
+		"$pull": bson.M{"unread_by": userId},
+		"$inc":  bson.M{"unread_count": -1},
+	}
+
+	result, err := collection.UpdateMany(context.Background(), filter, update)
+	if err != nil {
+		return 0, fmt.Errorf("메시지 읽음 처리 중 MongoDB 오류: %w", err)
+	}
+
+	return result.ModifiedCount, nil
+}
+
+// TODO 채팅방별 사용자가 읽지 않은 메시지 수
+func (r *chatPersistence) GetUnreadCounts(userId uint, chatRoomIDs []uint) (map[uint]int64, error) {
+	unreadCounts := make(map[uint]int64, len(chatRoomIDs))
+	if len(chatRoomIDs) == 0 {
+		return unreadCounts, nil
+	}
+
+	collection := r.mongo.Database("link").Collection("messages")
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"chat_room_id": bson.M{"$in": chatRoomIDs},
+			"unread_by":    userId,
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   "$chat_room_id",
+			"count": bson.M{"$sum": 1},
+		}}},
+	}
+
+	cursor, err := collection.Aggregate(context.Background(), pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("읽지 않은 메시지 수 조회 중 MongoDB 오류: %w", err)
+	}
+	defer cursor.Close(context.Background())
+
+	var results []struct {
+		ChatRoomID int64 `bson:"_id"`
+		Count      int64 `bson:"count"`
+	}
+	if err := cursor.All(context.Background(), &results); err != nil {
+		return nil, fmt.Errorf("읽지 않은 메시지 수 디코딩 중 오류: %w", err)
+	}
+
+	for _, result := range results {
+		unreadCounts[uint(result.ChatRoomID)] = result.Count
+	}
+
+	return unreadCounts, nil
+}
+
 // TODO 레디스 관련
 func (r *chatPersistence) SetChatRoomToRedis(roomId uint, chatRoomInfo map[string]interface{}) error {
 	//json으로 변환
@@ -480,7 +1027,8 @@ func (r *chatPersistence) LeaveChatRoom(userId uint, chatRoomId uint) error {
 	err := tx.Model(&model.ChatRoomUser{}).
 		Where("user_id = ? AND chat_room_id = ?", userId, chatRoomId).
 		Update("left_at", time.Now()).
-		Update("joined_at", nil).Error
+		Update("joined_at", nil).
+		Update("role", chatEntity.ChatRoomRoleMember).Error
 	if err != nil {
 		tx.Rollback()
 		return fmt.Errorf("채팅방 나가기 중 DB 오류: %w", err)
@@ -573,29 +1121,499 @@ func (r *chatPersistence) AddUserToPrivateChatRoom(requestUserId uint, targetUse
 	return nil
 }
 
-// TODO 그룹 채팅방 사용자 추가
+// TODO 그룹 채팅방 사용자 추가 - 나갔던 사용자는 다시 참여
 func (r *chatPersistence) AddUserToGroupChatRoom(requestUserId uint, targetUserId uint, chatRoomId uint) error {
-	tx := r.db.Begin()
-	if tx.Error != nil {
-		return fmt.Errorf("채팅방 사용자 추가 중 DB 오류: %w", tx.Error)
-	}
-
-	chatRoom, err := r.GetChatRoomById(chatRoomId)
-	if err != nil {
-		tx.Rollback()
+	var chatRoom model.ChatRoom
+	if err := r.db.First(&chatRoom, chatRoomId).Error; err != nil {
 		return fmt.Errorf("채팅방 조회 중 DB 오류: %w", err)
 	}
 
 	if chatRoom.IsPrivate {
-		tx.Rollback()
 		return fmt.Errorf("채팅방이 1:1 채팅방입니다")
 	}
 
-	if err := tx.Commit().Error; err != nil {
-		return fmt.Errorf("트랜잭션 커밋 실패: %w", err)
+	chatRoomUser := model.ChatRoomUser{
+		ChatRoomID:    chatRoomId,
+		UserID:        targetUserId,
+		JoinedAt:      time.Now(),
+		ChatRoomAlias: chatRoom.Name,
+		Role:          chatEntity.ChatRoomRoleMember,
+	}
+
+	// 나갔던 사용자면 left_at을 비우고 다시 참여
+	err := r.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "chat_room_id"}, {Name: "user_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"joined_at":       chatRoomUser.JoinedAt,
+			"left_at":         nil,
+			"chat_room_alias": chatRoomUser.ChatRoomAlias,
+			"role":            chatRoomUser.Role,
+		}),
+	}).Create(&chatRoomUser).Error
+	if err != nil {
+		return fmt.Errorf("채팅방 사용자 추가 중 DB 오류: %w", err)
+	}
+
+	return nil
+}
+
+// TODO 채팅방 참여자 역할 - 참여중이 아니면 빈 문자열
+func (r *chatPersistence) GetChatRoomUserRole(userId uint, chatRoomId uint) (string, error) {
+	var chatRoomUser model.ChatRoomUser
+	err := r.db.
+		Where("user_id = ? AND chat_room_id = ?", userId, chatRoomId).
+		Where("joined_at IS NOT NULL AND left_at IS NULL").
+		First(&chatRoomUser).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil
+		}
+		return "", fmt.Errorf("채팅방 참여자 조회 중 DB 오류: %w", err)
+	}
+	return chatRoomUser.Role, nil
+}
+
+// TODO 채팅방 이름 변경 - 별칭을 바꾸지 않은(기존 이름 그대로인) 참여자의 별칭도 함께 변경
+func (r *chatPersistence) UpdateChatRoomName(chatRoomId uint, name string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var chatRoom model.ChatRoom
+		if err := tx.First(&chatRoom, chatRoomId).Error; err != nil {
+			return fmt.Errorf("채팅방 조회 중 DB 오류: %w", err)
+		}
+
+		if err := tx.Model(&model.ChatRoomUser{}).
+			Where("chat_room_id = ? AND chat_room_alias = ?", chatRoomId, chatRoom.Name).
+			Update("chat_room_alias", name).Error; err != nil {
+			return fmt.Errorf("채팅방 별칭 변경 중 DB 오류: %w", err)
+		}
+
+		if err := tx.Model(&chatRoom).Update("name", name).Error; err != nil {
+			return fmt.Errorf("채팅방 이름 변경 중 DB 오류: %w", err)
+		}
+		return nil
+	})
+}
+
+// TODO 사용자별 채팅방 별칭 변경
+func (r *chatPersistence) UpdateChatRoomAlias(userId uint, chatRoomId uint, alias string) error {
+	if err := r.db.Model(&model.ChatRoomUser{}).
+		Where("user_id = ? AND chat_room_id = ?", userId, chatRoomId).
+		Update("chat_room_alias", alias).Error; err != nil {
+		return fmt.Errorf("채팅방 별칭 변경 중 DB 오류: %w", err)
+	}
+	return nil
+}
+
+// TODO 채팅방 참여자 역할 변경
+func (r *chatPersistence) UpdateChatRoomUserRole(userId uint, chatRoomId uint, role string) error {
+	if err := r.db.Model(&model.ChatRoomUser{}).
+		Where("user_id = ? AND chat_room_id = ?", userId, chatRoomId).
+		Update("role", role).Error; err != nil {
+		return fmt.Errorf("채팅방 역할 변경 중 DB 오류: %w", err)
+	}
+	return nil
+}
+
+// TODO 방장 위임 - 기존 방장은 관리자로
+func (r *chatPersistence) TransferChatRoomOwner(ownerId uint, targetUserId uint, chatRoomId uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.ChatRoomUser{}).
+			Where("user_id = ? AND chat_room_id = ?", ownerId, chatRoomId).
+			Update("role", chatEntity.ChatRoomRoleAdmin).Error; err != nil {
+			return fmt.Errorf("방장 위임 중 DB 오류: %w", err)
+		}
+		if err := tx.Model(&model.ChatRoomUser{}).
+			Where("user_id = ? AND chat_room_id = ?", targetUserId, chatRoomId).
+			Update("role", chatEntity.ChatRoomRoleOwner).Error; err != nil {
+			return fmt.Errorf("방장 위임 중 DB 오류: %w", err)
+		}
+		return nil
+	})
+}
+
+// TODO 방장이 나갔을 때 다음 방장 지정 (관리자 우선, 없으면 가장 먼저 참여한 사용자) - 지정된 사용자 ID 반환
+func (r *chatPersistence) PromoteNextChatRoomOwner(chatRoomId uint) (uint, error) {
+	var next model.ChatRoomUser
+	err := r.db.
+		Where("chat_room_id = ? AND joined_at IS NOT NULL AND left_at IS NULL", chatRoomId).
+		Order(clause.OrderBy{Expression: clause.Expr{SQL: "CASE WHEN role = ? THEN 0 ELSE 1 END, joined_at", Vars: []interface{}{chatEntity.ChatRoomRoleAdmin}}}).
+		First(&next).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("다음 방장 조회 중 DB 오류: %w", err)
+	}
+
+	if err := r.UpdateChatRoomUserRole(next.UserID, chatRoomId, chatEntity.ChatRoomRoleOwner); err != nil {
+		return 0, err
+	}
+	return next.UserID, nil
+}
+
+// TODO 레디스 채팅방 캐시 삭제 (이름, 참여자 변경 시)
+func (r *chatPersistence) DeleteChatRoomFromRedis(roomId uint) error {
+	if err := r.redis.Del(context.Background(), fmt.Sprintf("chatroom:%d", roomId)).Err(); err != nil {
+		return fmt.Errorf("채팅방 캐시 삭제 중 Redis 오류: %w", err)
+	}
+	return nil
+}
+
+// TODO 회사 채팅 보관 정책 조회 - 없으면 nil
+func (r *chatPersistence) GetChatRetentionPolicy(companyId uint) (*chatEntity.ChatRetentionPolicy, error) {
+	var policy model.ChatRetentionPolicy
+	if err := r.db.Where("company_id = ?", companyId).First(&policy).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("채팅 보관 정책 조회 중 DB 오류: %w", err)
+	}
+
+	return &chatEntity.ChatRetentionPolicy{
+		CompanyID:     policy.CompanyID,
+		RetentionDays: policy.RetentionDays,
+		UpdatedBy:     policy.UpdatedBy,
+		UpdatedAt:     policy.UpdatedAt,
+	}, nil
+}
+
+// TODO 회사 채팅 보관 정책 저장 - 회사당 1개라 있으면 수정
+func (r *chatPersistence) UpsertChatRetentionPolicy(policy *chatEntity.ChatRetentionPolicy) error {
+	modelPolicy := model.ChatRetentionPolicy{
+		CompanyID:     policy.CompanyID,
+		RetentionDays: policy.RetentionDays,
+		UpdatedBy:     policy.UpdatedBy,
+	}
+
+	err := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "company_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"retention_days", "updated_by", "updated_at"}),
+	}).Create(&modelPolicy).Error
+	if err != nil {
+		return fmt.Errorf("채팅 보관 정책 저장 중 DB 오류: %w", err)
 	}
 
+	policy.UpdatedAt = modelPolicy.UpdatedAt
 	return nil
 }
 
-//TODO 그룹 채팅방일 때 초대하면 추가
+// TODO 해제되지 않은 법적 보존 조치 목록
+func (r *chatPersistence) GetChatLegalHolds(companyId uint) ([]*chatEntity.ChatLegalHold, error) {
+	var holds []model.ChatLegalHold
+	if err := r.db.
+		Where("company_id = ? AND released_at IS NULL", companyId).
+		Order("created_at DESC").
+		Find(&holds).Error; err != nil {
+		return nil, fmt.Errorf("법적 보존 조치 조회 중 DB 오류: %w", err)
+	}
+
+	result := make([]*chatEntity.ChatLegalHold, len(holds))
+	for i, hold := range holds {
+		result[i] = &chatEntity.ChatLegalHold{
+			ID:         hold.ID,
+			CompanyID:  hold.CompanyID,
+			ChatRoomID: hold.ChatRoomID,
+			Reason:     hold.Reason,
+			CreatedBy:  hold.CreatedBy,
+			CreatedAt:  hold.CreatedAt,
+		}
+	}
+	return result, nil
+}
+
+func (r *chatPersistence) CreateChatLegalHold(hold *chatEntity.ChatLegalHold) error {
+	modelHold := model.ChatLegalHold{
+		CompanyID:  hold.CompanyID,
+		ChatRoomID: hold.ChatRoomID,
+		Reason:     hold.Reason,
+		CreatedBy:  hold.CreatedBy,
+	}
+	if err := r.db.Create(&modelHold).Error; err != nil {
+		return fmt.Errorf("법적 보존 조치 생성 중 DB 오류: %w", err)
+	}
+
+	hold.ID = modelHold.ID
+	hold.CreatedAt = modelHold.CreatedAt
+	return nil
+}
+
+// TODO 법적 보존 조치 해제 - 해당 회사의 해제되지 않은 조치가 없으면 false
+func (r *chatPersistence) ReleaseChatLegalHold(companyId uint, holdId uint, releasedBy uint) (bool, error) {
+	result := r.db.Model(&model.ChatLegalHold{}).
+		Where("id = ? AND company_id = ? AND released_at IS NULL", holdId, companyId).
+		Updates(map[string]interface{}{
+			"released_at": time.Now(),
+			"released_by": releasedBy,
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("법적 보존 조치 해제 중 DB 오류: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// TODO 회사 채팅방인지 확인 - 참여자(나간 사용자 포함) 전원이 해당 회사 소속이어야 함
+// 다른 회사 사용자가 한 명이라도 있으면 그 회사의 대화도 포함되므로 내보내기/보존 조치 대상이 아님
+func (r *chatPersistence) IsChatRoomInCompany(chatRoomId uint, companyId uint) bool {
+	var counts struct {
+		Total     int64
+		InCompany int64
+	}
+	err := r.db.Table("chat_room_users").
+		Select("COUNT(*) AS total, COUNT(*) FILTER (WHERE user_profiles.company_id = ?) AS in_company", companyId).
+		Joins("LEFT JOIN user_profiles ON user_profiles.user_id = chat_room_users.user_id").
+		Where("chat_room_users.chat_room_id = ?", chatRoomId).
+		Scan(&counts).Error
+	return err == nil && counts.Total > 0 && counts.Total == counts.InCompany
+}
+
+// TODO 보관 기간이 지난 메시지를 삭제할 채팅방 목록
+// 참여자(나간 사용자 포함) 회사 중 가장 긴 보관 기간을 적용하고, 영구 보관(0)이나 회사가 없는 참여자가 있으면 제외
+// 채팅방이나 참여자 회사에 해제되지 않은 법적 보존 조치가 있어도 제외
+func (r *chatPersistence) GetChatRoomRetentions(basicRetentionDays int) ([]*chatEntity.ChatRoomRetention, error) {
+	var rows []struct {
+		ChatRoomID    uint
+		RetentionDays int
+	}
+
+	err := r.db.Raw(`
+		SELECT cru.chat_room_id, MAX(retention.days) AS retention_days
+		FROM chat_room_users cru
+		LEFT JOIN user_profiles up ON up.user_id = cru.user_id
+		LEFT JOIN companies c ON c.id = up.company_id
+		LEFT JOIN chat_retention_policies p ON p.company_id = up.company_id
+		CROSS JOIN LATERAL (
+			SELECT COALESCE(p.retention_days, CASE WHEN c.grade = ? THEN ? ELSE 0 END) AS days
+		) retention
+		GROUP BY cru.chat_room_id
+		HAVING BOOL_AND(retention.days > 0)
+		AND NOT EXISTS (
+			SELECT 1 FROM chat_legal_holds h
+			WHERE h.released_at IS NULL
+			AND (
+				h.chat_room_id = cru.chat_room_id
+				OR (h.chat_room_id IS NULL AND h.company_id IN (
+					SELECT up2.company_id FROM chat_room_users cru2
+					JOIN user_profiles up2 ON up2.user_id = cru2.user_id
+					WHERE cru2.chat_room_id = cru.chat_room_id AND up2.company_id IS NOT NULL
+				))
+			)
+		)
+	`, model.CompanyGradeBasic, basicRetentionDays).Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("채팅방 보관 기간 조회 중 DB 오류: %w", err)
+	}
+
+	result := make([]*chatEntity.ChatRoomRetention, len(rows))
+	for i, row := range rows {
+		result[i] = &chatEntity.ChatRoomRetention{
+			ChatRoomID:    row.ChatRoomID,
+			RetentionDays: row.RetentionDays,
+		}
+	}
+	return result, nil
+}
+
+// TODO before 이전에 보낸 메시지 삭제
+func (r *chatPersistence) PurgeChatMessages(chatRoomId uint, before time.Time) (int64, error) {
+	collection := r.mongo.Database("link").Collection("messages")
+
+	result, err := collection.DeleteMany(context.Background(), bson.M{
+		"chat_room_id": chatRoomId,
+		"created_at":   bson.M{"$lt": before},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("채팅 메시지 삭제 중 MongoDB 오류: %w", err)
+	}
+	return result.DeletedCount, nil
+}
+
+// TODO 채팅방에 메시지를 보낸 사용자 ID 목록 (내보내기 시 이름 조회용)
+func (r *chatPersistence) GetChatMessageSenderIDs(chatRoomId uint) ([]uint, error) {
+	collection := r.mongo.Database("link").Collection("messages")
+
+	values, err := collection.Distinct(context.Background(), "sender_id", bson.M{"chat_room_id": chatRoomId})
+	if err != nil {
+		return nil, fmt.Errorf("채팅 발신자 조회 중 MongoDB 오류: %w", err)
+	}
+
+	senderIds := make([]uint, 0, len(values))
+	for _, value := range values {
+		switch id := value.(type) {
+		case int64:
+			senderIds = append(senderIds, uint(id))
+		case int32:
+			senderIds = append(senderIds, uint(id))
+		}
+	}
+	return senderIds, nil
+}
+
+// TODO 채팅방 전체 메시지를 오래된 순으로 하나씩 전달 (내보내기 - 메모리에 모두 올리지 않음)
+func (r *chatPersistence) StreamChatMessages(chatRoomId uint, fn func(chat *chatEntity.Chat) error) error {
+	collection := r.mongo.Database("link").Collection("messages")
+
+	ctx := context.Background()
+	cursor, err := collection.Find(ctx,
+		bson.M{"chat_room_id": chatRoomId},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}),
+	)
+	if err != nil {
+		return fmt.Errorf("채팅 메시지 조회 중 MongoDB 오류: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var chatMessage model.Chat
+		if err := cursor.Decode(&chatMessage); err != nil {
+			return fmt.Errorf("채팅 메시지 디코딩 중 오류: %w", err)
+		}
+		if err := fn(toChatEntity(&chatMessage)); err != nil {
+			return err
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return fmt.Errorf("채팅 메시지 조회 중 MongoDB 오류: %w", err)
+	}
+	return nil
+}
+
+// TODO 회사 공지 채팅방 자동 참여 - 소속 회사 공지방에는 참여시키고(나간 적 있으면 그대로 둠), 다른 회사 공지방에서는 내보냄
+func (r *chatPersistence) SyncCompanyAnnouncementChatRooms(userId uint, companyId *uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		leaveQuery := tx.Model(&model.ChatRoomUser{}).
+			Where("user_id = ? AND left_at IS NULL", userId)
+		if companyId != nil {
+			leaveQuery = leaveQuery.Where("chat_room_id IN (?)", tx.Model(&model.ChatRoom{}).Select("id").Where("is_announcement = ? AND company_id <> ?", true, *companyId))
+		} else {
+			leaveQuery = leaveQuery.Where("chat_room_id IN (?)", tx.Model(&model.ChatRoom{}).Select("id").Where("is_announcement = ?", true))
+		}
+		if err := leaveQuery.Updates(map[string]interface{}{
+			"left_at":   time.Now(),
+			"joined_at": nil,
+			"role":      chatEntity.ChatRoomRoleMember,
+		}).Error; err != nil {
+			return fmt.Errorf("공지 채팅방 나가기 중 DB 오류: %w", err)
+		}
+
+		if companyId == nil {
+			return nil
+		}
+
+		var chatRooms []model.ChatRoom
+		if err := tx.Where("is_announcement = ? AND company_id = ?", true, *companyId).Find(&chatRooms).Error; err != nil {
+			return fmt.Errorf("공지 채팅방 조회 중 DB 오류: %w", err)
+		}
+
+		for _, chatRoom := range chatRooms {
+			chatRoomUser := model.ChatRoomUser{
+				ChatRoomID:    chatRoom.ID,
+				UserID:        userId,
+				JoinedAt:      time.Now(),
+				ChatRoomAlias: chatRoom.Name,
+			}
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&chatRoomUser).Error; err != nil {
+				return fmt.Errorf("공지 채팅방 참여 중 DB 오류: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// TODO 채팅방 고정 메시지 수
+func (r *chatPersistence) CountPinnedChatMessages(chatRoomID uint) (int64, error) {
+	collection := r.mongo.Database("link").Collection("messages")
+
+	count, err := collection.CountDocuments(context.Background(), bson.M{
+		"chat_room_id": chatRoomID,
+		"pinned_at":    bson.M{"$exists": true},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("고정 메시지 수 조회 중 MongoDB 오류: %w", err)
+	}
+	return count, nil
+}
+
+// TODO 메시지 고정 - 고정 후 개수 반환, 이미 고정됐거나 maxPinned개가 차 있으면 false
+// 채팅방 row를 잠근 상태에서 개수 확인 + 고정을 하므로 동시에 요청해도 maxPinned를 넘지 않음
+func (r *chatPersistence) PinChatMessage(chatRoomID uint, chatMessageID string, userId uint, maxPinned int64) (bool, int64, error) {
+	objectID, err := primitive.ObjectIDFromHex(chatMessageID)
+	if err != nil {
+		return false, 0, fmt.Errorf("잘못된 메시지 ID: %w", err)
+	}
+
+	pinned := false
+	var pinnedCount int64
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		var chatRoom model.ChatRoom
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").Where("id = ?", chatRoomID).First(&chatRoom).Error; err != nil {
+			return fmt.Errorf("채팅방 잠금 중 DB 오류: %w", err)
+		}
+
+		count, err := r.CountPinnedChatMessages(chatRoomID)
+		if err != nil {
+			return err
+		}
+		pinnedCount = count
+		if pinnedCount >= maxPinned {
+			return nil
+		}
+
+		collection := r.mongo.Database("link").Collection("messages")
+		result, err := collection.UpdateOne(context.Background(),
+			bson.M{"_id": objectID, "chat_room_id": chatRoomID, "pinned_at": bson.M{"$exists": false}},
+			bson.M{"$set": bson.M{"pinned_by": userId, "pinned_at": time.Now()}},
+		)
+		if err != nil {
+			return fmt.Errorf("메시지 고정 중 MongoDB 오류: %w", err)
+		}
+		if result.ModifiedCount > 0 {
+			pinned = true
+			pinnedCount++
+		}
+		return nil
+	})
+	if err != nil {
+		return false, 0, err
+	}
+	return pinned, pinnedCount, nil
+}
+
+// TODO 메시지 고정 해제 - 고정되지 않은 메시지면 false
+func (r *chatPersistence) UnpinChatMessage(chatRoomID uint, chatMessageID string) (bool, error) {
+	objectID, err := primitive.ObjectIDFromHex(chatMessageID)
+	if err != nil {
+		return false, fmt.Errorf("잘못된 메시지 ID: %w", err)
+	}
+
+	collection := r.mongo.Database("link").Collection("messages")
+	result, err := collection.UpdateOne(context.Background(),
+		bson.M{"_id": objectID, "chat_room_id": chatRoomID, "pinned_at": bson.M{"$exists": true}},
+		bson.M{"$unset": bson.M{"pinned_by": "", "pinned_at": ""}},
+	)
+	if err != nil {
+		return false, fmt.Errorf("메시지 고정 해제 중 MongoDB 오류: %w", err)
+	}
+	return result.ModifiedCount > 0, nil
+}
+
+// TODO 채팅방 고정 메시지 목록 (최근 고정한 순)
+func (r *chatPersistence) GetPinnedChatMessages(chatRoomID uint) ([]*chatEntity.Chat, error) {
+	collection := r.mongo.Database("link").Collection("messages")
+
+	ctx := context.Background()
+	cursor, err := collection.Find(ctx,
+		bson.M{"chat_room_id": chatRoomID, "pinned_at": bson.M{"$exists": true}},
+		options.Find().SetSort(bson.D{{Key: "pinned_at", Value: -1}}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("고정 메시지 조회 중 MongoDB 오류: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var chatMessages []model.Chat
+	if err := cursor.All(ctx, &chatMessages); err != nil {
+		return nil, fmt.Errorf("고정 메시지 디코딩 중 오류: %w", err)
+	}
+
+	return r.toChatEntities(chatMessages)
+}
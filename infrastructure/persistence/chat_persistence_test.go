@@ -0,0 +1,76 @@
+package persistence
+
+import (
+	"regexp"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestChatAroundLimits(t *testing.T) {
+	tests := []struct {
+		limit      int
+		wantBefore int
+		wantAfter  int
+	}{
+		{1, 0, 0}, // 대상 메시지만 - 이전 메시지 조회는 건너뜀
+		{2, 1, 0},
+		{3, 1, 1},
+		{50, 25, 24},
+		{0, 0, 0},
+		{-5, 0, 0},
+	}
+
+	for _, tt := range tests {
+		before, after := chatAroundLimits(tt.limit)
+		if before != tt.wantBefore || after != tt.wantAfter {
+			t.Errorf("chatAroundLimits(%d) = (%d, %d), want (%d, %d)", tt.limit, before, after, tt.wantBefore, tt.wantAfter)
+		}
+		if before < 0 || after < 0 {
+			t.Errorf("chatAroundLimits(%d) 개수가 음수입니다", tt.limit)
+		}
+	}
+}
+
+func TestChatSearchConditions(t *testing.T) {
+	conditions := chatSearchConditions("  회의 a.b  ")
+	if len(conditions) != 2 {
+		t.Fatalf("조건 수 = %d, want 2", len(conditions))
+	}
+
+	patterns := make([]*regexp.Regexp, len(conditions))
+	for i, condition := range conditions {
+		content, ok := condition["content"].(bson.M)
+		if !ok || content["$options"] != "i" {
+			t.Fatalf("대소문자 무시 부분 일치 조건이어야 합니다: %v", condition)
+		}
+		patterns[i] = regexp.MustCompile("(?i)" + content["$regex"].(string))
+	}
+
+	// 조사가 붙은 한국어도 찾음
+	if !patterns[0].MatchString("내일 회의는 10시") {
+		t.Error("'회의'로 '회의는'을 찾지 못했습니다")
+	}
+	// 정규식 특수문자는 그대로 검색
+	if patterns[1].MatchString("axb") || !patterns[1].MatchString("A.B 확인") {
+		t.Error("검색어의 정규식 특수문자가 이스케이프되지 않았습니다")
+	}
+}
+
+func TestChatSearchScore(t *testing.T) {
+	tests := []struct {
+		content string
+		query   string
+		want    float64
+	}{
+		{"회의는 내일 회의실에서", "회의", 2},
+		{"Meeting at 10", "meeting 10", 2},
+		{"관련 없음", "회의", 0},
+	}
+
+	for _, tt := range tests {
+		if got := chatSearchScore(tt.content, tt.query); got != tt.want {
+			t.Errorf("chatSearchScore(%q, %q) = %v, want %v", tt.content, tt.query, got, tt.want)
+		}
+	}
+}
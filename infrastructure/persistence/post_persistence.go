@@ -604,3 +604,146 @@ func (r *postPersistence) GetPostViewCount(postId uint) (int, error) {
 	fmt.Printf("조회수 조회: postId=%d, DB count=%d, diff=%d, total=%d\n", postId, count-diff, diff, count)
 	return count, nil
 }
+
+const postViewFlushStatKey = "post:views:flush:stat"
+
+// GET + DEL 을 원자적으로 처리 (GETDEL 미지원 redis 버전 호환)
+var drainPostViewDiffScript = redis.NewScript(`
+local value = redis.call("GET", KEYS[1])
+if value then
+	redis.call("DEL", KEYS[1])
+end
+return value
+`)
+
+// TODO 조회수 diff 키를 스캔하여 원자적으로 가져오고 삭제
+func (r *postPersistence) DrainPostViewDiffs() (map[uint]int, error) {
+	ctx := context.Background()
+	diffs := make(map[uint]int)
+
+	iter := r.redis.Scan(ctx, 0, "post:views:diff:*", 500).Iterator()
+	for iter.Next(ctx) {
+		key := iter.Val()
+		postId, err := strconv.ParseUint(strings.TrimPrefix(key, "post:views:diff:"), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		value, err := drainPostViewDiffScript.Run(ctx, r.redis, []string{key}).Int()
+		if err != nil {
+			if err == redis.Nil {
+				continue
+			}
+			// 이미 가져온 diff는 되돌려 놓고 종료
+			_ = r.RestorePostViewDiffs(diffs)
+			return nil, fmt.Errorf("조회수 diff 가져오기 실패: %w", err)
+		}
+
+		if value > 0 {
+			diffs[uint(postId)] += value
+		}
+	}
+
+	if err := iter.Err(); err != nil {
+		_ = r.RestorePostViewDiffs(diffs)
+		return nil, fmt.Errorf("조회수 diff 키 스캔 실패: %w", err)
+	}
+
+	return diffs, nil
+}
+
+// TODO 조회수 diff를 하나의 트랜잭션으로 posts.views에 반영
+func (r *postPersistence) ApplyPostViewDiffs(diffs map[uint]int) error {
+	if len(diffs) == 0 {
+		return nil
+	}
+
+	values := make([]string, 0, len(diffs))
+	args := make([]interface{}, 0, len(diffs)*2)
+	for postId, diff := range diffs {
+		values = append(values, "(?::bigint, ?::bigint)")
+		args = append(args, postId, diff)
+	}
+
+	query := fmt.Sprintf(`
+	UPDATE posts AS p
+	SET views = p.views + v.diff
+	FROM (VALUES %s) AS v(id, diff)
+	WHERE p.id = v.id
+	`, strings.Join(values, ","))
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		return tx.Exec(query, args...).Error
+	})
+	if err != nil {
+		return fmt.Errorf("조회수 DB 반영 실패: %w", err)
+	}
+
+	// DB 값이 바뀌었으므로 조회수 캐시 무효화
+	ctx := context.Background()
+	cacheKeys := make([]string, 0, len(diffs))
+	for postId := range diffs {
+		cacheKeys = append(cacheKeys, fmt.Sprintf("post:views:%d", postId))
+	}
+	_ = r.redis.Del(ctx, cacheKeys...).Err()
+
+	return nil
+}
+
+// TODO DB 반영 실패 시 diff를 redis에 되돌림
+func (r *postPersistence) RestorePostViewDiffs(diffs map[uint]int) error {
+	if len(diffs) == 0 {
+		return nil
+	}
+
+	ctx := context.Background()
+	pipe := r.redis.TxPipeline()
+	for postId, diff := range diffs {
+		key := fmt.Sprintf("post:views:diff:%d", postId)
+		pipe.IncrBy(ctx, key, int64(diff))
+		pipe.Expire(ctx, key, 5*time.Minute)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("조회수 diff 복구 실패: %w", err)
+	}
+	return nil
+}
+
+func (r *postPersistence) UpdatePostViewFlushStat(stat *entity.PostViewFlushStat) error {
+	ctx := context.Background()
+
+	fields := map[string]interface{}{
+		"last_run_at":       stat.LastRunAt.Format(time.RFC3339),
+		"last_duration_ms":  stat.LastDurationMs,
+		"last_flushed_post": stat.LastFlushedPost,
+		"last_flushed_view": stat.LastFlushedView,
+		"last_error":        stat.LastError,
+	}
+	if !stat.LastSuccessAt.IsZero() {
+		fields["last_success_at"] = stat.LastSuccessAt.Format(time.RFC3339)
+	}
+
+	if err := r.redis.HSet(ctx, postViewFlushStatKey, fields).Err(); err != nil {
+		return fmt.Errorf("조회수 반영 상태 저장 실패: %w", err)
+	}
+	return nil
+}
+
+func (r *postPersistence) GetPostViewFlushStat() (*entity.PostViewFlushStat, error) {
+	ctx := context.Background()
+
+	data, err := r.redis.HGetAll(ctx, postViewFlushStatKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("조회수 반영 상태 조회 실패: %w", err)
+	}
+
+	stat := &entity.PostViewFlushStat{LastError: data["last_error"]}
+	stat.LastRunAt, _ = time.Parse(time.RFC3339, data["last_run_at"])
+	stat.LastSuccessAt, _ = time.Parse(time.RFC3339, data["last_success_at"])
+	stat.LastDurationMs, _ = strconv.ParseInt(data["last_duration_ms"], 10, 64)
+	stat.LastFlushedPost, _ = strconv.Atoi(data["last_flushed_post"])
+	stat.LastFlushedView, _ = strconv.Atoi(data["last_flushed_view"])
+
+	return stat, nil
+}
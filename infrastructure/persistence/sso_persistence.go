@@ -0,0 +1,160 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+
+	"link/infrastructure/model"
+	"link/internal/sso/entity"
+	"link/internal/sso/repository"
+)
+
+type ssoPersistence struct {
+	db          *gorm.DB
+	redisClient *redis.Client
+}
+
+func NewSsoPersistence(db *gorm.DB, redisClient *redis.Client) repository.SsoRepository {
+	return &ssoPersistence{db: db, redisClient: redisClient}
+}
+
+func ssoStateKey(state string) string {
+	return fmt.Sprintf("auth:sso:state:%s", state)
+}
+
+// TODO 회사 SSO 설정 조회 - 없으면 nil
+func (r *ssoPersistence) GetCompanySso(companyId uint) (*entity.CompanySso, error) {
+	var sso model.CompanySso
+	if err := r.db.Where("company_id = ?", companyId).First(&sso).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		log.Printf("회사 SSO 설정 조회 중 DB 오류: %v", err)
+		return nil, fmt.Errorf("회사 SSO 설정 조회 중 DB 오류: %w", err)
+	}
+
+	return &entity.CompanySso{
+		ID:              sso.ID,
+		CompanyID:       sso.CompanyID,
+		Protocol:        sso.Protocol,
+		Issuer:          sso.Issuer,
+		ClientID:        sso.ClientID,
+		ClientSecret:    sso.ClientSecret,
+		Scopes:          strings.Fields(sso.Scopes),
+		EmailClaim:      sso.EmailClaim,
+		NameClaim:       sso.NameClaim,
+		DepartmentClaim: sso.DepartmentClaim,
+		PositionClaim:   sso.PositionClaim,
+		AutoProvision:   sso.AutoProvision,
+		AllowedDomains:  strings.Fields(sso.AllowedDomains),
+		Enabled:         sso.Enabled,
+		CreatedAt:       sso.CreatedAt,
+		UpdatedAt:       sso.UpdatedAt,
+	}, nil
+}
+
+// TODO 회사 SSO 설정 저장 - 회사당 1개라 있으면 수정
+func (r *ssoPersistence) UpsertCompanySso(sso *entity.CompanySso) error {
+	values := map[string]interface{}{
+		"protocol":         sso.Protocol,
+		"issuer":           sso.Issuer,
+		"client_id":        sso.ClientID,
+		"client_secret":    sso.ClientSecret,
+		"scopes":           strings.Join(sso.Scopes, " "),
+		"email_claim":      sso.EmailClaim,
+		"name_claim":       sso.NameClaim,
+		"department_claim": sso.DepartmentClaim,
+		"position_claim":   sso.PositionClaim,
+		"auto_provision":   sso.AutoProvision,
+		"allowed_domains":  strings.Join(sso.AllowedDomains, " "),
+		"enabled":          sso.Enabled,
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var existing model.CompanySso
+		err := tx.Where("company_id = ?", sso.CompanyID).First(&existing).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Printf("회사 SSO 설정 조회 중 DB 오류: %v", err)
+			return fmt.Errorf("회사 SSO 설정 조회 중 DB 오류: %w", err)
+		}
+
+		if err == nil {
+			if err := tx.Model(&existing).Updates(values).Error; err != nil {
+				log.Printf("회사 SSO 설정 수정 중 DB 오류: %v", err)
+				return fmt.Errorf("회사 SSO 설정 수정 중 DB 오류: %w", err)
+			}
+			return nil
+		}
+
+		// bool 기본값(default:true)이 false로 덮이지 않도록 map으로 생성
+		values["company_id"] = sso.CompanyID
+		values["created_at"] = time.Now()
+		values["updated_at"] = time.Now()
+		if err := tx.Model(&model.CompanySso{}).Create(values).Error; err != nil {
+			log.Printf("회사 SSO 설정 생성 중 DB 오류: %v", err)
+			return fmt.Errorf("회사 SSO 설정 생성 중 DB 오류: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r *ssoPersistence) DeleteCompanySso(companyId uint) error {
+	if err := r.db.Where("company_id = ?", companyId).Delete(&model.CompanySso{}).Error; err != nil {
+		log.Printf("회사 SSO 설정 삭제 중 DB 오류: %v", err)
+		return fmt.Errorf("회사 SSO 설정 삭제 중 DB 오류: %w", err)
+	}
+	return nil
+}
+
+func (r *ssoPersistence) StoreSsoState(state *entity.SsoState) error {
+	ctx := context.Background()
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("SSO 상태 직렬화 오류: %w", err)
+	}
+
+	if err := r.redisClient.Set(ctx, ssoStateKey(state.State), data, time.Until(state.ExpiresAt)).Err(); err != nil {
+		log.Printf("SSO 상태 저장 오류: %v", err)
+		return fmt.Errorf("SSO 상태 저장 오류: %w", err)
+	}
+	return nil
+}
+
+// TODO SSO 상태 1회 사용 (조회 후 삭제) - 없거나 만료되면 nil
+func (r *ssoPersistence) ConsumeSsoState(state string) (*entity.SsoState, error) {
+	ctx := context.Background()
+
+	var get *redis.StringCmd
+	_, err := r.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		get = pipe.Get(ctx, ssoStateKey(state))
+		pipe.Del(ctx, ssoStateKey(state))
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		log.Printf("SSO 상태 조회 오류: %v", err)
+		return nil, fmt.Errorf("SSO 상태 조회 오류: %w", err)
+	}
+
+	data, err := get.Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("SSO 상태 조회 오류: %w", err)
+	}
+
+	var ssoState entity.SsoState
+	if err := json.Unmarshal(data, &ssoState); err != nil {
+		return nil, fmt.Errorf("SSO 상태 파싱 오류: %w", err)
+	}
+	return &ssoState, nil
+}
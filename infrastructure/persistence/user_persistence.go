@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -40,6 +41,9 @@ func (r *userPersistence) CreateUser(user *entity.User) error {
 		Phone:    *user.Phone,
 		Role:     model.UserRole(user.Role),
 	}
+	if user.Status != nil {
+		modelUser.Status = *user.Status
+	}
 
 	var userOmitFields []string
 	val := reflect.ValueOf(modelUser).Elem()
@@ -133,6 +137,7 @@ func (r *userPersistence) CreateUser(user *entity.User) error {
 		return fmt.Errorf("트랜잭션 커밋 중 DB 오류: %w", err)
 	}
 
+	user.ID = &modelUser.ID
 	return nil
 }
 
@@ -187,7 +192,8 @@ func (r *userPersistence) GetUserByEmail(email string) (*entity.User, error) {
 	// 	Joins("LEFT JOIN user_profiles ON user_profiles.user_id = users.id").
 	// 	Select("users.id", "users.email", "users.nickname", "users.name", "users.role", "users.password", "user_profiles.company_id").
 	// 	Where("users.email = ?", email).First(&user).Error
-	err := r.db.Preload("UserProfile").Preload("UserProfile.Departments").Where("email = ?", email).First(&user).Error
+	// 대소문자 구분 없이 조회 (로그인은 소문자로 정규화한 이메일로 조회)
+	err := r.db.Preload("UserProfile").Preload("UserProfile.Departments").Where("LOWER(email) = LOWER(?)", email).First(&user).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("사용자를 찾을 수 없습니다: %s", email)
@@ -910,6 +916,47 @@ func (r *userPersistence) AdminSearchUser(searchTerm string) ([]entity.User, err
 	return entityUsers, nil
 }
 
+// TODO 2단계 인증 정보 조회 - 시크릿이 들어있으므로 캐시 없이 DB에서 직접 조회
+func (r *userPersistence) GetUserTotp(userId uint) (*entity.UserTotp, error) {
+	var user model.User
+	err := r.db.Select("id", "totp_enabled", "totp_secret", "totp_recovery_codes", "totp_enabled_at").
+		Where("id = ?", userId).First(&user).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("사용자를 찾을 수 없습니다: %d", userId)
+		}
+		return nil, fmt.Errorf("2단계 인증 정보 조회 중 DB 오류: %w", err)
+	}
+
+	recoveryCodes := make([]string, 0)
+	if user.TotpRecoveryCodes != "" {
+		recoveryCodes = strings.Split(user.TotpRecoveryCodes, ",")
+	}
+
+	return &entity.UserTotp{
+		UserId:        user.ID,
+		Enabled:       user.TotpEnabled,
+		Secret:        user.TotpSecret,
+		RecoveryCodes: recoveryCodes,
+		EnabledAt:     user.TotpEnabledAt,
+	}, nil
+}
+
+// TODO 2단계 인증 정보 저장 (등록, 해제, 복구 코드 사용/재발급)
+func (r *userPersistence) UpdateUserTotp(totp *entity.UserTotp) error {
+	updates := map[string]interface{}{
+		"totp_enabled":        totp.Enabled,
+		"totp_secret":         totp.Secret,
+		"totp_recovery_codes": strings.Join(totp.RecoveryCodes, ","),
+		"totp_enabled_at":     totp.EnabledAt,
+	}
+
+	if err := r.db.Model(&model.User{}).Where("id = ?", totp.UserId).Updates(updates).Error; err != nil {
+		return fmt.Errorf("2단계 인증 정보 저장 중 DB 오류: %w", err)
+	}
+	return nil
+}
+
 func (r *userPersistence) UpdateUserDepartments(userId uint, departmentIds []uint) error {
 	//TODO 사용자 부서 업데이트 중간테이블업데이트 해야함 갯수 안맞는데 새로 들어온건 새로 삽입
 	//TODO 삭제를 하고 다시 삽입을 해야하나? -> 고민
@@ -0,0 +1,97 @@
+package entity
+
+import "time"
+
+// 개인 액세스 토큰 형식: link_pat_<랜덤 40자리 hex> (DB에는 sha256 해시만 저장)
+const TokenPrefix = "link_pat_"
+
+// 토큰 권한 범위 - 라우트 그룹마다 읽기/쓰기 scope를 확인 (쓰기 scope는 읽기 포함)
+const (
+	ScopeUserRead          = "user:read"
+	ScopeUserWrite         = "user:write"
+	ScopeCompanyRead       = "company:read" // 회사, 부서, 직책
+	ScopeCompanyWrite      = "company:write"
+	ScopeChatRead          = "chat:read"
+	ScopeChatWrite         = "chat:write"
+	ScopeNotificationRead  = "notification:read"
+	ScopeNotificationWrite = "notification:write"
+	ScopePostRead          = "post:read" // 게시글, 댓글, 좋아요
+	ScopePostWrite         = "post:write"
+	ScopeProjectRead       = "project:read"
+	ScopeProjectWrite      = "project:write"
+	ScopeBoardRead         = "board:read"
+	ScopeBoardWrite        = "board:write"
+	ScopeReportRead        = "report:read"
+	ScopeReportWrite       = "report:write"
+	ScopeStatRead          = "stat:read"
+	ScopeAdminUser         = "admin:user"    // 관리자 API - 토큰 소유자가 관리자일 때만 발급 가능
+	ScopeAdminCompany      = "admin:company" // 관리자 회사, 부서 관리
+	ScopeAdminReport       = "admin:report"  // 관리자 신고 조회
+)
+
+var Scopes = []string{
+	ScopeUserRead, ScopeUserWrite,
+	ScopeCompanyRead, ScopeCompanyWrite,
+	ScopeChatRead, ScopeChatWrite,
+	ScopeNotificationRead, ScopeNotificationWrite,
+	ScopePostRead, ScopePostWrite,
+	ScopeProjectRead, ScopeProjectWrite,
+	ScopeBoardRead, ScopeBoardWrite,
+	ScopeReportRead, ScopeReportWrite,
+	ScopeStatRead,
+	ScopeAdminUser, ScopeAdminCompany, ScopeAdminReport,
+}
+
+func IsValidScope(scope string) bool {
+	for _, s := range Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// 감사 로그 이벤트
+const (
+	AuditActionCreated = "created"
+	AuditActionUsed    = "used" // 사용 기록은 토큰당 1분에 1번만 남김
+	AuditActionDenied  = "denied"
+	AuditActionRevoked = "revoked"
+)
+
+type PersonalAccessToken struct {
+	ID         uint       `json:"id"`
+	UserID     uint       `json:"user_id"`
+	UserEmail  string     `json:"-"` // 인증 시 Context 설정용
+	CreatedBy  uint       `json:"created_by"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"` // 목록에서 구분용 (link_pat_xxxxxxxx)
+	TokenHash  string     `json:"-"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	LastUsedIP string     `json:"last_used_ip,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+func (t *PersonalAccessToken) HasScope(scope string) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+type AccessTokenAudit struct {
+	ID        uint      `json:"id"`
+	TokenID   uint      `json:"token_id"`
+	UserID    uint      `json:"user_id"`
+	ActorID   uint      `json:"actor_id"` // 생성/폐기한 사용자 (사용 기록은 토큰 소유자)
+	Action    string    `json:"action"`
+	IP        string    `json:"ip,omitempty"`
+	UserAgent string    `json:"user_agent,omitempty"`
+	Detail    string    `json:"detail,omitempty"` // 요청 경로, 거부된 scope 등
+	CreatedAt time.Time `json:"created_at"`
+}
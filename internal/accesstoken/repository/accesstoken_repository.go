@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"link/internal/accesstoken/entity"
+	"time"
+)
+
+type AccessTokenRepository interface {
+	CreateAccessToken(token *entity.PersonalAccessToken) error
+	GetAccessTokenByHash(tokenHash string) (*entity.PersonalAccessToken, error)
+	GetAccessTokenByID(tokenId uint) (*entity.PersonalAccessToken, error)
+	GetAccessTokensByUser(userId uint) ([]*entity.PersonalAccessToken, error) // 본인 토큰 + 본인이 발급해준 토큰
+	RevokeAccessToken(tokenId uint, revokedAt time.Time) error
+
+	//TODO 마지막 사용 기록 - DB 쓰기를 줄이기 위해 redis로 주기 제한
+	TryMarkAccessTokenUsed(tokenId uint, interval time.Duration) (bool, error)
+	UpdateAccessTokenLastUsed(tokenId uint, usedAt time.Time, ip string) error
+
+	//TODO 감사 로그
+	CreateAccessTokenAudit(audit *entity.AccessTokenAudit) error
+	GetAccessTokenAudits(tokenId uint, limit int) ([]*entity.AccessTokenAudit, error)
+}
@@ -0,0 +1,310 @@
+package usecase
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"link/internal/accesstoken/entity"
+	_accessTokenRepo "link/internal/accesstoken/repository"
+	_userEntity "link/internal/user/entity"
+	_userRepo "link/internal/user/repository"
+	"link/pkg/common"
+	"link/pkg/dto/req"
+	"link/pkg/dto/res"
+	_utils "link/pkg/util"
+)
+
+// 마지막 사용 시각/사용 감사 로그 기록 주기
+const accessTokenUsageInterval = time.Minute
+const accessTokenAuditLimit = 100
+
+// scope별로 토큰 소유자가 가져야 하는 최소 권한 (숫자가 작을수록 높은 권한)
+var scopeRequiredRoles = map[string]_userEntity.UserRole{
+	entity.ScopeAdminUser:    _userEntity.RoleSubAdmin,
+	entity.ScopeAdminCompany: _userEntity.RoleSubAdmin,
+	entity.ScopeAdminReport:  _userEntity.RoleSubAdmin,
+}
+
+type AccessTokenUsecase interface {
+	CreateAccessToken(requestUserId uint, request *req.CreateAccessTokenRequest, ip string, userAgent string) (*res.CreateAccessTokenResponse, error)
+	CreateUserAccessToken(requestUserId uint, request *req.CreateUserAccessTokenRequest, ip string, userAgent string) (*res.CreateAccessTokenResponse, error)
+	GetAccessTokens(requestUserId uint) ([]res.AccessTokenResponse, error)
+	RevokeAccessToken(requestUserId uint, tokenId uint, ip string, userAgent string) error
+	GetAccessTokenAudits(requestUserId uint, tokenId uint) ([]res.AccessTokenAuditResponse, error)
+	GetScopes() []string
+
+	//TODO 인터셉터 - 토큰 인증 / scope 거부 기록
+	ValidateAccessToken(token string, ip string, userAgent string, path string) (*entity.PersonalAccessToken, error)
+	RecordScopeDenied(token *entity.PersonalAccessToken, scope string, ip string, userAgent string, path string)
+}
+
+type accessTokenUsecase struct {
+	accessTokenRepo _accessTokenRepo.AccessTokenRepository
+	userRepo        _userRepo.UserRepository
+}
+
+func NewAccessTokenUsecase(accessTokenRepo _accessTokenRepo.AccessTokenRepository, userRepo _userRepo.UserRepository) AccessTokenUsecase {
+	return &accessTokenUsecase{accessTokenRepo: accessTokenRepo, userRepo: userRepo}
+}
+
+func IsAccessToken(token string) bool {
+	return strings.HasPrefix(token, entity.TokenPrefix)
+}
+
+func hashAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// TODO 본인 토큰 발급
+func (u *accessTokenUsecase) CreateAccessToken(requestUserId uint, request *req.CreateAccessTokenRequest, ip string, userAgent string) (*res.CreateAccessTokenResponse, error) {
+	user, err := u.userRepo.GetUserByID(requestUserId)
+	if err != nil {
+		return nil, common.NewError(http.StatusBadRequest, "존재 하지 않는 사용자 입니다", err)
+	}
+
+	return u.issueAccessToken(user, requestUserId, request, ip, userAgent)
+}
+
+// TODO 회사 관리자 - 같은 회사 소속 사용자(봇 계정 등)에게 토큰 발급
+func (u *accessTokenUsecase) CreateUserAccessToken(requestUserId uint, request *req.CreateUserAccessTokenRequest, ip string, userAgent string) (*res.CreateAccessTokenResponse, error) {
+	requestUser, err := u.userRepo.GetUserByID(requestUserId)
+	if err != nil {
+		return nil, common.NewError(http.StatusBadRequest, "존재 하지 않는 사용자 입니다", err)
+	}
+
+	targetUser, err := u.userRepo.GetUserByID(request.UserID)
+	if err != nil {
+		return nil, common.NewError(http.StatusNotFound, "대상 사용자를 찾을 수 없습니다", err)
+	}
+
+	if requestUser.UserProfile == nil || requestUser.UserProfile.CompanyID == nil ||
+		targetUser.UserProfile == nil || targetUser.UserProfile.CompanyID == nil ||
+		*requestUser.UserProfile.CompanyID != *targetUser.UserProfile.CompanyID {
+		return nil, common.NewError(http.StatusForbidden, "같은 회사 소속 사용자에게만 발급할 수 있습니다", nil)
+	}
+
+	//본인이 아니면 자신보다 낮은 권한의 사용자 토큰만 발급 가능 (같은 권한도 불가)
+	if request.UserID != requestUserId && targetUser.Role <= requestUser.Role {
+		return nil, common.NewError(http.StatusForbidden, "권한이 없습니다", nil)
+	}
+
+	return u.issueAccessToken(targetUser, requestUserId, &request.CreateAccessTokenRequest, ip, userAgent)
+}
+
+func (u *accessTokenUsecase) issueAccessToken(owner *_userEntity.User, actorId uint, request *req.CreateAccessTokenRequest, ip string, userAgent string) (*res.CreateAccessTokenResponse, error) {
+	scopes, err := normalizeScopes(request.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	//토큰 소유자 권한으로 가질 수 없는 scope는 발급 불가
+	for _, scope := range scopes {
+		if requiredRole, ok := scopeRequiredRoles[scope]; ok && owner.Role > requiredRole {
+			return nil, common.NewError(http.StatusForbidden, fmt.Sprintf("대상 사용자의 권한으로는 %s scope를 사용할 수 없습니다", scope), nil)
+		}
+	}
+
+	secret := make([]byte, 20)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "토큰 생성에 실패했습니다", err)
+	}
+	plainToken := entity.TokenPrefix + hex.EncodeToString(secret)
+
+	token := &entity.PersonalAccessToken{
+		UserID:    *owner.ID,
+		CreatedBy: actorId,
+		Name:      strings.TrimSpace(request.Name),
+		Prefix:    plainToken[:len(entity.TokenPrefix)+8],
+		TokenHash: hashAccessToken(plainToken),
+		Scopes:    scopes,
+	}
+	if request.ExpiresInDays > 0 {
+		expiresAt := time.Now().AddDate(0, 0, request.ExpiresInDays)
+		token.ExpiresAt = &expiresAt
+	}
+
+	if err := u.accessTokenRepo.CreateAccessToken(token); err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "토큰 생성에 실패했습니다", err)
+	}
+
+	u.audit(token, actorId, entity.AuditActionCreated, ip, userAgent, strings.Join(scopes, " "))
+
+	return &res.CreateAccessTokenResponse{
+		Token:               plainToken,
+		AccessTokenResponse: toAccessTokenResponse(token),
+	}, nil
+}
+
+// TODO 토큰 목록 (본인 토큰 + 본인이 발급해준 토큰)
+func (u *accessTokenUsecase) GetAccessTokens(requestUserId uint) ([]res.AccessTokenResponse, error) {
+	tokens, err := u.accessTokenRepo.GetAccessTokensByUser(requestUserId)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "토큰 목록 조회에 실패했습니다", err)
+	}
+
+	response := make([]res.AccessTokenResponse, len(tokens))
+	for i, token := range tokens {
+		response[i] = toAccessTokenResponse(token)
+	}
+	return response, nil
+}
+
+// TODO 토큰 폐기 - 소유자 또는 발급자
+func (u *accessTokenUsecase) RevokeAccessToken(requestUserId uint, tokenId uint, ip string, userAgent string) error {
+	token, err := u.getManageableToken(requestUserId, tokenId)
+	if err != nil {
+		return err
+	}
+	if token.RevokedAt != nil {
+		return nil
+	}
+
+	if err := u.accessTokenRepo.RevokeAccessToken(tokenId, time.Now()); err != nil {
+		return common.NewError(http.StatusInternalServerError, "토큰 폐기에 실패했습니다", err)
+	}
+
+	u.audit(token, requestUserId, entity.AuditActionRevoked, ip, userAgent, "")
+	return nil
+}
+
+// TODO 토큰 감사 로그 조회 - 소유자 또는 발급자
+func (u *accessTokenUsecase) GetAccessTokenAudits(requestUserId uint, tokenId uint) ([]res.AccessTokenAuditResponse, error) {
+	if _, err := u.getManageableToken(requestUserId, tokenId); err != nil {
+		return nil, err
+	}
+
+	audits, err := u.accessTokenRepo.GetAccessTokenAudits(tokenId, accessTokenAuditLimit)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "감사 로그 조회에 실패했습니다", err)
+	}
+
+	response := make([]res.AccessTokenAuditResponse, len(audits))
+	for i, audit := range audits {
+		response[i] = res.AccessTokenAuditResponse{
+			Action:    audit.Action,
+			ActorID:   audit.ActorID,
+			IP:        audit.IP,
+			UserAgent: audit.UserAgent,
+			Detail:    audit.Detail,
+			CreatedAt: _utils.ParseKst(audit.CreatedAt).Format(time.DateTime),
+		}
+	}
+	return response, nil
+}
+
+func (u *accessTokenUsecase) GetScopes() []string {
+	return entity.Scopes
+}
+
+// TODO 토큰 인증 - 해시 조회 후 폐기/만료/계정 상태 확인, 사용 기록은 주기적으로만 남김
+func (u *accessTokenUsecase) ValidateAccessToken(plainToken string, ip string, userAgent string, path string) (*entity.PersonalAccessToken, error) {
+	token, err := u.accessTokenRepo.GetAccessTokenByHash(hashAccessToken(plainToken))
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "토큰 조회에 실패했습니다", err)
+	}
+	if token == nil {
+		return nil, common.NewError(http.StatusUnauthorized, "유효하지 않은 토큰입니다", nil)
+	}
+	if token.RevokedAt != nil {
+		return nil, common.NewError(http.StatusUnauthorized, "폐기된 토큰입니다", nil)
+	}
+	if token.ExpiresAt != nil && time.Now().After(*token.ExpiresAt) {
+		return nil, common.NewError(http.StatusUnauthorized, "만료된 토큰입니다", nil)
+	}
+
+	user, err := u.userRepo.GetUserByID(token.UserID)
+	if err != nil {
+		return nil, common.NewError(http.StatusUnauthorized, "사용자를 찾을 수 없습니다", err)
+	}
+	if user.Status != nil && (*user.Status == _userEntity.UserStatusSuspended || *user.Status == _userEntity.UserStatusLocked) {
+		return nil, common.NewError(http.StatusForbidden, "사용할 수 없는 계정입니다", nil)
+	}
+
+	first, err := u.accessTokenRepo.TryMarkAccessTokenUsed(token.ID, accessTokenUsageInterval)
+	if err == nil && first {
+		go func() {
+			if err := u.accessTokenRepo.UpdateAccessTokenLastUsed(token.ID, time.Now(), ip); err != nil {
+				log.Printf("액세스 토큰 사용 시각 업데이트 실패: %v", err)
+			}
+			u.audit(token, token.UserID, entity.AuditActionUsed, ip, userAgent, path)
+		}()
+	}
+
+	return token, nil
+}
+
+func (u *accessTokenUsecase) RecordScopeDenied(token *entity.PersonalAccessToken, scope string, ip string, userAgent string, path string) {
+	u.audit(token, token.UserID, entity.AuditActionDenied, ip, userAgent, fmt.Sprintf("%s (%s)", path, scope))
+}
+
+func (u *accessTokenUsecase) getManageableToken(requestUserId uint, tokenId uint) (*entity.PersonalAccessToken, error) {
+	token, err := u.accessTokenRepo.GetAccessTokenByID(tokenId)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "토큰 조회에 실패했습니다", err)
+	}
+	if token == nil || (token.UserID != requestUserId && token.CreatedBy != requestUserId) {
+		return nil, common.NewError(http.StatusNotFound, "토큰을 찾을 수 없습니다", nil)
+	}
+	return token, nil
+}
+
+// 감사 로그 저장 실패는 요청을 막지 않음
+func (u *accessTokenUsecase) audit(token *entity.PersonalAccessToken, actorId uint, action string, ip string, userAgent string, detail string) {
+	if err := u.accessTokenRepo.CreateAccessTokenAudit(&entity.AccessTokenAudit{
+		TokenID:   token.ID,
+		UserID:    token.UserID,
+		ActorID:   actorId,
+		Action:    action,
+		IP:        ip,
+		UserAgent: userAgent,
+		Detail:    detail,
+	}); err != nil {
+		log.Printf("액세스 토큰 감사 로그 저장 실패: %v", err)
+	}
+}
+
+func normalizeScopes(scopes []string) ([]string, error) {
+	seen := make(map[string]bool)
+	result := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.ToLower(strings.TrimSpace(scope))
+		if !entity.IsValidScope(scope) {
+			return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("알 수 없는 scope입니다: %s", scope), nil)
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			result = append(result, scope)
+		}
+	}
+	return result, nil
+}
+
+func toAccessTokenResponse(token *entity.PersonalAccessToken) res.AccessTokenResponse {
+	response := res.AccessTokenResponse{
+		ID:         token.ID,
+		UserID:     token.UserID,
+		CreatedBy:  token.CreatedBy,
+		Name:       token.Name,
+		Prefix:     token.Prefix,
+		Scopes:     token.Scopes,
+		LastUsedIP: token.LastUsedIP,
+		CreatedAt:  _utils.ParseKst(token.CreatedAt).Format(time.DateTime),
+	}
+	if token.ExpiresAt != nil {
+		response.ExpiresAt = _utils.ParseKst(*token.ExpiresAt).Format(time.DateTime)
+	}
+	if token.LastUsedAt != nil {
+		response.LastUsedAt = _utils.ParseKst(*token.LastUsedAt).Format(time.DateTime)
+	}
+	if token.RevokedAt != nil {
+		response.RevokedAt = _utils.ParseKst(*token.RevokedAt).Format(time.DateTime)
+	}
+	return response
+}
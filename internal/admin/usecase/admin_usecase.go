@@ -3,8 +3,12 @@ package usecase
 import (
 	"log"
 	"net/http"
+	"strings"
 	"time"
 
+	_authEntity "link/internal/auth/entity"
+	_authRepo "link/internal/auth/repository"
+	_chatRepo "link/internal/chat/repository"
 	_companyEntity "link/internal/company/entity"
 	_companyRepo "link/internal/company/repository"
 	_departmentEntity "link/internal/department/entity"
@@ -28,6 +32,7 @@ type AdminUsecase interface {
 	AdminSearchUser(adminUserId uint, searchTerm string) ([]res.AdminGetUserByIdResponse, error)
 	AdminUpdateUser(adminUserId uint, targetUserId uint, request *req.AdminUpdateUserRequest) error
 	AdminUpdateUserStatus(adminUserId uint, targetUserId uint, status string) error
+	AdminForceLogoutUser(adminUserId uint, targetUserId uint) error
 
 	AdminUpdateUserRole(adminUserId uint, targetUserId uint, role uint) error
 	AdminRemoveUserFromCompany(adminUserId uint, targetUserId uint) error
@@ -53,17 +58,30 @@ type adminUsecase struct {
 	userRepository       _userRepo.UserRepository
 	departmentRepository _departmentRepo.DepartmentRepository
 	reportRepository     _reportRepo.ReportRepository
+	authRepository       _authRepo.AuthRepository
+	chatRepository       _chatRepo.ChatRepository
 }
 
 func NewAdminUsecase(companyRepository _companyRepo.CompanyRepository,
 	userRepository _userRepo.UserRepository,
 	departmentRepository _departmentRepo.DepartmentRepository,
-	reportRepository _reportRepo.ReportRepository) AdminUsecase {
+	reportRepository _reportRepo.ReportRepository,
+	authRepository _authRepo.AuthRepository,
+	chatRepository _chatRepo.ChatRepository) AdminUsecase {
 	return &adminUsecase{
 		companyRepository:    companyRepository,
 		userRepository:       userRepository,
 		departmentRepository: departmentRepository,
 		reportRepository:     reportRepository,
+		authRepository:       authRepository,
+		chatRepository:       chatRepository,
+	}
+}
+
+// 회사 소속이 바뀌면 회사 공지 채팅방 참여/나가기 (실패해도 소속 변경은 유지)
+func (u *adminUsecase) syncAnnouncementChatRooms(userId uint, companyId *uint) {
+	if err := u.chatRepository.SyncCompanyAnnouncementChatRooms(userId, companyId); err != nil {
+		log.Printf("공지 채팅방 참여 처리 중 DB 오류: %v", err)
 	}
 }
 
@@ -107,6 +125,9 @@ func (u *adminUsecase) AdminRegisterAdmin(requestUserId uint, request *req.Admin
 		log.Printf("관리자 등록에 실패했습니다: %v", err)
 		return nil, common.NewError(http.StatusInternalServerError, "관리자 등록에 실패했습니다", err)
 	}
+	if admin.ID != nil {
+		u.syncAnnouncementChatRooms(*admin.ID, &companyID)
+	}
 
 	return admin, nil
 }
@@ -136,19 +157,6 @@ func (u *adminUsecase) AdminGetAllUsers(requestUserId uint) ([]_userEntity.User,
 
 // TODO 회사 등록
 func (c *adminUsecase) AdminCreateCompany(requestUserID uint, request *req.AdminCreateCompanyRequest) (*res.AdminRegisterCompanyResponse, error) {
-
-	//TODO 관리자 계정인지 확인
-	user, err := c.userRepository.GetUserByID(requestUserID)
-	if err != nil {
-		log.Printf("관리자 계정 조회 중 오류 발생: %v", err)
-		return nil, common.NewError(http.StatusInternalServerError, "관리자 계정 조회 중 오류 발생", err)
-	}
-
-	if user.Role > _userEntity.RoleSubAdmin {
-		log.Printf("권한이 없는 사용자가 회사를 등록하려 했습니다: 요청자 ID %d", requestUserID)
-		return nil, common.NewError(http.StatusForbidden, "관리자 계정이 아닙니다", err)
-	}
-
 	var cpLogo string
 	if request.CpLogo != nil {
 		cpLogo = *request.CpLogo
@@ -191,17 +199,6 @@ func (c *adminUsecase) AdminCreateCompany(requestUserID uint, request *req.Admin
 
 // TODO 회사 사용자 조회
 func (c *adminUsecase) AdminGetUsersByCompany(adminUserId uint, companyID uint, query *req.UserQuery) ([]res.AdminGetUserByIdResponse, error) {
-	adminUser, err := c.userRepository.GetUserByID(adminUserId)
-	if err != nil {
-		log.Printf("관리자 계정 조회 중 오류 발생: %v", err)
-		return nil, common.NewError(http.StatusInternalServerError, "관리자 계정 조회 중 오류 발생", err)
-	}
-
-	if adminUser.Role > _userEntity.RoleSubAdmin {
-		log.Printf("권한이 없는 사용자가 회사 사용자 조회하려 했습니다: 요청자 ID %d", adminUserId)
-		return nil, common.NewError(http.StatusForbidden, "관리자 계정이 아닙니다", err)
-	}
-
 	if query.SortBy == "" {
 		query.SortBy = req.UserSortBy(req.UserSortByID)
 	}
@@ -271,17 +268,6 @@ func (c *adminUsecase) AdminGetUsersByCompany(adminUserId uint, companyID uint,
 
 // TODO 사용자 정보 업데이트
 func (u *adminUsecase) AdminUpdateUser(adminUserId uint, targetUserId uint, request *req.AdminUpdateUserRequest) error {
-	adminUser, err := u.userRepository.GetUserByID(adminUserId)
-	if err != nil {
-		log.Printf("관리자 계정 조회 중 오류 발생: %v", err)
-		return common.NewError(http.StatusInternalServerError, "관리자 계정 조회 중 오류 발생", err)
-	}
-
-	if adminUser.Role > _userEntity.RoleSubAdmin {
-		log.Printf("권한이 없는 사용자가 사용자 정보를 업데이트하려 했습니다: 요청자 ID %d", adminUserId)
-		return common.NewError(http.StatusForbidden, "권한이 없습니다", err)
-	}
-
 	targetUser, err := u.userRepository.GetUserByID(targetUserId)
 	if err != nil {
 		log.Printf("대상 사용자 조회 중 오류 발생: %v", err)
@@ -348,6 +334,12 @@ func (u *adminUsecase) AdminUpdateUser(adminUserId uint, targetUserId uint, requ
 		log.Printf("사용자 업데이트 중 오류 발생: %v", err)
 		return common.NewError(http.StatusInternalServerError, "사용자 업데이트 중 오류 발생", err)
 	}
+	if request.CompanyID == -1 {
+		u.syncAnnouncementChatRooms(*targetUser.ID, nil)
+	} else if request.CompanyID > 0 {
+		companyID := uint(request.CompanyID)
+		u.syncAnnouncementChatRooms(*targetUser.ID, &companyID)
+	}
 
 	if targetUser.UserProfile != nil && targetUser.UserProfile.CompanyID != nil {
 		for _, deptId := range request.DepartmentIDs {
@@ -375,18 +367,6 @@ func (u *adminUsecase) AdminUpdateUser(adminUserId uint, targetUserId uint, requ
 
 // TODO 회사 삭제 - ADMIN
 func (c *adminUsecase) AdminDeleteCompany(requestUserID uint, companyID uint) error {
-	//TODO 관리자 계정인지 확인
-	admin, err := c.userRepository.GetUserByID(requestUserID)
-	if err != nil {
-		log.Printf("관리자 계정 조회 중 오류 발생: %v", err)
-		return common.NewError(http.StatusInternalServerError, "관리자 계정 조회 중 오류 발생", err)
-	}
-
-	if admin.Role > _userEntity.RoleSubAdmin {
-		log.Printf("권한이 없는 사용자가 회사를 삭제하려 했습니다: 요청자 ID %d", requestUserID)
-		return common.NewError(http.StatusForbidden, "관리자 계정이 아닙니다", err)
-	}
-
 	company, err := c.companyRepository.GetCompanyByID(companyID)
 	if err != nil {
 		log.Printf("존재하지 않는 회사는 삭제할 수 없습니다: %v", err)
@@ -440,23 +420,13 @@ func (u *adminUsecase) AdminAddUserToCompany(adminUserId uint, targetUserId uint
 		log.Printf("사용자 업데이트 중 오류 발생: %v", err)
 		return common.NewError(http.StatusInternalServerError, "사용자 업데이트 중 오류 발생", err)
 	}
+	u.syncAnnouncementChatRooms(targetUserId, &companyID)
 
 	return nil
 }
 
 // TODO 회사 업데이트 - ADMIN
 func (u *adminUsecase) AdminUpdateCompany(requestUserID uint, request *req.AdminUpdateCompanyRequest) error {
-	adminUser, err := u.userRepository.GetUserByID(requestUserID)
-	if err != nil {
-		log.Printf("관리자 계정 조회 중 오류 발생: %v", err)
-		return common.NewError(http.StatusInternalServerError, "관리자 계정 조회 중 오류 발생", err)
-	}
-
-	if adminUser.Role > _userEntity.RoleSubAdmin {
-		log.Printf("권한이 없는 사용자가 회사를 업데이트하려 했습니다: 요청자 ID %d", requestUserID)
-		return common.NewError(http.StatusForbidden, "권한이 없습니다", err)
-	}
-
 	//TODO request -> entity 변환
 	updateCompanyInfo := &_companyEntity.Company{
 		CpName:                    request.CpName,
@@ -473,7 +443,7 @@ func (u *adminUsecase) AdminUpdateCompany(requestUserID uint, request *req.Admin
 	}
 
 	//TODO 회사 정보 업데이트
-	err = u.companyRepository.UpdateCompany(request.CompanyID, updateCompanyInfo)
+	err := u.companyRepository.UpdateCompany(request.CompanyID, updateCompanyInfo)
 	if err != nil {
 		log.Printf("회사 업데이트 중 오류 발생: %v", err)
 		return common.NewError(http.StatusInternalServerError, "회사 업데이트 중 오류 발생", err)
@@ -484,17 +454,6 @@ func (u *adminUsecase) AdminUpdateCompany(requestUserID uint, request *req.Admin
 
 // TODO 사용자 검색 Query 파라미터로 해당 회사의 사용자 검색 구분자는 company 전체로보는게 default 부서는 department
 func (u *adminUsecase) AdminSearchUser(adminUserId uint, searchTerm string) ([]res.AdminGetUserByIdResponse, error) {
-	adminUser, err := u.userRepository.GetUserByID(adminUserId)
-	if err != nil {
-		log.Printf("관리자 계정 조회 중 오류 발생: %v", err)
-		return nil, common.NewError(http.StatusInternalServerError, "관리자 계정 조회 중 오류 발생", err)
-	}
-
-	if adminUser.Role > _userEntity.RoleSubAdmin {
-		log.Printf("권한이 없는 사용자가 사용자를 검색하려 했습니다: 요청자 ID %d", adminUserId)
-		return nil, common.NewError(http.StatusForbidden, "권한이 없습니다", err)
-	}
-
 	users, err := u.userRepository.AdminSearchUser(searchTerm)
 	if err != nil {
 		log.Printf("사용자 검색 중 오류 발생: %v", err)
@@ -590,23 +549,12 @@ func (u *adminUsecase) AdminUpdateUserRole(adminUserId uint, targetUserId uint,
 
 // TODO 관리자 일반 사용자 회사에서 퇴출
 func (u *adminUsecase) AdminRemoveUserFromCompany(adminUserId uint, targetUserId uint) error {
-	adminUser, err := u.userRepository.GetUserByID(adminUserId)
-	if err != nil {
-		log.Printf("해당 관리자는 존재하지 않습니다: %v", err)
-		return common.NewError(http.StatusInternalServerError, "해당 관리자는 존재하지 않습니다", err)
-	}
-
 	targetUser, err := u.userRepository.GetUserByID(targetUserId)
 	if err != nil {
 		log.Printf("해당 사용자는 존재하지 않습니다: %v", err)
 		return common.NewError(http.StatusBadRequest, "해당 사용자는 존재하지 않습니다", err)
 	}
 
-	if adminUser.Role > _userEntity.RoleSubAdmin {
-		log.Printf("운영자 권한이 없습니다: 요청자 ID %d, 대상자 ID %d", adminUserId, targetUserId)
-		return common.NewError(http.StatusBadRequest, "운영자 권한이 없습니다", err)
-	}
-
 	if targetUser.UserProfile.CompanyID == nil {
 		log.Printf("회사에 소속되어 있지 않은 사람은 퇴출할 수 없습니다: 요청자 ID %d, 대상자 ID %d", adminUserId, targetUserId)
 		return common.NewError(http.StatusBadRequest, "회사에 소속되어 있지 않은 사람은 퇴출할 수 없습니다", err)
@@ -627,6 +575,7 @@ func (u *adminUsecase) AdminRemoveUserFromCompany(adminUserId uint, targetUserId
 		log.Printf("사용자 회사 퇴출 중 오류 발생: %v", err)
 		return common.NewError(http.StatusInternalServerError, "사용자 회사 퇴출 중 오류 발생", err)
 	}
+	u.syncAnnouncementChatRooms(targetUserId, nil)
 
 	//TODO 부서도 퇴출 - 중간테이블에서 해당 유저에 해당하는 내용 지워야함 부서정보는 남아있어야함
 	err = u.departmentRepository.DeleteUserDepartment(targetUserId)
@@ -640,17 +589,7 @@ func (u *adminUsecase) AdminRemoveUserFromCompany(adminUserId uint, targetUserId
 
 // TODO 관리자 부서 생성
 func (u *adminUsecase) AdminCreateDepartment(adminUserId uint, request *req.AdminCreateDepartmentRequest) error {
-	adminUser, err := u.userRepository.GetUserByID(adminUserId)
-	if err != nil {
-		log.Printf("관리자 계정 조회 중 오류 발생: %v", err)
-		return common.NewError(http.StatusInternalServerError, "관리자 계정 조회 중 오류 발생", err)
-	}
-	if adminUser.Role > _userEntity.RoleSubAdmin {
-		log.Printf("권한이 없는 사용자가 부서를 생성하려 했습니다: 요청자 ID %d", adminUserId)
-		return common.NewError(http.StatusForbidden, "권한이 없습니다", err)
-	}
-
-	_, err = u.companyRepository.GetCompanyByID(request.CompanyID)
+	_, err := u.companyRepository.GetCompanyByID(request.CompanyID)
 	if err != nil {
 		log.Printf("존재하지 않는 회사입니다: %v", err)
 		return common.NewError(http.StatusBadRequest, "존재하지 않는 회사입니다", err)
@@ -672,18 +611,7 @@ func (u *adminUsecase) AdminCreateDepartment(adminUserId uint, request *req.Admi
 
 // TODO 관리자 부서 리스트 조회
 func (u *adminUsecase) AdminGetAllDepartments(adminUserId uint, companyId uint) ([]res.AdminGetDepartmentResponse, error) {
-	adminUser, err := u.userRepository.GetUserByID(adminUserId)
-	if err != nil {
-		log.Printf("관리자 계정 조회 중 오류 발생: %v", err)
-		return nil, common.NewError(http.StatusInternalServerError, "관리자 계정 조회 중 오류 발생", err)
-	}
-
-	if adminUser.Role > _userEntity.RoleSubAdmin {
-		log.Printf("관리자 권한이 없습니다: 요청자 ID %d", adminUserId)
-		return nil, common.NewError(http.StatusForbidden, "관리자 권한이 없습니다", err)
-	}
-
-	_, err = u.companyRepository.GetCompanyByID(companyId)
+	_, err := u.companyRepository.GetCompanyByID(companyId)
 	if err != nil {
 		log.Printf("존재하지 않는 회사입니다: %v", err)
 		return nil, common.NewError(http.StatusBadRequest, "존재하지 않는 회사입니다", err)
@@ -712,18 +640,7 @@ func (u *adminUsecase) AdminGetAllDepartments(adminUserId uint, companyId uint)
 
 // TODO 관리자 부서정보 업데이트 - 부서 리더 포함 role 4로 지정
 func (u *adminUsecase) AdminUpdateDepartment(adminUserId uint, companyID uint, departmentID uint, request *req.AdminUpdateDepartmentRequest) error {
-	adminUser, err := u.userRepository.GetUserByID(adminUserId)
-	if err != nil {
-		log.Printf("관리자 계정 조회 중 오류 발생: %v", err)
-		return common.NewError(http.StatusInternalServerError, "관리자 계정 조회 중 오류 발생", err)
-	}
-
-	if adminUser.Role > _userEntity.RoleSubAdmin {
-		log.Printf("권한이 없는 사용자가 부서를 업데이트하려 했습니다: 요청자 ID %d", adminUserId)
-		return common.NewError(http.StatusForbidden, "권한이 없습니다", err)
-	}
-
-	_, err = u.departmentRepository.GetDepartmentByID(companyID, departmentID)
+	_, err := u.departmentRepository.GetDepartmentByID(companyID, departmentID)
 	if err != nil {
 		log.Printf("해당 부서는 존재하지 않습니다: %v", err)
 		return common.NewError(http.StatusBadRequest, "해당 부서는 존재하지 않습니다", err)
@@ -755,18 +672,7 @@ func (u *adminUsecase) AdminUpdateDepartment(adminUserId uint, companyID uint, d
 
 // TODO 관리자 부서 삭제
 func (u *adminUsecase) AdminDeleteDepartment(adminUserId uint, companyID uint, departmentID uint) error {
-	adminUser, err := u.userRepository.GetUserByID(adminUserId)
-	if err != nil {
-		log.Printf("관리자 계정 조회 중 오류 발생: %v", err)
-		return common.NewError(http.StatusInternalServerError, "관리자 계정 조회 중 오류 발생", err)
-	}
-
-	if adminUser.Role > _userEntity.RoleSubAdmin {
-		log.Printf("권한이 없는 사용자가 부서를 삭제하려 했습니다: 요청자 ID %d", adminUserId)
-		return common.NewError(http.StatusForbidden, "권한이 없습니다", err)
-	}
-
-	_, err = u.companyRepository.GetCompanyByID(companyID)
+	_, err := u.companyRepository.GetCompanyByID(companyID)
 	if err != nil {
 		log.Printf("존재하지 않는 회사입니다: %v", err)
 		return common.NewError(http.StatusBadRequest, "존재하지 않는 회사입니다", err)
@@ -789,19 +695,7 @@ func (u *adminUsecase) AdminDeleteDepartment(adminUserId uint, companyID uint, d
 
 // TODO 사용자 리포트 조회
 func (u *adminUsecase) AdminGetReportsByUser(adminUserId uint, targetUserId uint, queryParams *req.GetReportsQueryParams) (*res.GetReportsResponse, error) {
-
-	adminUser, err := u.userRepository.GetUserByID(adminUserId)
-	if err != nil {
-		log.Printf("관리자 계정 조회 중 오류 발생: %v", err)
-		return nil, common.NewError(http.StatusInternalServerError, "관리자 계정 조회 중 오류 발생", err)
-	}
-
-	if adminUser.Role > _userEntity.RoleSubAdmin {
-		log.Printf("권한이 없는 사용자가 리포트를 조회하려 했습니다: 요청자 ID %d", adminUserId)
-		return nil, common.NewError(http.StatusForbidden, "권한이 없습니다", err)
-	}
-
-	_, err = u.userRepository.GetUserByID(targetUserId)
+	_, err := u.userRepository.GetUserByID(targetUserId)
 	if err != nil {
 		log.Printf("해당 사용자는 존재하지 않습니다: %v", err)
 		return nil, common.NewError(http.StatusBadRequest, "해당 사용자는 존재하지 않습니다", err)
@@ -852,18 +746,7 @@ func (u *adminUsecase) AdminGetReportsByUser(adminUserId uint, targetUserId uint
 
 // TODO 사용자 상태 수정
 func (u *adminUsecase) AdminUpdateUserStatus(adminUserId uint, targetUserId uint, status string) error {
-	adminUser, err := u.userRepository.GetUserByID(adminUserId)
-	if err != nil {
-		log.Printf("관리자 계정 조회 중 오류 발생: %v", err)
-		return common.NewError(http.StatusInternalServerError, "관리자 계정 조회 중 오류 발생", err)
-	}
-
-	if adminUser.Role > _userEntity.RoleSubAdmin {
-		log.Printf("권한이 없는 사용자가 사용자 상태를 수정하려 했습니다: 요청자 ID %d", adminUserId)
-		return common.NewError(http.StatusForbidden, "권한이 없습니다", err)
-	}
-
-	_, err = u.userRepository.GetUserByID(targetUserId)
+	targetUser, err := u.userRepository.GetUserByID(targetUserId)
 	if err != nil {
 		log.Printf("해당 사용자는 존재하지 않습니다: %v", err)
 		return common.NewError(http.StatusBadRequest, "해당 사용자는 존재하지 않습니다", err)
@@ -878,5 +761,43 @@ func (u *adminUsecase) AdminUpdateUserStatus(adminUserId uint, targetUserId uint
 		return common.NewError(http.StatusInternalServerError, "사용자 상태 수정 중 오류 발생", err)
 	}
 
+	//TODO 정지 처리 시 모든 세션 폐기 (강제 로그아웃)
+	if status == _userEntity.UserStatusSuspended {
+		if err := u.authRepository.DeleteAllSessions(targetUserId); err != nil {
+			log.Printf("정지 사용자 세션 폐기 중 오류 발생: %v", err)
+			return common.NewError(http.StatusInternalServerError, "정지 사용자 세션 폐기 중 오류 발생", err)
+		}
+	}
+
+	//TODO 관리자가 상태를 바꾸면 로그인 실패 잠금 기록 삭제
+	// (관리자가 잠근 계정이 로그인 실패 잠금처럼 시간이 지나 자동 해제되지 않도록)
+	if err := u.authRepository.DeleteLoginLock(targetUserId); err != nil {
+		log.Printf("계정 잠금 기록 삭제 중 오류 발생: %v", err)
+		return common.NewError(http.StatusInternalServerError, "계정 잠금 기록 삭제 중 오류 발생", err)
+	}
+
+	//TODO 잠긴 계정을 활성화하면 로그인 실패 기록 초기화
+	if status == _userEntity.UserStatusActive && targetUser.Status != nil && *targetUser.Status == _userEntity.UserStatusLocked {
+		if err := u.authRepository.ClearLoginFailures(_authEntity.LoginLimitEmail, strings.ToLower(util.GetValueOrDefault(targetUser.Email, ""))); err != nil {
+			log.Printf("로그인 실패 기록 초기화 중 오류 발생: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// TODO 사용자 강제 로그아웃 - 모든 세션 폐기
+func (u *adminUsecase) AdminForceLogoutUser(adminUserId uint, targetUserId uint) error {
+	_, err := u.userRepository.GetUserByID(targetUserId)
+	if err != nil {
+		log.Printf("해당 사용자는 존재하지 않습니다: %v", err)
+		return common.NewError(http.StatusBadRequest, "해당 사용자는 존재하지 않습니다", err)
+	}
+
+	if err := u.authRepository.DeleteAllSessions(targetUserId); err != nil {
+		log.Printf("사용자 세션 폐기 중 오류 발생: %v", err)
+		return common.NewError(http.StatusInternalServerError, "사용자 세션 폐기 중 오류 발생", err)
+	}
+
 	return nil
 }
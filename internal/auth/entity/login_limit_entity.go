@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+// LoginLimitScope 로그인 실패 집계 단위
+type LoginLimitScope string
+
+const (
+	LoginLimitEmail LoginLimitScope = "email"
+	LoginLimitIP    LoginLimitScope = "ip"
+)
+
+// LoginFailures 슬라이딩 윈도우 안의 로그인 실패 현황
+type LoginFailures struct {
+	Count         int
+	LastFailureAt time.Time
+}
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// MfaChallenge 비밀번호 확인 후 2단계 인증을 기다리는 로그인 시도
+type MfaChallenge struct {
+	ChallengeId        string    `json:"challenge_id"`
+	UserId             uint      `json:"user_id"`
+	Email              string    `json:"email"`
+	EnrollmentRequired bool      `json:"enrollment_required"` // 필수 대상인데 아직 등록하지 않은 경우
+	Attempts           int       `json:"attempts"`
+	ExpiresAt          time.Time `json:"expires_at"`
+}
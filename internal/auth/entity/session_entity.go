@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+// Session 로그인 단위 세션 (기기별) - 하나의 세션이 하나의 리프레시 토큰 패밀리
+type Session struct {
+	SessionId      string    `json:"session_id"`
+	RefreshTokenId string    `json:"-"` // 현재 유효한 리프레시 토큰 jti
+	UserId         uint      `json:"user_id"`
+	IP             string    `json:"ip,omitempty"`
+	UserAgent      string    `json:"user_agent,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
@@ -7,3 +7,21 @@ type Token struct {
 	RefreshToken string    `json:"refreshToken"`
 	ExpiresAt    time.Time `json:"expiresAt"`
 }
+
+// RefreshTokenRotation 리프레시 토큰 회전 결과
+type RefreshTokenRotation int
+
+const (
+	RefreshTokenRotated         RefreshTokenRotation = iota // 정상 회전
+	RefreshTokenReused                                      // 이미 회전된 토큰 재사용 -> 탈취 의심
+	RefreshTokenSessionNotFound                             // 만료 또는 폐기된 세션
+	RefreshTokenGrace                                       // 방금 회전된 토큰을 유예 시간 안에 다시 사용 -> 같은 후속 토큰 반환
+)
+
+// EmailTokenPurpose 이메일 링크 토큰 용도
+type EmailTokenPurpose string
+
+const (
+	EmailTokenVerifyEmail   EmailTokenPurpose = "verify_email"
+	EmailTokenResetPassword EmailTokenPurpose = "reset_password"
+)
@@ -1,7 +1,38 @@
 package repository
 
+import (
+	"link/internal/auth/entity"
+	"time"
+)
+
 type AuthRepository interface {
-	StoreRefreshToken(mergeKey, refreshToken string) error
-	DeleteRefreshToken(mergeKey string) error
-	GetRefreshToken(mergeKey string) (string, error)
+	//TODO 세션 레지스트리 (세션 = 기기별 리프레시 토큰 패밀리)
+	CreateSession(session *entity.Session) error
+	GetSessions(userId uint) ([]*entity.Session, error)
+	IsSessionActive(userId uint, sessionId string) (bool, error)
+	DeleteSession(userId uint, sessionId string) error
+	DeleteAllSessions(userId uint) error
+	RotateSessionRefreshToken(userId uint, sessionId string, currentTokenId string, newTokenId string, newRefreshToken string, expiresAt time.Time, grace time.Duration) (entity.RefreshTokenRotation, string, error)
+
+	//TODO 이메일 인증/비밀번호 재설정 토큰 (사용자+용도별로 마지막 발급 토큰 1개만 유효)
+	StoreEmailToken(purpose entity.EmailTokenPurpose, userId uint, tokenId string, expiresAt time.Time) error
+	ConsumeEmailToken(purpose entity.EmailTokenPurpose, userId uint, tokenId string) (bool, error)
+
+	//TODO 2단계 인증
+	CreateMfaChallenge(challenge *entity.MfaChallenge) error
+	GetMfaChallenge(challengeId string) (*entity.MfaChallenge, error)
+	IncrMfaChallengeAttempts(challengeId string) (int, error)
+	DeleteMfaChallenge(challengeId string) error
+	StorePendingTotpSecret(userId uint, secret string, expiresAt time.Time) error
+	GetPendingTotpSecret(userId uint) (string, error)
+	DeletePendingTotpSecret(userId uint) error
+	MarkTotpStepUsed(userId uint, step int64) (bool, error)
+
+	//TODO 로그인 실패 제한 (슬라이딩 윈도우) / 계정 잠금
+	RecordLoginFailure(scope entity.LoginLimitScope, key string, window time.Duration) (*entity.LoginFailures, error)
+	GetLoginFailures(scope entity.LoginLimitScope, key string, window time.Duration) (*entity.LoginFailures, error)
+	ClearLoginFailures(scope entity.LoginLimitScope, key string) error
+	SetLoginLock(userId uint, until time.Time) error
+	GetLoginLock(userId uint) (*time.Time, error)
+	DeleteLoginLock(userId uint) error
 }
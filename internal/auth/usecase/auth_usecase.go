@@ -5,24 +5,81 @@ import (
 	"fmt"
 	"link/internal/auth/entity"
 	_authRepo "link/internal/auth/repository"
+	_userEntity "link/internal/user/entity"
 	_userRepo "link/internal/user/repository"
 
 	"link/pkg/common"
 	"link/pkg/dto/req"
 	"link/pkg/dto/res"
+	_mailer "link/pkg/mailer"
 	_nats "link/pkg/nats"
 	_utils "link/pkg/util"
 	"log"
 	"net/http"
-	"strconv"
+	"os"
+	"strings"
 	"time"
+
+	"github.com/google/uuid"
 )
 
+// 세션 유효 기간 = 리프레시 토큰 유효 기간
+const sessionExp = time.Hour * 24 * 5
+
+// 동시에 갱신한 탭/재시도 요청이 재사용으로 처리되지 않도록 방금 회전된 토큰을 허용하는 시간
+const refreshTokenReuseGrace = 10 * time.Second
+
+// 2단계 인증 대기/등록 유효 기간
+const mfaChallengeExp = time.Minute * 5
+const mfaChallengeMaxAttempts = 5
+const pendingTotpExp = time.Minute * 10
+const recoveryCodeCount = 10
+
+// 로그인 실패 제한
+const loginFailureWindow = time.Minute * 15 // 실패 집계 윈도우
+const loginDelayThreshold = 3               // 이메일별 이 횟수부터 대기 시간 부여 (1s, 2s, 4s ... 최대 loginMaxDelay)
+const loginMaxDelay = time.Minute
+const loginInlineDelay = time.Second * 3 // 남은 대기 시간이 이 이하면 거부하지 않고 기다린 뒤 처리
+const loginLockThreshold = 10            // 이메일별 이 횟수에 도달하면 계정 잠금
+const loginLockDuration = time.Minute * 30
+const loginIPDelayThreshold = 10 // IP별 이 횟수부터 대기 시간 부여
+const loginIPThreshold = 50      // IP별 윈도우 내 최대 실패 횟수
+
+// 이메일 링크 유효 기간
+const verifyEmailTokenExp = time.Hour * 24
+const resetPasswordTokenExp = time.Minute * 30
+
 // AuthUsecase 인터페이스 정의
 type AuthUsecase interface {
-	SignIn(request *req.LoginRequest) (*res.LoginUserResponse, *entity.Token, error) // 로그인 처리
-	SignOut(userId uint, email string) error                                         // 로그아웃 처리
-	GetRefreshToken(userId uint, email string) (string, error)
+	SignIn(request *req.LoginRequest, ip string, userAgent string) (*res.LoginUserResponse, *entity.Token, error) // 로그인 처리
+	SignOut(userId uint, sessionId string) error                                                                  // 로그아웃 처리
+	RotateRefreshToken(refreshToken string) (*entity.Token, error)                                                // 리프레시 토큰 회전 및 accessToken 재발급
+	SignInExternalUser(email string, ip string, userAgent string) (*res.LoginUserResponse, *entity.Token, error)  // SSO 로그인 (IdP 인증 완료 후)
+
+	//세션 관련
+	ValidateAccessToken(token string) (*_utils.Claims, error)
+	CheckSession(claims *_utils.Claims) error
+	GetRequestUser(userId uint) (*_userEntity.User, error)
+	GetSessions(userId uint, currentSessionId string) ([]res.SessionResponse, error)
+	RevokeSession(userId uint, sessionId string) error
+	RevokeAllSessions(userId uint) error
+	GetJWKS() *res.JWKSResponse
+
+	//이메일 인증 / 비밀번호 재설정
+	SendVerificationEmail(email string) error
+	VerifyEmail(token string) error
+	RequestPasswordReset(email string) error
+	ResetPassword(token string, newPassword string) error
+
+	//2단계 인증 (로그인 중 - mfa_token 사용)
+	VerifySignInMfa(request *req.MfaVerifyRequest, ip string, userAgent string) (*res.LoginUserResponse, *entity.Token, error)
+	StartSignInTotpEnrollment(mfaToken string) (*res.TotpEnrollmentResponse, error)
+	ConfirmSignInTotpEnrollment(request *req.MfaEnrollConfirmRequest, ip string, userAgent string) (*res.LoginUserResponse, *entity.Token, error)
+	//2단계 인증 (로그인 후 - 선택 등록/해제)
+	StartTotpEnrollment(userId uint) (*res.TotpEnrollmentResponse, error)
+	ConfirmTotpEnrollment(userId uint, code string) (*res.RecoveryCodesResponse, error)
+	DisableTotp(userId uint, code string) error
+	RegenerateRecoveryCodes(userId uint, code string) (*res.RecoveryCodesResponse, error)
 }
 
 // authUsecase 구조체 정의
@@ -30,48 +87,131 @@ type authUsecase struct {
 	authRepo      _authRepo.AuthRepository // Redis와 상호작용하는 저장소
 	userRepo      _userRepo.UserRepository // 사용자 정보 저장소
 	natsPublisher *_nats.NatsPublisher
+	mailer        _mailer.Mailer
 }
 
 // NewAuthUsecase 생성자 함수
 // userRepo 주입
-func NewAuthUsecase(authRepo _authRepo.AuthRepository, userRepo _userRepo.UserRepository, publisher *_nats.NatsPublisher) AuthUsecase {
-	return &authUsecase{authRepo: authRepo, userRepo: userRepo, natsPublisher: publisher} //TODO 사용자 정보 저장소 주입
+func NewAuthUsecase(authRepo _authRepo.AuthRepository, userRepo _userRepo.UserRepository, publisher *_nats.NatsPublisher, mailer _mailer.Mailer) AuthUsecase {
+	return &authUsecase{authRepo: authRepo, userRepo: userRepo, natsPublisher: publisher, mailer: mailer} //TODO 사용자 정보 저장소 주입
 }
 
-func (u *authUsecase) SignIn(request *req.LoginRequest) (*res.LoginUserResponse, *entity.Token, error) {
+func (u *authUsecase) SignIn(request *req.LoginRequest, ip string, userAgent string) (*res.LoginUserResponse, *entity.Token, error) {
+	email := strings.ToLower(strings.TrimSpace(request.Email))
 
-	user, err := u.userRepo.GetUserByEmail(request.Email)
+	//TODO 무차별 대입 방지 - IP/이메일별 실패 횟수에 따라 대기 시간 부여
+	if err := u.checkLoginThrottle(email, ip); err != nil {
+		return nil, nil, err
+	}
+
+	user, err := u.userRepo.GetUserByEmail(email)
 	if err != nil {
 		log.Printf("사용자 조회 오류: %v", err)
+		u.recordLoginFailure(nil, email, ip)
 		return nil, nil, common.NewError(http.StatusNotFound, "이메일 또는 비밀번호가 존재하지 않습니다", err)
 	}
 
+	//TODO 잠긴 계정은 비밀번호 확인 전에 거부 (잠금 시간이 지났으면 자동 해제)
+	if user.Status != nil && *user.Status == _userEntity.UserStatusLocked {
+		if err := u.checkLoginLock(user); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	if !_utils.CheckPasswordHash(request.Password, *user.Password) {
 
-		log.Printf("비밀번호 불일치: %s", request.Email)
+		log.Printf("비밀번호 불일치: %s", email)
+		if err := u.recordLoginFailure(user, email, ip); err != nil {
+			return nil, nil, err
+		}
 		return nil, nil, common.NewError(http.StatusNotFound, "이메일 또는 비밀번호가 일치하지 않습니다", err)
 	}
 
-	accessToken, err := _utils.GenerateAccessToken(*user.Name, *user.Email, *user.ID)
+	if err := u.authRepo.ClearLoginFailures(entity.LoginLimitEmail, email); err != nil {
+		log.Printf("로그인 실패 기록 초기화 오류: %v", err)
+	}
+
+	return u.signInVerifiedUser(user, ip, userAgent)
+}
+
+// TODO 외부 IdP(SSO)에서 본인 확인이 끝난 사용자 로그인 - 비밀번호 확인만 생략
+func (u *authUsecase) SignInExternalUser(email string, ip string, userAgent string) (*res.LoginUserResponse, *entity.Token, error) {
+	user, err := u.userRepo.GetUserByEmail(email)
 	if err != nil {
+		log.Printf("사용자 조회 오류: %v", err)
+		return nil, nil, common.NewError(http.StatusNotFound, "사용자를 찾을 수 없습니다", err)
+	}
 
-		log.Printf("액세스 토큰 생성 오류: %v", err)
-		return nil, nil, common.NewError(http.StatusInternalServerError, "액세스 토큰 생성에 실패했습니다", err)
+	if user.Status != nil && *user.Status == _userEntity.UserStatusLocked {
+		if err := u.checkLoginLock(user); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	//IdP가 이메일 소유를 확인했으므로 인증 대기 상태 해제
+	if user.Status != nil && *user.Status == _userEntity.UserStatusPending {
+		if err := u.userRepo.UpdateUser(*user.ID, map[string]interface{}{"status": _userEntity.UserStatusActive}, nil); err != nil {
+			log.Printf("인증 대기 해제 오류: %v", err)
+			return nil, nil, common.NewError(http.StatusInternalServerError, "사용자 상태 변경에 실패했습니다", err)
+		}
+		active := _userEntity.UserStatusActive
+		user.Status = &active
 	}
 
-	refreshToken, err := _utils.GenerateRefreshToken(*user.Name, *user.Email, *user.ID)
+	return u.signInVerifiedUser(user, ip, userAgent)
+}
+
+// 본인 확인(비밀번호/SSO) 이후 공통 처리 - 계정 상태 확인, 2단계 인증, 세션 생성
+func (u *authUsecase) signInVerifiedUser(user *_userEntity.User, ip string, userAgent string) (*res.LoginUserResponse, *entity.Token, error) {
+	if user.Status != nil && *user.Status == _userEntity.UserStatusSuspended {
+		log.Printf("정지된 사용자 로그인 시도: %s", *user.Email)
+		return nil, nil, common.NewError(http.StatusForbidden, "이용이 정지된 계정입니다", nil)
+	}
+
+	if user.Status != nil && *user.Status == _userEntity.UserStatusPending {
+		log.Printf("이메일 미인증 사용자 로그인 시도: %s", *user.Email)
+		return nil, nil, common.NewError(http.StatusForbidden, "이메일 인증이 필요합니다", nil)
+	}
+
+	//TODO 관리자 권한(회사 관리자 이상)은 2단계 인증 필수, 그 외는 등록한 경우에만
+	totp, err := u.userRepo.GetUserTotp(*user.ID)
 	if err != nil {
-		log.Printf("리프레시 토큰 생성 오류: %v", err)
-		return nil, nil, common.NewError(http.StatusInternalServerError, "리프레시 토큰 생성에 실패했습니다", err)
+		log.Printf("2단계 인증 정보 조회 오류: %v", err)
+		return nil, nil, common.NewError(http.StatusInternalServerError, "2단계 인증 정보 조회에 실패했습니다", err)
+	}
+	if totp.Enabled || isMfaMandatory(user.Role) {
+		challenge, err := u.createMfaChallenge(user, !totp.Enabled)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &res.LoginUserResponse{Mfa: challenge}, nil, nil
 	}
 
-	userIdStr := strconv.FormatUint(uint64(*user.ID), 10)
-	//TODO userId:email 키값으로 레디스 저장
-	mergeKey := fmt.Sprintf("%s:%s", userIdStr, *user.Email)
-	err = u.authRepo.StoreRefreshToken(mergeKey, refreshToken)
+	return u.completeSignIn(user, ip, userAgent)
+}
+
+// 세션 생성 + 토큰 발급 (비밀번호/2단계 인증이 모두 끝난 뒤 호출)
+func (u *authUsecase) completeSignIn(user *_userEntity.User, ip string, userAgent string) (*res.LoginUserResponse, *entity.Token, error) {
+	//TODO 로그인 단위 세션(리프레시 토큰 패밀리) 생성 - 기기마다 별도 세션
+	sessionId := uuid.NewString()
+	token, refreshTokenId, err := u.issueTokens(*user.Name, *user.Email, *user.ID, sessionId)
 	if err != nil {
-		log.Printf("리프레시 토큰 저장 오류: %v", err)
-		return nil, nil, common.NewError(http.StatusInternalServerError, "리프레시 토큰 저장에 실패했습니다", err)
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	session := &entity.Session{
+		SessionId:      sessionId,
+		RefreshTokenId: refreshTokenId,
+		UserId:         *user.ID,
+		IP:             ip,
+		UserAgent:      userAgent,
+		CreatedAt:      now,
+		ExpiresAt:      now.Add(sessionExp),
+	}
+	if err := u.authRepo.CreateSession(session); err != nil {
+		log.Printf("세션 생성 오류: %v", err)
+		return nil, nil, common.NewError(http.StatusInternalServerError, "세션 생성에 실패했습니다", err)
 	}
 
 	departmentIds := make([]uint, len(user.UserProfile.Departments))
@@ -99,49 +239,854 @@ func (u *authUsecase) SignIn(request *req.LoginRequest) (*res.LoginUserResponse,
 	u.natsPublisher.PublishEvent("link.event.user.signin", []byte(jsonData))
 
 	return &res.LoginUserResponse{
-			ID:            _utils.GetValueOrDefault(user.ID, 0),
-			Email:         _utils.GetValueOrDefault(user.Email, ""),
-			Name:          _utils.GetValueOrDefault(user.Name, ""),
-			Role:          uint(_utils.GetValueOrDefault(&user.Role, 4)),
-			CompanyID:     _utils.GetValueOrDefault(user.UserProfile.CompanyID, 0),
-			ProfileImage:  _utils.GetValueOrDefault(user.UserProfile.Image, ""),
-			DepartmentIds: departmentIds,
-		}, &entity.Token{
-			AccessToken:  accessToken,
-			RefreshToken: refreshToken,
-			ExpiresAt:    time.Now().Add(24 * time.Hour), // AccessToken의 만료 시간
-		}, nil
+		ID:            _utils.GetValueOrDefault(user.ID, 0),
+		Email:         _utils.GetValueOrDefault(user.Email, ""),
+		Name:          _utils.GetValueOrDefault(user.Name, ""),
+		Role:          uint(_utils.GetValueOrDefault(&user.Role, 4)),
+		CompanyID:     _utils.GetValueOrDefault(user.UserProfile.CompanyID, 0),
+		ProfileImage:  _utils.GetValueOrDefault(user.UserProfile.Image, ""),
+		DepartmentIds: departmentIds,
+	}, token, nil
 }
 
-func (u *authUsecase) SignOut(userId uint, email string) error {
-	userIdStr := strconv.FormatUint(uint64(userId), 10)
-	if userIdStr == "" {
-		return common.NewError(http.StatusBadRequest, "userId가 유효하지 않습니다", fmt.Errorf("userId가 유효하지 않습니다"))
+// accessToken + refreshToken 발급, refreshToken의 jti 함께 반환
+func (u *authUsecase) issueTokens(name string, email string, userId uint, sessionId string) (*entity.Token, string, error) {
+	accessToken, err := _utils.GenerateAccessToken(name, email, userId, sessionId)
+	if err != nil {
+		log.Printf("액세스 토큰 생성 오류: %v", err)
+		return nil, "", common.NewError(http.StatusInternalServerError, "액세스 토큰 생성에 실패했습니다", err)
 	}
 
-	mergeKey := fmt.Sprintf("%s:%s", userIdStr, email)
-
-	err := u.authRepo.DeleteRefreshToken(mergeKey)
+	refreshToken, refreshTokenId, err := _utils.GenerateRefreshToken(name, email, userId, sessionId)
 	if err != nil {
+		log.Printf("리프레시 토큰 생성 오류: %v", err)
+		return nil, "", common.NewError(http.StatusInternalServerError, "리프레시 토큰 생성에 실패했습니다", err)
+	}
+
+	return &entity.Token{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresAt:    time.Now().Add(24 * time.Hour), // AccessToken의 만료 시간
+	}, refreshTokenId, nil
+}
+
+func (u *authUsecase) SignOut(userId uint, sessionId string) error {
+	if sessionId == "" {
+		return common.NewError(http.StatusBadRequest, "세션 정보가 없습니다", fmt.Errorf("sessionId가 유효하지 않습니다"))
+	}
+
+	//TODO 현재 세션 폐기 -> 해당 세션의 accessToken, refreshToken 모두 즉시 거부됨
+	if err := u.authRepo.DeleteSession(userId, sessionId); err != nil {
 		log.Printf("로그아웃 처리 오류: %v", err)
 		return common.NewError(http.StatusInternalServerError, "로그아웃 처리에 실패했습니다", err)
 	}
 	return nil
 }
 
-// TODO 레디스에서 userId로 리프레시 토큰 가져오기
-func (u *authUsecase) GetRefreshToken(userId uint, email string) (string, error) {
-	userIdStr := strconv.FormatUint(uint64(userId), 10)
-	if userIdStr == "" {
-		return "", common.NewError(http.StatusBadRequest, "userId가 유효하지 않습니다", fmt.Errorf("userId가 유효하지 않습니다"))
+// TODO 리프레시 토큰 회전
+// 제시된 토큰이 세션의 현재 토큰이면 새 토큰 쌍을 발급하고,
+// 이미 회전된(과거) 토큰이면 탈취로 보고 세션(토큰 패밀리) 전체를 폐기 (방금 회전된 토큰은 유예 시간 동안 허용)
+func (u *authUsecase) RotateRefreshToken(refreshToken string) (*entity.Token, error) {
+	claims, err := _utils.ValidateRefreshToken(refreshToken)
+	if err != nil {
+		log.Printf("리프레시 토큰 검증 중 오류가 발생했습니다: %v", err)
+		return nil, common.NewError(http.StatusUnauthorized, "유효하지 않은 Refresh Token입니다. 다시 로그인 해주세요.", err)
+	}
+
+	if claims.SessionId == "" || claims.ID == "" {
+		return nil, common.NewError(http.StatusUnauthorized, "세션 정보가 없는 Refresh Token입니다. 다시 로그인 해주세요.", nil)
+	}
+
+	//정지/잠금된 사용자는 기존 세션으로도 토큰을 새로 받을 수 없음
+	user, err := u.userRepo.GetUserByID(claims.UserId)
+	if err != nil {
+		log.Printf("사용자 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusUnauthorized, "사용자를 찾을 수 없습니다. 다시 로그인 해주세요.", err)
+	}
+	if user.Status != nil && *user.Status != _userEntity.UserStatusActive {
+		if err := u.authRepo.DeleteSession(claims.UserId, claims.SessionId); err != nil {
+			log.Printf("비활성 사용자 세션 폐기 오류: %v", err)
+		}
+		return nil, common.NewError(http.StatusForbidden, "사용할 수 없는 계정입니다. 관리자에게 문의해주세요.", nil)
+	}
+
+	token, newRefreshTokenId, err := u.issueTokens(claims.Name, claims.Email, claims.UserId, claims.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	rotation, successorToken, err := u.authRepo.RotateSessionRefreshToken(claims.UserId, claims.SessionId, claims.ID, newRefreshTokenId, token.RefreshToken, time.Now().Add(sessionExp), refreshTokenReuseGrace)
+	if err != nil {
+		log.Printf("리프레시 토큰 회전 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "리프레시 토큰 회전에 실패했습니다", err)
+	}
+
+	switch rotation {
+	case entity.RefreshTokenReused:
+		//TODO 재사용 감지 -> 토큰 패밀리 전체 폐기
+		if err := u.authRepo.DeleteSession(claims.UserId, claims.SessionId); err != nil {
+			log.Printf("재사용 감지 세션 폐기 오류: %v", err)
+		}
+		u.publishRefreshTokenReused(claims)
+		return nil, common.NewError(http.StatusUnauthorized, "이미 사용된 Refresh Token입니다. 다시 로그인 해주세요.", nil)
+	case entity.RefreshTokenSessionNotFound:
+		return nil, common.NewError(http.StatusUnauthorized, "만료되었거나 폐기된 세션입니다. 다시 로그인 해주세요.", nil)
+	case entity.RefreshTokenGrace:
+		//동시 갱신 - 먼저 회전한 요청과 같은 리프레시 토큰을 돌려줌 (accessToken만 새로 발급)
+		token.RefreshToken = successorToken
+	}
+
+	return token, nil
+}
+
+func (u *authUsecase) publishRefreshTokenReused(claims *_utils.Claims) {
+	natsData := map[string]interface{}{
+		"topic": "link.event.auth.refresh.reused",
+		"payload": map[string]interface{}{
+			"user_id":    claims.UserId,
+			"email":      claims.Email,
+			"session_id": claims.SessionId,
+			"token_id":   claims.ID,
+			"timestamp":  time.Now(),
+		},
+	}
+	jsonData, err := json.Marshal(natsData)
+	if err != nil {
+		log.Printf("NATS 데이터 직렬화 오류: %v", err)
+		return
+	}
+	u.natsPublisher.PublishEvent("link.event.auth.refresh.reused", jsonData)
+}
+
+// TODO accessToken 검증 - 서명/만료 + 세션 폐기 여부
+func (u *authUsecase) ValidateAccessToken(token string) (*_utils.Claims, error) {
+	claims, err := _utils.ValidateAccessToken(token)
+	if err != nil {
+		return nil, common.NewError(http.StatusUnauthorized, "유효하지 않은 토큰입니다", err)
+	}
+
+	if err := u.CheckSession(claims); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// TODO 토큰의 세션이 아직 살아있는지 확인 (폐기된 세션의 토큰 거부)
+func (u *authUsecase) CheckSession(claims *_utils.Claims) error {
+	if claims.SessionId == "" {
+		return common.NewError(http.StatusUnauthorized, "세션 정보가 없는 토큰입니다. 다시 로그인 해주세요.", nil)
+	}
+
+	active, err := u.authRepo.IsSessionActive(claims.UserId, claims.SessionId)
+	if err != nil {
+		log.Printf("세션 조회 오류: %v", err)
+		return common.NewError(http.StatusInternalServerError, "세션 조회에 실패했습니다", err)
+	}
+	if !active {
+		return common.NewError(http.StatusUnauthorized, "폐기된 토큰입니다. 다시 로그인 해주세요.", nil)
+	}
+
+	return nil
+}
+
+// TODO 내 세션 목록 조회
+func (u *authUsecase) GetSessions(userId uint, currentSessionId string) ([]res.SessionResponse, error) {
+	sessions, err := u.authRepo.GetSessions(userId)
+	if err != nil {
+		log.Printf("세션 목록 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "세션 목록 조회에 실패했습니다", err)
+	}
+
+	response := make([]res.SessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		response = append(response, res.SessionResponse{
+			SessionId: session.SessionId,
+			IP:        session.IP,
+			UserAgent: session.UserAgent,
+			IsCurrent: session.SessionId == currentSessionId,
+			CreatedAt: _utils.ParseKst(session.CreatedAt).Format(time.DateTime),
+			ExpiresAt: _utils.ParseKst(session.ExpiresAt).Format(time.DateTime),
+		})
+	}
+
+	return response, nil
+}
+
+// TODO 세션 하나 폐기
+func (u *authUsecase) RevokeSession(userId uint, sessionId string) error {
+	active, err := u.authRepo.IsSessionActive(userId, sessionId)
+	if err != nil {
+		log.Printf("세션 조회 오류: %v", err)
+		return common.NewError(http.StatusInternalServerError, "세션 조회에 실패했습니다", err)
+	}
+	if !active {
+		return common.NewError(http.StatusNotFound, "세션이 존재하지 않습니다", nil)
+	}
+
+	if err := u.authRepo.DeleteSession(userId, sessionId); err != nil {
+		log.Printf("세션 삭제 오류: %v", err)
+		return common.NewError(http.StatusInternalServerError, "세션 폐기에 실패했습니다", err)
+	}
+	return nil
+}
+
+// TODO 모든 세션 폐기 (모든 기기 로그아웃)
+func (u *authUsecase) RevokeAllSessions(userId uint) error {
+	if err := u.authRepo.DeleteAllSessions(userId); err != nil {
+		log.Printf("세션 전체 삭제 오류: %v", err)
+		return common.NewError(http.StatusInternalServerError, "세션 폐기에 실패했습니다", err)
+	}
+	return nil
+}
+
+// TODO 토큰 검증용 공개키 목록 (다른 서비스가 공유 시크릿 없이 토큰 검증)
+func (u *authUsecase) GetJWKS() *res.JWKSResponse {
+	return &res.JWKSResponse{Keys: _utils.PublicJWKs()}
+}
+
+// TODO 권한 확인용 요청 사용자 조회 (role, 회사)
+func (u *authUsecase) GetRequestUser(userId uint) (*_userEntity.User, error) {
+	user, err := u.userRepo.GetUserByID(userId)
+	if err != nil {
+		log.Printf("사용자 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusUnauthorized, "사용자를 찾을 수 없습니다", err)
+	}
+
+	if user.Status != nil && *user.Status == _userEntity.UserStatusSuspended {
+		return nil, common.NewError(http.StatusForbidden, "이용이 정지된 계정입니다", nil)
+	}
+
+	return user, nil
+}
+
+// TODO 이메일 인증 메일 발송 (회원가입 직후, 재발송)
+// 인증 대기 상태가 아닌 계정이면 아무것도 하지 않음 (계정 존재 여부 노출 방지)
+func (u *authUsecase) SendVerificationEmail(email string) error {
+	user, err := u.userRepo.GetUserByEmail(email)
+	if err != nil {
+		log.Printf("인증 메일 대상 사용자 조회 실패: %v", err)
+		return nil
+	}
+
+	if user.Status == nil || *user.Status != _userEntity.UserStatusPending {
+		return nil
+	}
+
+	token, err := u.issueEmailToken(user, entity.EmailTokenVerifyEmail, verifyEmailTokenExp)
+	if err != nil {
+		return err
+	}
+
+	mail := &_mailer.Mail{
+		To:      *user.Email,
+		Subject: "[Link] 이메일 인증을 완료해주세요",
+		Body: fmt.Sprintf("%s님, Link 가입을 환영합니다.\n\n아래 링크에서 이메일 인증을 완료해주세요. (%d시간 동안 유효)\n%s\n",
+			*user.Name, int(verifyEmailTokenExp.Hours()), emailLink("/verify-email", token)),
+	}
+	if err := u.mailer.Send(mail); err != nil {
+		log.Printf("인증 메일 발송 오류: %v", err)
+		return common.NewError(http.StatusInternalServerError, "인증 메일 발송에 실패했습니다", err)
+	}
+
+	return nil
+}
+
+// TODO 이메일 인증 완료 -> 계정 활성화
+func (u *authUsecase) VerifyEmail(token string) error {
+	claims, err := u.consumeEmailToken(token, entity.EmailTokenVerifyEmail)
+	if err != nil {
+		return err
+	}
+
+	user, err := u.userRepo.GetUserByEmail(claims.Email)
+	if err != nil || *user.ID != claims.UserId {
+		log.Printf("이메일 인증 대상 사용자 조회 실패: %v", err)
+		return common.NewError(http.StatusBadRequest, "유효하지 않은 인증 링크입니다", err)
+	}
+
+	if user.Status == nil || *user.Status != _userEntity.UserStatusPending {
+		return nil
+	}
+
+	if err := u.userRepo.UpdateUser(claims.UserId, map[string]interface{}{"status": _userEntity.UserStatusActive}, nil); err != nil {
+		log.Printf("이메일 인증 처리 오류: %v", err)
+		return common.NewError(http.StatusInternalServerError, "이메일 인증 처리에 실패했습니다", err)
+	}
+
+	return nil
+}
+
+// TODO 비밀번호 재설정 메일 발송
+// 존재하지 않는 이메일이어도, 메일 발송에 실패해도 같은 성공 응답 (계정 존재 여부 노출 방지)
+func (u *authUsecase) RequestPasswordReset(email string) error {
+	user, err := u.userRepo.GetUserByEmail(email)
+	if err != nil {
+		log.Printf("비밀번호 재설정 대상 사용자 조회 실패: %v", err)
+		return nil
+	}
+
+	if user.Status != nil && *user.Status == _userEntity.UserStatusSuspended {
+		return nil
+	}
+
+	token, err := u.issueEmailToken(user, entity.EmailTokenResetPassword, resetPasswordTokenExp)
+	if err != nil {
+		log.Printf("비밀번호 재설정 토큰 발급 오류: %s: %v", *user.Email, err)
+		return nil
+	}
+
+	mail := &_mailer.Mail{
+		To:      *user.Email,
+		Subject: "[Link] 비밀번호 재설정 안내",
+		Body: fmt.Sprintf("%s님, 비밀번호 재설정이 요청되었습니다.\n\n아래 링크에서 새 비밀번호를 설정해주세요. (%d분 동안 유효)\n%s\n\n본인이 요청하지 않았다면 이 메일을 무시해주세요.\n",
+			*user.Name, int(resetPasswordTokenExp.Minutes()), emailLink("/reset-password", token)),
+	}
+	if err := u.mailer.Send(mail); err != nil {
+		log.Printf("비밀번호 재설정 메일 발송 오류: %s: %v", *user.Email, err)
+		return nil
+	}
+
+	return nil
+}
+
+// TODO 비밀번호 재설정 -> 모든 세션 폐기
+func (u *authUsecase) ResetPassword(token string, newPassword string) error {
+	claims, err := u.consumeEmailToken(token, entity.EmailTokenResetPassword)
+	if err != nil {
+		return err
+	}
+
+	user, err := u.userRepo.GetUserByEmail(claims.Email)
+	if err != nil || *user.ID != claims.UserId {
+		log.Printf("비밀번호 재설정 대상 사용자 조회 실패: %v", err)
+		return common.NewError(http.StatusBadRequest, "유효하지 않은 재설정 링크입니다", err)
+	}
+
+	hashedPassword, err := _utils.HashPassword(newPassword)
+	if err != nil {
+		log.Printf("비밀번호 해싱 오류: %v", err)
+		return common.NewError(http.StatusInternalServerError, "비밀번호 해쉬화에 실패했습니다", err)
+	}
+
+	updates := map[string]interface{}{"password": hashedPassword}
+	//메일을 받았다는 것 자체로 이메일 소유가 확인되므로 인증 대기 상태도 해제
+	if user.Status != nil && *user.Status == _userEntity.UserStatusPending {
+		updates["status"] = _userEntity.UserStatusActive
+	}
+
+	if err := u.userRepo.UpdateUser(claims.UserId, updates, nil); err != nil {
+		log.Printf("비밀번호 재설정 오류: %v", err)
+		return common.NewError(http.StatusInternalServerError, "비밀번호 재설정에 실패했습니다", err)
+	}
+
+	if err := u.authRepo.DeleteAllSessions(claims.UserId); err != nil {
+		log.Printf("비밀번호 재설정 후 세션 폐기 오류: %v", err)
+	}
+
+	return nil
+}
+
+// 이메일 토큰 발급 + redis 저장 (이전에 발급한 같은 용도의 토큰은 무효화)
+func (u *authUsecase) issueEmailToken(user *_userEntity.User, purpose entity.EmailTokenPurpose, expiration time.Duration) (string, error) {
+	token, tokenId, err := _utils.GenerateEmailToken(*user.ID, *user.Email, string(purpose), expiration)
+	if err != nil {
+		log.Printf("이메일 토큰 생성 오류: %v", err)
+		return "", common.NewError(http.StatusInternalServerError, "이메일 토큰 생성에 실패했습니다", err)
+	}
+
+	if err := u.authRepo.StoreEmailToken(purpose, *user.ID, tokenId, time.Now().Add(expiration)); err != nil {
+		return "", common.NewError(http.StatusInternalServerError, "이메일 토큰 저장에 실패했습니다", err)
+	}
+
+	return token, nil
+}
+
+// 이메일 토큰 검증 + 1회 사용 처리
+func (u *authUsecase) consumeEmailToken(token string, purpose entity.EmailTokenPurpose) (*_utils.EmailTokenClaims, error) {
+	claims, err := _utils.ValidateEmailToken(token, string(purpose))
+	if err != nil {
+		return nil, common.NewError(http.StatusBadRequest, "유효하지 않거나 만료된 링크입니다", err)
+	}
+
+	consumed, err := u.authRepo.ConsumeEmailToken(purpose, claims.UserId, claims.ID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "토큰 확인에 실패했습니다", err)
+	}
+	if !consumed {
+		return nil, common.NewError(http.StatusBadRequest, "이미 사용되었거나 만료된 링크입니다", nil)
+	}
+
+	return claims, nil
+}
+
+// 메일 본문에 들어갈 프론트 링크 (LINK_UI_URL이 여러 개면 첫 번째 사용)
+func emailLink(path string, token string) string {
+	baseUrl := strings.TrimSpace(strings.Split(os.Getenv("LINK_UI_URL"), ",")[0])
+	if baseUrl == "" {
+		baseUrl = "http://localhost:3000"
+	}
+	return fmt.Sprintf("%s%s?token=%s", strings.TrimRight(baseUrl, "/"), path, token)
+}
+
+// 회사 관리자 이상 권한은 2단계 인증 필수
+func isMfaMandatory(role _userEntity.UserRole) bool {
+	return role <= _userEntity.RoleCompanyManager
+}
+
+func (u *authUsecase) createMfaChallenge(user *_userEntity.User, enrollmentRequired bool) (*res.MfaChallengeResponse, error) {
+	challenge := &entity.MfaChallenge{
+		ChallengeId:        uuid.NewString(),
+		UserId:             *user.ID,
+		Email:              *user.Email,
+		EnrollmentRequired: enrollmentRequired,
+		ExpiresAt:          time.Now().Add(mfaChallengeExp),
+	}
+	if err := u.authRepo.CreateMfaChallenge(challenge); err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "2단계 인증 요청 생성에 실패했습니다", err)
+	}
+
+	return &res.MfaChallengeResponse{
+		MfaToken:           challenge.ChallengeId,
+		EnrollmentRequired: enrollmentRequired,
+		ExpiresAt:          _utils.ParseKst(challenge.ExpiresAt).Format(time.DateTime),
+	}, nil
+}
+
+// 2단계 인증 대기 로그인 조회 + 시도 횟수 제한
+func (u *authUsecase) getMfaChallenge(mfaToken string) (*entity.MfaChallenge, error) {
+	challenge, err := u.authRepo.GetMfaChallenge(mfaToken)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "2단계 인증 요청 조회에 실패했습니다", err)
+	}
+	if challenge == nil {
+		return nil, common.NewError(http.StatusUnauthorized, "만료된 2단계 인증 요청입니다. 다시 로그인 해주세요.", nil)
+	}
+
+	attempts, err := u.authRepo.IncrMfaChallengeAttempts(mfaToken)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "2단계 인증 요청 조회에 실패했습니다", err)
+	}
+	if attempts > mfaChallengeMaxAttempts {
+		u.authRepo.DeleteMfaChallenge(mfaToken)
+		return nil, common.NewError(http.StatusUnauthorized, "2단계 인증 시도 횟수를 초과했습니다. 다시 로그인 해주세요.", nil)
+	}
+
+	return challenge, nil
+}
+
+// 로그인 완료 대상 사용자 조회 (부서 정보 등 로그인 응답에 필요한 값은 이메일 조회에만 있음)
+func (u *authUsecase) getSignInUser(challenge *entity.MfaChallenge) (*_userEntity.User, error) {
+	user, err := u.userRepo.GetUserByEmail(challenge.Email)
+	if err != nil || *user.ID != challenge.UserId {
+		log.Printf("2단계 인증 사용자 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusUnauthorized, "사용자를 찾을 수 없습니다", err)
+	}
+	if user.Status != nil && *user.Status == _userEntity.UserStatusSuspended {
+		return nil, common.NewError(http.StatusForbidden, "이용이 정지된 계정입니다", nil)
+	}
+	return user, nil
+}
+
+// TODO 로그인 2단계 - OTP 코드 또는 복구 코드 확인 후 토큰 발급
+func (u *authUsecase) VerifySignInMfa(request *req.MfaVerifyRequest, ip string, userAgent string) (*res.LoginUserResponse, *entity.Token, error) {
+	challenge, err := u.getMfaChallenge(request.MfaToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	if challenge.EnrollmentRequired {
+		return nil, nil, common.NewError(http.StatusBadRequest, "2단계 인증 등록이 필요합니다", nil)
+	}
+
+	totp, err := u.userRepo.GetUserTotp(challenge.UserId)
+	if err != nil {
+		return nil, nil, common.NewError(http.StatusInternalServerError, "2단계 인증 정보 조회에 실패했습니다", err)
+	}
+
+	if err := u.verifySecondFactor(totp, request.Code, request.RecoveryCode); err != nil {
+		return nil, nil, err
+	}
+
+	user, err := u.getSignInUser(challenge)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u.authRepo.DeleteMfaChallenge(request.MfaToken)
+	return u.completeSignIn(user, ip, userAgent)
+}
+
+// TODO 로그인 중 필수 2단계 인증 등록 시작
+func (u *authUsecase) StartSignInTotpEnrollment(mfaToken string) (*res.TotpEnrollmentResponse, error) {
+	challenge, err := u.getMfaChallenge(mfaToken)
+	if err != nil {
+		return nil, err
+	}
+	if !challenge.EnrollmentRequired {
+		return nil, common.NewError(http.StatusBadRequest, "이미 2단계 인증이 등록되어 있습니다", nil)
+	}
+
+	return u.startTotpEnrollment(challenge.UserId, challenge.Email)
+}
+
+// TODO 로그인 중 필수 2단계 인증 등록 완료 -> 토큰 발급 + 복구 코드 1회 노출
+func (u *authUsecase) ConfirmSignInTotpEnrollment(request *req.MfaEnrollConfirmRequest, ip string, userAgent string) (*res.LoginUserResponse, *entity.Token, error) {
+	challenge, err := u.getMfaChallenge(request.MfaToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !challenge.EnrollmentRequired {
+		return nil, nil, common.NewError(http.StatusBadRequest, "이미 2단계 인증이 등록되어 있습니다", nil)
+	}
+
+	recoveryCodes, err := u.confirmTotpEnrollment(challenge.UserId, request.Code)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user, err := u.getSignInUser(challenge)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u.authRepo.DeleteMfaChallenge(request.MfaToken)
+	response, token, err := u.completeSignIn(user, ip, userAgent)
+	if err != nil {
+		return nil, nil, err
+	}
+	response.RecoveryCodes = recoveryCodes
+	return response, token, nil
+}
+
+// TODO 2단계 인증 등록 시작 (로그인 후)
+func (u *authUsecase) StartTotpEnrollment(userId uint) (*res.TotpEnrollmentResponse, error) {
+	user, err := u.userRepo.GetUserByID(userId)
+	if err != nil {
+		log.Printf("사용자 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "사용자를 찾을 수 없습니다", err)
+	}
+
+	totp, err := u.userRepo.GetUserTotp(userId)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "2단계 인증 정보 조회에 실패했습니다", err)
+	}
+	if totp.Enabled {
+		return nil, common.NewError(http.StatusBadRequest, "이미 2단계 인증이 등록되어 있습니다", nil)
+	}
+
+	return u.startTotpEnrollment(userId, *user.Email)
+}
+
+// TODO 2단계 인증 등록 완료 (로그인 후)
+func (u *authUsecase) ConfirmTotpEnrollment(userId uint, code string) (*res.RecoveryCodesResponse, error) {
+	recoveryCodes, err := u.confirmTotpEnrollment(userId, code)
+	if err != nil {
+		return nil, err
+	}
+	return &res.RecoveryCodesResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+// TODO 2단계 인증 해제 - 필수 대상 권한은 해제 불가
+func (u *authUsecase) DisableTotp(userId uint, code string) error {
+	user, err := u.userRepo.GetUserByID(userId)
+	if err != nil {
+		log.Printf("사용자 조회 오류: %v", err)
+		return common.NewError(http.StatusNotFound, "사용자를 찾을 수 없습니다", err)
+	}
+	if isMfaMandatory(user.Role) {
+		return common.NewError(http.StatusForbidden, "관리자 권한은 2단계 인증을 해제할 수 없습니다", nil)
+	}
+
+	totp, err := u.userRepo.GetUserTotp(userId)
+	if err != nil {
+		return common.NewError(http.StatusInternalServerError, "2단계 인증 정보 조회에 실패했습니다", err)
+	}
+	if !totp.Enabled {
+		return common.NewError(http.StatusBadRequest, "2단계 인증이 등록되어 있지 않습니다", nil)
+	}
+
+	if err := u.verifySecondFactor(totp, code, ""); err != nil {
+		return err
+	}
+
+	if err := u.userRepo.UpdateUserTotp(&_userEntity.UserTotp{UserId: userId}); err != nil {
+		log.Printf("2단계 인증 해제 오류: %v", err)
+		return common.NewError(http.StatusInternalServerError, "2단계 인증 해제에 실패했습니다", err)
+	}
+	return nil
+}
+
+// TODO 복구 코드 재발급 - 기존 복구 코드는 모두 무효화
+func (u *authUsecase) RegenerateRecoveryCodes(userId uint, code string) (*res.RecoveryCodesResponse, error) {
+	totp, err := u.userRepo.GetUserTotp(userId)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "2단계 인증 정보 조회에 실패했습니다", err)
+	}
+	if !totp.Enabled {
+		return nil, common.NewError(http.StatusBadRequest, "2단계 인증이 등록되어 있지 않습니다", nil)
+	}
+
+	if err := u.verifySecondFactor(totp, code, ""); err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, hashedCodes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	totp.RecoveryCodes = hashedCodes
+	if err := u.userRepo.UpdateUserTotp(totp); err != nil {
+		log.Printf("복구 코드 저장 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "복구 코드 재발급에 실패했습니다", err)
+	}
+
+	return &res.RecoveryCodesResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+// 시크릿 생성 후 임시 저장 - 코드 확인 전까지는 DB에 반영하지 않음
+func (u *authUsecase) startTotpEnrollment(userId uint, email string) (*res.TotpEnrollmentResponse, error) {
+	secret, err := _utils.GenerateTotpSecret()
+	if err != nil {
+		log.Printf("TOTP 시크릿 생성 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "2단계 인증 등록에 실패했습니다", err)
+	}
+
+	if err := u.authRepo.StorePendingTotpSecret(userId, secret, time.Now().Add(pendingTotpExp)); err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "2단계 인증 등록에 실패했습니다", err)
+	}
+
+	issuer := os.Getenv("TOTP_ISSUER")
+	if issuer == "" {
+		issuer = "Link"
+	}
+
+	return &res.TotpEnrollmentResponse{
+		Secret:          secret,
+		ProvisioningURI: _utils.TotpProvisioningURI(secret, issuer, email),
+	}, nil
+}
+
+// 임시 시크릿으로 코드 확인 후 등록 완료, 평문 복구 코드 반환
+func (u *authUsecase) confirmTotpEnrollment(userId uint, code string) ([]string, error) {
+	secret, err := u.authRepo.GetPendingTotpSecret(userId)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "2단계 인증 등록에 실패했습니다", err)
+	}
+	if secret == "" {
+		return nil, common.NewError(http.StatusBadRequest, "등록 요청이 만료되었습니다. 다시 시도해주세요.", nil)
+	}
+
+	step, ok := _utils.ValidateTotpCode(secret, code, time.Now())
+	if !ok {
+		return nil, common.NewError(http.StatusUnauthorized, "인증 코드가 일치하지 않습니다", nil)
+	}
+	u.authRepo.MarkTotpStepUsed(userId, step)
+
+	recoveryCodes, hashedCodes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	totp := &_userEntity.UserTotp{
+		UserId:        userId,
+		Enabled:       true,
+		Secret:        secret,
+		RecoveryCodes: hashedCodes,
+		EnabledAt:     &now,
+	}
+	if err := u.userRepo.UpdateUserTotp(totp); err != nil {
+		log.Printf("2단계 인증 저장 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "2단계 인증 등록에 실패했습니다", err)
+	}
+	u.authRepo.DeletePendingTotpSecret(userId)
+
+	return recoveryCodes, nil
+}
+
+// OTP 코드(같은 코드 재사용 불가) 또는 복구 코드(1회용) 확인
+func (u *authUsecase) verifySecondFactor(totp *_userEntity.UserTotp, code string, recoveryCode string) error {
+	if code != "" {
+		step, ok := _utils.ValidateTotpCode(totp.Secret, code, time.Now())
+		if !ok {
+			return common.NewError(http.StatusUnauthorized, "인증 코드가 일치하지 않습니다", nil)
+		}
+
+		firstUse, err := u.authRepo.MarkTotpStepUsed(totp.UserId, step)
+		if err != nil {
+			return common.NewError(http.StatusInternalServerError, "인증 코드 확인에 실패했습니다", err)
+		}
+		if !firstUse {
+			return common.NewError(http.StatusUnauthorized, "이미 사용된 인증 코드입니다", nil)
+		}
+		return nil
+	}
+
+	if recoveryCode != "" {
+		hashed := _utils.HashRecoveryCode(recoveryCode)
+		for i, stored := range totp.RecoveryCodes {
+			if stored != hashed {
+				continue
+			}
+
+			totp.RecoveryCodes = append(totp.RecoveryCodes[:i:i], totp.RecoveryCodes[i+1:]...)
+			if err := u.userRepo.UpdateUserTotp(totp); err != nil {
+				log.Printf("복구 코드 사용 처리 오류: %v", err)
+				return common.NewError(http.StatusInternalServerError, "복구 코드 확인에 실패했습니다", err)
+			}
+			return nil
+		}
+		return common.NewError(http.StatusUnauthorized, "복구 코드가 일치하지 않습니다", nil)
+	}
+
+	return common.NewError(http.StatusBadRequest, "인증 코드 또는 복구 코드를 입력해주세요", nil)
+}
+
+// 복구 코드 평문(사용자에게 1회 노출)과 해시(DB 저장) 생성
+func generateRecoveryCodes() ([]string, []string, error) {
+	recoveryCodes, err := _utils.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		log.Printf("복구 코드 생성 오류: %v", err)
+		return nil, nil, common.NewError(http.StatusInternalServerError, "복구 코드 생성에 실패했습니다", err)
+	}
+
+	hashedCodes := make([]string, len(recoveryCodes))
+	for i, code := range recoveryCodes {
+		hashedCodes[i] = _utils.HashRecoveryCode(code)
+	}
+	return recoveryCodes, hashedCodes, nil
+}
+
+// 실패 횟수에 따른 대기 시간 - threshold번째 실패부터 1초, 이후 실패할 때마다 2배 (최대 loginMaxDelay)
+func loginBackoff(count int, threshold int) time.Duration {
+	if count < threshold {
+		return 0
+	}
+	if shift := count - threshold; shift < 6 {
+		return min(time.Second<<shift, loginMaxDelay)
+	}
+	return loginMaxDelay
+}
+
+// 마지막 실패 이후 남은 대기 시간
+func loginWait(failures *entity.LoginFailures, threshold int) time.Duration {
+	delay := loginBackoff(failures.Count, threshold)
+	if delay == 0 {
+		return 0
+	}
+	return max(time.Until(failures.LastFailureAt.Add(delay)), 0)
+}
+
+// 윈도우 내 실패 횟수가 많으면 다음 시도까지 대기 (대기 시간은 실패할 때마다 2배)
+// 남은 대기 시간이 짧으면 응답을 늦춰서 처리하고, 길면 429로 거부
+func (u *authUsecase) checkLoginThrottle(email string, ip string) error {
+	ipFailures, err := u.authRepo.GetLoginFailures(entity.LoginLimitIP, ip, loginFailureWindow)
+	if err != nil {
+		return common.NewError(http.StatusInternalServerError, "로그인 제한 확인에 실패했습니다", err)
+	}
+	if ipFailures.Count >= loginIPThreshold {
+		log.Printf("IP 로그인 실패 한도 초과: %s", ip)
+		return common.NewError(http.StatusTooManyRequests, "로그인 시도가 너무 많습니다. 잠시 후 다시 시도해주세요", nil)
+	}
+
+	emailFailures, err := u.authRepo.GetLoginFailures(entity.LoginLimitEmail, email, loginFailureWindow)
+	if err != nil {
+		return common.NewError(http.StatusInternalServerError, "로그인 제한 확인에 실패했습니다", err)
+	}
+
+	wait := max(loginWait(ipFailures, loginIPDelayThreshold), loginWait(emailFailures, loginDelayThreshold))
+	if wait == 0 {
+		return nil
 	}
+	if wait <= loginInlineDelay {
+		time.Sleep(wait)
+		return nil
+	}
+	seconds := int(wait.Seconds()) + 1
+	return common.NewError(http.StatusTooManyRequests, fmt.Sprintf("로그인 시도가 너무 많습니다. %d초 후 다시 시도해주세요", seconds), nil)
+}
 
-	mergeKey := fmt.Sprintf("%s:%s", userIdStr, email)
+// 실패 기록 - 이메일별 실패가 한도에 도달하면 계정 잠금
+func (u *authUsecase) recordLoginFailure(user *_userEntity.User, email string, ip string) error {
+	if _, err := u.authRepo.RecordLoginFailure(entity.LoginLimitIP, ip, loginFailureWindow); err != nil {
+		log.Printf("IP 로그인 실패 기록 오류: %v", err)
+	}
 
-	refreshToken, err := u.authRepo.GetRefreshToken(mergeKey)
+	failures, err := u.authRepo.RecordLoginFailure(entity.LoginLimitEmail, email, loginFailureWindow)
 	if err != nil {
-		log.Printf("리프레시 토큰 조회 오류: %v", err)
-		return "", common.NewError(http.StatusInternalServerError, "로그인 필요", err)
+		log.Printf("이메일 로그인 실패 기록 오류: %v", err)
+		return nil
+	}
+
+	if user == nil || failures.Count < loginLockThreshold {
+		return nil
+	}
+	// 정지/인증 대기 계정은 상태를 덮어쓰지 않음
+	if user.Status != nil && *user.Status != _userEntity.UserStatusActive && *user.Status != _userEntity.UserStatusLocked {
+		return nil
+	}
+
+	lockedUntil := time.Now().Add(loginLockDuration)
+	if err := u.authRepo.SetLoginLock(*user.ID, lockedUntil); err != nil {
+		return common.NewError(http.StatusInternalServerError, "계정 잠금 처리에 실패했습니다", err)
+	}
+	if err := u.userRepo.UpdateUser(*user.ID, map[string]interface{}{"status": _userEntity.UserStatusLocked}, nil); err != nil {
+		log.Printf("계정 잠금 상태 저장 오류: %v", err)
+		return common.NewError(http.StatusInternalServerError, "계정 잠금 처리에 실패했습니다", err)
+	}
+
+	log.Printf("로그인 실패 한도 초과로 계정 잠금: %s", email)
+	u.publishUserLocked(user, ip, failures.Count, lockedUntil)
+
+	return common.NewError(http.StatusLocked, fmt.Sprintf("로그인 실패가 반복되어 계정이 %d분간 잠겼습니다", int(loginLockDuration.Minutes())), nil)
+}
+
+// 잠금 중이면 에러, 로그인 실패로 잠긴 계정의 잠금 시간이 지났으면 잠금 해제
+// 잠금 기록이 없으면 관리자가 잠근 계정이므로 관리자가 해제할 때까지 유지
+func (u *authUsecase) checkLoginLock(user *_userEntity.User) error {
+	lockedUntil, err := u.authRepo.GetLoginLock(*user.ID)
+	if err != nil {
+		return common.NewError(http.StatusInternalServerError, "계정 잠금 확인에 실패했습니다", err)
+	}
+	if lockedUntil == nil {
+		return common.NewError(http.StatusLocked, "관리자에 의해 잠긴 계정입니다. 관리자에게 문의해주세요", nil)
+	}
+	if time.Now().Before(*lockedUntil) {
+		minutes := int(time.Until(*lockedUntil).Minutes()) + 1
+		return common.NewError(http.StatusLocked, fmt.Sprintf("잠긴 계정입니다. %d분 후 다시 시도하거나 관리자에게 문의해주세요", minutes), nil)
+	}
+
+	if err := u.userRepo.UpdateUser(*user.ID, map[string]interface{}{"status": _userEntity.UserStatusActive}, nil); err != nil {
+		log.Printf("계정 잠금 해제 오류: %v", err)
+		return common.NewError(http.StatusInternalServerError, "계정 잠금 해제에 실패했습니다", err)
+	}
+	if err := u.authRepo.DeleteLoginLock(*user.ID); err != nil {
+		log.Printf("계정 잠금 기록 삭제 오류: %v", err)
+	}
+	active := _userEntity.UserStatusActive
+	user.Status = &active
+	u.authRepo.ClearLoginFailures(entity.LoginLimitEmail, strings.ToLower(*user.Email))
+
+	return nil
+}
+
+func (u *authUsecase) publishUserLocked(user *_userEntity.User, ip string, failureCount int, lockedUntil time.Time) {
+	natsData := map[string]interface{}{
+		"topic": "link.event.user.locked",
+		"payload": map[string]interface{}{
+			"user_id":       _utils.GetValueOrDefault(user.ID, 0),
+			"email":         _utils.GetValueOrDefault(user.Email, ""),
+			"ip":            ip,
+			"failure_count": failureCount,
+			"locked_until":  lockedUntil,
+			"timestamp":     time.Now(),
+		},
+	}
+	jsonData, err := json.Marshal(natsData)
+	if err != nil {
+		log.Printf("NATS 데이터 직렬화 오류: %v", err)
+		return
 	}
-	return refreshToken, nil
+	u.natsPublisher.PublishEvent("link.event.user.locked", jsonData)
 }
@@ -34,24 +34,29 @@ type BoardColumn struct {
 	Name      string      `json:"name,omitempty"`
 	BoardID   uint        `json:"board_id,omitempty"`
 	Position  uint        `json:"position,omitempty"`
+	WipLimit  uint        `json:"wip_limit,omitempty"` // 0이면 제한 없음
+	Version   int         `json:"version,omitempty"`
 	CreatedAt time.Time   `json:"created_at,omitempty"`
 	UpdatedAt time.Time   `json:"updated_at,omitempty"`
 	Cards     []BoardCard `json:"cards,omitempty"`
 }
 
 type BoardCard struct {
-	ID            uuid.UUID `json:"id,omitempty"`
-	Name          string    `json:"name,omitempty"`
-	Content       string    `json:"content,omitempty"`
-	BoardID       uint      `json:"board_id,omitempty"`
-	BoardColumnID uuid.UUID `json:"board_column_id,omitempty"`
-	Position      uint      `json:"position,omitempty"`
-	StartDate     time.Time `json:"start_date,omitempty"`
-	EndDate       time.Time `json:"end_date,omitempty"`
-	Version       int       `json:"version,omitempty"`
-	CreatedAt     time.Time `json:"created_at,omitempty"`
-	UpdatedAt     time.Time `json:"updated_at,omitempty"`
-	Assignees     []uint    `json:"assignees,omitempty"`
+	ID             uuid.UUID `json:"id,omitempty"`
+	Name           string    `json:"name,omitempty"`
+	Content        string    `json:"content,omitempty"`
+	BoardID        uint      `json:"board_id,omitempty"`
+	BoardColumnID  uuid.UUID `json:"board_column_id,omitempty"`
+	Position       uint      `json:"position,omitempty"`
+	StartDate      time.Time `json:"start_date,omitempty"`
+	EndDate        time.Time `json:"end_date,omitempty"`
+	Version        int       `json:"version,omitempty"`
+	ContentVersion int       `json:"content_version,omitempty"`
+	CreatedAt      time.Time `json:"created_at,omitempty"`
+	UpdatedAt      time.Time `json:"updated_at,omitempty"`
+	Assignees      []uint    `json:"assignees,omitempty"`
+
+	Labels []uuid.UUID `json:"labels,omitempty"`
 }
 
 type CardAssignee struct {
@@ -59,15 +64,266 @@ type CardAssignee struct {
 	UserID uint      `json:"user_id,omitempty"`
 }
 
-// CardActivity 엔티티
-type CardActivity struct {
-	ID        string
-	UserID    uint
-	UserName  string
-	CardID    uint
-	ProjectID uint
+const MaxCardCommentLength = 5000 // 카드 댓글 최대 길이 (글자 수)
+
+// 보드 라벨 (Color는 #RRGGBB)
+type BoardLabel struct {
+	ID        uuid.UUID `json:"id,omitempty"`
+	BoardID   uint      `json:"board_id,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	Color     string    `json:"color,omitempty"`
+	Version   int       `json:"version,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// 카드 댓글 - ParentID가 있으면 답글 (한 단계만)
+type BoardCardComment struct {
+	ID        uuid.UUID  `json:"id,omitempty"`
+	CardID    uuid.UUID  `json:"card_id,omitempty"`
+	BoardID   uint       `json:"board_id,omitempty"`
+	ParentID  *uuid.UUID `json:"parent_id,omitempty"`
+	UserID    uint       `json:"user_id,omitempty"`
+	Content   string     `json:"content,omitempty"`
+	Mentions  []uint     `json:"mentions,omitempty"`
+	Version   int        `json:"version,omitempty"`
+	CreatedAt time.Time  `json:"created_at,omitempty"`
+	UpdatedAt time.Time  `json:"updated_at,omitempty"`
+}
+
+type BoardCardChecklist struct {
+	ID        uuid.UUID                `json:"id,omitempty"`
+	CardID    uuid.UUID                `json:"card_id,omitempty"`
+	BoardID   uint                     `json:"board_id,omitempty"`
+	Name      string                   `json:"name,omitempty"`
+	Position  uint                     `json:"position,omitempty"`
+	Version   int                      `json:"version,omitempty"`
+	CreatedAt time.Time                `json:"created_at,omitempty"`
+	UpdatedAt time.Time                `json:"updated_at,omitempty"`
+	Items     []BoardCardChecklistItem `json:"items,omitempty"`
+}
+
+type BoardCardChecklistItem struct {
+	ID          uuid.UUID `json:"id,omitempty"`
+	ChecklistID uuid.UUID `json:"checklist_id,omitempty"`
+	CardID      uuid.UUID `json:"card_id,omitempty"`
+	BoardID     uint      `json:"board_id,omitempty"`
+	Content     string    `json:"content,omitempty"`
+	Checked     bool      `json:"checked,omitempty"`
+	Position    uint      `json:"position,omitempty"`
+	Version     int       `json:"version,omitempty"`
+	CreatedAt   time.Time `json:"created_at,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at,omitempty"`
+}
+
+type BoardCardAttachment struct {
+	ID          uuid.UUID `json:"id,omitempty"`
+	CardID      uuid.UUID `json:"card_id,omitempty"`
+	BoardID     uint      `json:"board_id,omitempty"`
+	URL         string    `json:"url,omitempty"`
+	Name        string    `json:"name,omitempty"`
+	Size        int64     `json:"size,omitempty"`
+	ContentType string    `json:"content_type,omitempty"`
+	UploadedBy  uint      `json:"uploaded_by,omitempty"`
+	CreatedAt   time.Time `json:"created_at,omitempty"`
+}
+
+// BoardContent 보드를 만들 때 한 트랜잭션으로 함께 저장하는 내용 (기본 컬럼, 템플릿, 보드 복사)
+// ID는 모두 새로 발급된 상태여야 하고 BoardID는 저장 시 채움
+// 카드는 Columns[].Cards, 체크리스트 항목은 Checklists[].Items에 둠
+type BoardContent struct {
+	Columns     []BoardColumn
+	Labels      []BoardLabel
+	Checklists  []BoardCardChecklist
+	Attachments []BoardCardAttachment
+}
+
+// 보드 템플릿 제한
+const (
+	MaxBoardTemplateColumns = 30
+	MaxBoardTemplateLabels  = 50
+	MaxBoardTemplateCards   = 200
+)
+
+// BoardTemplate 회사 단위 보드 템플릿
+type BoardTemplate struct {
+	ID          uint                  `json:"id,omitempty"`
+	CompanyID   uint                  `json:"company_id,omitempty"`
+	Name        string                `json:"name,omitempty"`
+	Description string                `json:"description,omitempty"`
+	CreatedBy   uint                  `json:"created_by,omitempty"`
+	CreatedAt   time.Time             `json:"created_at,omitempty"`
+	UpdatedAt   time.Time             `json:"updated_at,omitempty"`
+	Columns     []BoardTemplateColumn `json:"columns,omitempty"`
+	Labels      []BoardTemplateLabel  `json:"labels,omitempty"`
+	Cards       []BoardTemplateCard   `json:"cards,omitempty"`
+}
+
+type BoardTemplateColumn struct {
+	ID       uuid.UUID `json:"id,omitempty"`
+	Name     string    `json:"name,omitempty"`
+	Position uint      `json:"position,omitempty"`
+	WipLimit uint      `json:"wip_limit,omitempty"`
+}
+
+type BoardTemplateLabel struct {
+	ID    uuid.UUID `json:"id,omitempty"`
+	Name  string    `json:"name,omitempty"`
+	Color string    `json:"color,omitempty"`
+}
+
+// 템플릿 예시 카드 - ColumnID, Labels는 같은 템플릿의 컬럼/라벨 ID
+type BoardTemplateCard struct {
+	ID       uuid.UUID   `json:"id,omitempty"`
+	ColumnID uuid.UUID   `json:"column_id,omitempty"`
+	Name     string      `json:"name,omitempty"`
+	Content  string      `json:"content,omitempty"`
+	Position uint        `json:"position,omitempty"`
+	Labels   []uuid.UUID `json:"labels,omitempty"`
+}
+
+// 자동 저장 변경사항 적용 결과
+const (
+	BoardChangeApplied  = "applied"  // 그대로 적용
+	BoardChangeMerged   = "merged"   // 이전 버전 기준 이동 요청을 현재 상태에 맞춰 적용
+	BoardChangeConflict = "conflict" // 이전 버전 기준 수정/삭제라 거절 (Column/Card 등에 현재 상태, 삭제된 경우 nil)
+)
+
+// BoardChange 자동 저장 변경사항 - 한 번의 트랜잭션으로 적용
+// Version은 클라이언트가 마지막으로 받은 버전 (nil이면 버전 비교 없이 적용)
+// label/comment/checklist/checklist_item/attachment는 TargetID가 대상, CardID(항목은 ChecklistID)가 속한 카드
+type BoardChange struct {
+	Type      string // column | card | label | comment | checklist | checklist_item | attachment
+	Action    string // create | update | delete | move
+	ColumnID  *uuid.UUID
+	CardID    uuid.UUID
+	Position  *uint
+	Name      *string
+	Content   *string
+	StartDate *time.Time
+	EndDate   *time.Time
+	Version   *int
+	Assignees []uint
+	WipLimit  *uint
+
+	ContentVersion *int // 카드 내용 수정 시 클라이언트가 마지막으로 받은 내용 버전
+
+	UserID      uint // 변경한 사용자 (댓글 작성자, 첨부 파일 업로드한 사용자)
+	TargetID    uuid.UUID
+	ChecklistID *uuid.UUID
+	ParentID    *uuid.UUID
+	Labels      []uuid.UUID
+	Mentions    []uint
+	Color       *string
+	Checked     *bool
+	URL         *string
+	Size        *int64
+	ContentType *string
+}
+
+type BoardChangeResult struct {
+	Index    int
+	Type     string
+	Action   string
+	TargetID uuid.UUID
+	Status   string
+	Column   *BoardColumn
+	Card     *BoardCard
+	// 적용 전 상태 (생성이면 nil)
+	PrevColumn *BoardColumn
+	PrevCard   *BoardCard
+
+	Label             *BoardLabel
+	Comment           *BoardCardComment
+	Checklist         *BoardCardChecklist
+	ChecklistItem     *BoardCardChecklistItem
+	Attachment        *BoardCardAttachment
+	PrevLabel         *BoardLabel
+	PrevComment       *BoardCardComment
+	PrevChecklist     *BoardCardChecklist
+	PrevChecklistItem *BoardCardChecklistItem
+	PrevAttachment    *BoardCardAttachment
+}
+
+// 카드 협업 편집
+const (
+	CardOperationHistorySize = 500             // 카드별로 보관하는 최근 연산 수 (이보다 오래된 리비전 기준 연산은 다시 불러와야 함)
+	CardDocumentTTL          = 24 * time.Hour  // 마지막 편집 이후 redis 문서 보관 기간
+	CardCursorTTL            = 5 * time.Minute // 이 시간 동안 움직임이 없으면 커서 숨김
+	MaxCardContentLength     = 65536           // 카드 내용 최대 길이 (UTF-16 코드 단위)
+)
+
+// CardDocument 협업 편집 중인 카드 내용 (redis) - 편집 연산 하나마다 Revision 증가
+// Session은 redis에 문서를 처음 올릴 때 발급, 문서가 만료되어 다시 올라가면 바뀌므로 이전 세션 기준 연산은 거절
+type CardDocument struct {
+	CardID    uuid.UUID
 	BoardID   uint
-	Action    string
-	CreatedAt time.Time
+	Session   string
+	Content   string
+	Revision  int
+	UpdatedBy uint
 	UpdatedAt time.Time
 }
+
+// CardOperation 리비전에 적용된 편집 연산 (ot.js TextOperation JSON)
+type CardOperation struct {
+	Revision  int       `json:"revision"` // 적용 후 리비전
+	UserID    uint      `json:"user_id"`
+	ClientID  string    `json:"client_id"`
+	Operation string    `json:"operation"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CardCursor 협업 편집 중인 사용자의 커서/선택 영역 (Revision 기준 위치)
+type CardCursor struct {
+	CardID    uuid.UUID `json:"card_id"`
+	ClientID  string    `json:"client_id"`
+	UserID    uint      `json:"user_id"`
+	UserName  string    `json:"user_name"`
+	Revision  int       `json:"revision"`
+	Anchor    int       `json:"anchor"`
+	Head      int       `json:"head"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CardActivity 보드 활동 기록 (자동 저장으로 적용된 카드/컬럼 변경 하나당 하나)
+// 카드 활동은 ColumnID에 카드가 속한 컬럼, 이동이면 From/To에 이동 전후 컬럼과 위치
+type CardActivity struct {
+	ID             string
+	UserID         uint
+	UserName       string
+	ProjectID      uint
+	BoardID        uint
+	TargetType     string     // column | card | label | comment | checklist | checklist_item | attachment
+	TargetID       *uuid.UUID // 라벨, 댓글, 체크리스트, 체크리스트 항목, 첨부 파일 ID
+	CardID         *uuid.UUID
+	ColumnID       *uuid.UUID
+	Name           string // 변경 후 (삭제면 삭제 전) 카드/컬럼 이름
+	Action         string // create | update | delete | move
+	FromColumnID   *uuid.UUID
+	FromColumnName string
+	ToColumnID     *uuid.UUID
+	ToColumnName   string
+	FromPosition   *uint
+	ToPosition     *uint
+	Changes        []CardActivityChange
+	CreatedAt      time.Time
+}
+
+// CardActivityChange 수정된 항목의 이전/이후 값
+type CardActivityChange struct {
+	Field  string
+	Before string
+	After  string
+}
+
+// 활동 조회 조건 - Cursor는 이전 페이지 마지막 활동 ID
+type CardActivityFilter struct {
+	BoardID    uint
+	CardID     *uuid.UUID // 카드와 카드의 댓글, 체크리스트, 첨부 파일 활동
+	UserID     uint
+	Action     string
+	TargetType string
+	Cursor     string
+	Limit      int
+}
@@ -8,9 +8,10 @@ import (
 
 type BoardRepository interface {
 	//보드 정보 관련
-	CreateBoard(board *entity.Board, boardUsers []entity.BoardUser) error
+	CreateBoard(board *entity.Board, boardUsers []entity.BoardUser, content *entity.BoardContent) error
 	GetBoardByID(boardID uint) (*entity.Board, error)
 	GetBoardsByProjectID(projectID uint) ([]entity.Board, error)
+	GetBoardContent(boardID uint) (*entity.BoardContent, error)
 
 	UpdateBoard(board *entity.Board) error
 	DeleteBoard(boardID uint) error
@@ -33,4 +34,34 @@ type BoardRepository interface {
 	UpdateBoardCard(boardCard *entity.BoardCard) error
 	DeleteBoardCard(cardID uuid.UUID) error
 	MoveBoardCard(cardID uuid.UUID, toColumnID *uuid.UUID, newPosition *uint) error
+	//자동 저장 관련 (변경사항 묶음을 한 트랜잭션으로 적용)
+	ApplyBoardChanges(boardID uint, changes []entity.BoardChange) ([]entity.BoardChangeResult, error)
+	//카드 협업 편집 관련 (redis 문서 + postgres 스냅샷)
+	GetCardDocument(cardID uuid.UUID) (*entity.CardDocument, error)
+	InitCardDocument(document *entity.CardDocument) (*entity.CardDocument, error)
+	GetCardOperations(cardID uuid.UUID, afterRevision int) ([]entity.CardOperation, error)
+	SaveCardOperation(document *entity.CardDocument, operation *entity.CardOperation) (bool, error)
+	GetLatestCardSnapshotRevision(cardID uuid.UUID) (int, error)
+	DrainDirtyCardDocuments() ([]entity.CardDocument, error)
+	RestoreDirtyCardDocuments(cardIDs []uuid.UUID) error
+	SaveCardDocumentSnapshots(documents []entity.CardDocument) error
+	GetBoardCardCursors(boardID uint) ([]entity.CardCursor, error)
+	SaveBoardCardCursor(boardID uint, cursor *entity.CardCursor) error
+	DeleteBoardCardCursors(boardID uint, cursors []entity.CardCursor) error
+	//카드 라벨, 댓글, 체크리스트, 첨부 파일 관련 (변경은 ApplyBoardChanges로)
+	GetBoardLabels(boardID uint) ([]entity.BoardLabel, error)
+	GetBoardCardLabels(boardID uint) (map[uuid.UUID][]uuid.UUID, error)
+	GetBoardCardComments(boardID uint) ([]entity.BoardCardComment, error)
+	GetBoardCardCommentsByIDs(boardID uint, commentIDs []uuid.UUID) ([]entity.BoardCardComment, error)
+	GetBoardCardChecklists(boardID uint) ([]entity.BoardCardChecklist, error)
+	GetBoardCardAttachments(boardID uint) ([]entity.BoardCardAttachment, error)
+	//보드 템플릿 관련
+	CreateBoardTemplate(template *entity.BoardTemplate) error
+	GetBoardTemplatesByCompanyID(companyID uint) ([]entity.BoardTemplate, error)
+	GetBoardTemplateByID(templateID uint) (*entity.BoardTemplate, error)
+	DeleteBoardTemplate(templateID uint) error
+	//활동 기록 관련 (몽고 디비)
+	CreateCardActivities(activities []entity.CardActivity) error
+	GetCardActivities(filter entity.CardActivityFilter) ([]entity.CardActivity, bool, error)
+	GetCardActivityBoardID(cardID uuid.UUID) (uint, error)
 }
@@ -0,0 +1,454 @@
+package usecase
+
+import (
+	"fmt"
+	"link/internal/board/entity"
+	"link/pkg/common"
+	"link/pkg/dto/req"
+	"link/pkg/dto/res"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 활동 기록에 남기는 카드 내용 길이 (내용 전체는 스냅샷에 있음)
+const cardActivityContentPreviewLength = 100
+
+// ! 보드 활동 기록 관련
+// 보드 활동 조회 (보드 사용자만)
+func (u *boardUsecase) GetBoardActivities(userId uint, boardID uint, queryParams *req.GetBoardActivitiesQueryParams) (*res.GetBoardActivitiesResponse, error) {
+	_, err := u.boardRepo.GetBoardByID(boardID)
+	if err != nil {
+		return nil, common.NewError(http.StatusNotFound, "보드를 찾을 수 없습니다.", err)
+	}
+
+	if _, err := u.boardRepo.CheckBoardUserRole(boardID, userId); err != nil {
+		return nil, common.NewError(http.StatusForbidden, "해당 보드에 접근할 수 없습니다.", err)
+	}
+
+	return u.getCardActivities(boardID, nil, queryParams)
+}
+
+// 카드 활동 조회 - 삭제된 카드도 활동 기록으로 보드를 찾아 조회
+func (u *boardUsecase) GetCardActivities(userId uint, cardID uuid.UUID, queryParams *req.GetBoardActivitiesQueryParams) (*res.GetBoardActivitiesResponse, error) {
+	var boardID uint
+	if card, err := u.boardRepo.GetBoardCardByID(cardID); err == nil {
+		boardID = card.BoardID
+	} else {
+		boardID, err = u.boardRepo.GetCardActivityBoardID(cardID)
+		if err != nil {
+			return nil, common.NewError(http.StatusInternalServerError, "카드 활동 조회 실패", err)
+		}
+		if boardID == 0 {
+			return nil, common.NewError(http.StatusNotFound, "카드를 찾을 수 없습니다.", nil)
+		}
+	}
+
+	if _, err := u.boardRepo.CheckBoardUserRole(boardID, userId); err != nil {
+		return nil, common.NewError(http.StatusForbidden, "해당 보드에 접근할 수 없습니다.", err)
+	}
+
+	return u.getCardActivities(boardID, &cardID, queryParams)
+}
+
+func (u *boardUsecase) getCardActivities(boardID uint, cardID *uuid.UUID, queryParams *req.GetBoardActivitiesQueryParams) (*res.GetBoardActivitiesResponse, error) {
+	switch queryParams.Action {
+	case "", "create", "update", "delete", "move":
+	default:
+		return nil, common.NewError(http.StatusBadRequest, "action이 올바르지 않습니다.", nil)
+	}
+
+	switch queryParams.TargetType {
+	case "", "column", "card", "label", "comment", "checklist", "checklist_item", "attachment":
+	default:
+		return nil, common.NewError(http.StatusBadRequest, "target_type이 올바르지 않습니다.", nil)
+	}
+
+	if queryParams.Cursor != "" {
+		if _, err := primitive.ObjectIDFromHex(queryParams.Cursor); err != nil {
+			return nil, common.NewError(http.StatusBadRequest, "유효하지 않은 커서입니다.", err)
+		}
+	}
+
+	activities, hasMore, err := u.boardRepo.GetCardActivities(entity.CardActivityFilter{
+		BoardID:    boardID,
+		CardID:     cardID,
+		UserID:     queryParams.UserID,
+		Action:     queryParams.Action,
+		TargetType: queryParams.TargetType,
+		Cursor:     queryParams.Cursor,
+		Limit:      queryParams.Limit,
+	})
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "보드 활동 조회 실패", err)
+	}
+
+	response := &res.GetBoardActivitiesResponse{
+		Activities: make([]res.BoardActivityResponse, len(activities)),
+		Meta: &res.BoardActivityMeta{
+			HasMore:  hasMore,
+			PageSize: queryParams.Limit,
+		},
+	}
+	for i, activity := range activities {
+		response.Activities[i] = res.BoardActivityResponse{
+			ID:             activity.ID,
+			UserID:         activity.UserID,
+			UserName:       activity.UserName,
+			ProjectID:      activity.ProjectID,
+			BoardID:        activity.BoardID,
+			TargetType:     activity.TargetType,
+			TargetID:       activity.TargetID,
+			CardID:         activity.CardID,
+			ColumnID:       activity.ColumnID,
+			Name:           activity.Name,
+			Action:         activity.Action,
+			FromColumnID:   activity.FromColumnID,
+			FromColumnName: activity.FromColumnName,
+			ToColumnID:     activity.ToColumnID,
+			ToColumnName:   activity.ToColumnName,
+			FromPosition:   activity.FromPosition,
+			ToPosition:     activity.ToPosition,
+			CreatedAt:      activity.CreatedAt,
+		}
+		for _, change := range activity.Changes {
+			response.Activities[i].Changes = append(response.Activities[i].Changes, res.BoardActivityChangeResponse{
+				Field:  change.Field,
+				Before: change.Before,
+				After:  change.After,
+			})
+		}
+	}
+	if hasMore && len(activities) > 0 {
+		response.Meta.NextCursor = activities[len(activities)-1].ID
+	}
+
+	return response, nil
+}
+
+// 자동 저장 결과를 활동 기록으로 저장 - 보드 저장은 이미 끝났으므로 실패해도 로그만 남김
+func (u *boardUsecase) saveBoardActivities(userId uint, userName string, projectID uint, boardID uint, results []entity.BoardChangeResult) {
+	columnNames := make(map[uuid.UUID]string)
+	if columns, err := u.boardRepo.GetBoardColumnsByBoardID(boardID); err == nil {
+		for _, column := range columns {
+			columnNames[column.ID] = column.Name
+		}
+	} else {
+		log.Printf("보드 컬럼 조회 실패: %v", err)
+	}
+	// 같은 묶음에서 삭제된 컬럼도 이름을 남길 수 있도록
+	for _, result := range results {
+		if result.PrevColumn != nil {
+			if _, ok := columnNames[result.PrevColumn.ID]; !ok {
+				columnNames[result.PrevColumn.ID] = result.PrevColumn.Name
+			}
+		}
+	}
+
+	now := time.Now()
+	activities := make([]entity.CardActivity, 0, len(results))
+	for _, result := range results {
+		if result.Status == entity.BoardChangeConflict {
+			continue
+		}
+
+		activity := entity.CardActivity{
+			UserID:     userId,
+			UserName:   userName,
+			ProjectID:  projectID,
+			BoardID:    boardID,
+			TargetType: result.Type,
+			Action:     result.Action,
+			CreatedAt:  now,
+		}
+		var ok bool
+		switch result.Type {
+		case "column":
+			ok = buildColumnActivity(&activity, result)
+		case "card":
+			ok = buildCardActivity(&activity, result, columnNames)
+		default:
+			ok = buildCardDetailActivity(&activity, result)
+		}
+		if ok {
+			activities = append(activities, activity)
+		}
+	}
+
+	if err := u.boardRepo.CreateCardActivities(activities); err != nil {
+		log.Printf("보드 활동 저장 실패: %v", err)
+	}
+}
+
+// 실제로 바뀐 것이 없으면 false
+func buildColumnActivity(activity *entity.CardActivity, result entity.BoardChangeResult) bool {
+	before, after := result.PrevColumn, result.Column
+	columnID := result.TargetID
+	activity.ColumnID = &columnID
+
+	switch result.Action {
+	case "create":
+		if after == nil {
+			return false
+		}
+		activity.Name = after.Name
+		activity.ToPosition = uintPtr(after.Position)
+	case "delete":
+		if before == nil {
+			return false
+		}
+		activity.Name = before.Name
+		activity.FromPosition = uintPtr(before.Position)
+	case "update":
+		if before == nil || after == nil {
+			return false
+		}
+		activity.Name = after.Name
+		activity.Changes = appendActivityChange(activity.Changes, "name", before.Name, after.Name)
+		activity.Changes = appendActivityChange(activity.Changes, "wip_limit", fmt.Sprint(before.WipLimit), fmt.Sprint(after.WipLimit))
+		return len(activity.Changes) > 0
+	case "move":
+		if before == nil || after == nil || before.Position == after.Position {
+			return false
+		}
+		activity.Name = after.Name
+		activity.FromPosition = uintPtr(before.Position)
+		activity.ToPosition = uintPtr(after.Position)
+	}
+
+	return true
+}
+
+func buildCardActivity(activity *entity.CardActivity, result entity.BoardChangeResult, columnNames map[uuid.UUID]string) bool {
+	before, after := result.PrevCard, result.Card
+	cardID := result.TargetID
+	activity.CardID = &cardID
+
+	switch result.Action {
+	case "create":
+		if after == nil {
+			return false
+		}
+		activity.Name = after.Name
+		activity.ColumnID = uuidPtr(after.BoardColumnID)
+		activity.ToColumnID = uuidPtr(after.BoardColumnID)
+		activity.ToColumnName = columnNames[after.BoardColumnID]
+		activity.ToPosition = uintPtr(after.Position)
+	case "delete":
+		if before == nil {
+			return false
+		}
+		activity.Name = before.Name
+		activity.ColumnID = uuidPtr(before.BoardColumnID)
+		activity.FromColumnID = uuidPtr(before.BoardColumnID)
+		activity.FromColumnName = columnNames[before.BoardColumnID]
+		activity.FromPosition = uintPtr(before.Position)
+	case "update":
+		if before == nil || after == nil {
+			return false
+		}
+		activity.Name = after.Name
+		activity.ColumnID = uuidPtr(after.BoardColumnID)
+		activity.Changes = appendActivityChange(activity.Changes, "name", before.Name, after.Name)
+		activity.Changes = appendActivityChange(activity.Changes, "content",
+			contentPreview(before.Content), contentPreview(after.Content))
+		activity.Changes = appendActivityChange(activity.Changes, "start_date",
+			formatActivityTime(before.StartDate), formatActivityTime(after.StartDate))
+		activity.Changes = appendActivityChange(activity.Changes, "end_date",
+			formatActivityTime(before.EndDate), formatActivityTime(after.EndDate))
+		activity.Changes = appendActivityChange(activity.Changes, "assignees",
+			formatActivityUserIDs(before.Assignees), formatActivityUserIDs(after.Assignees))
+		activity.Changes = appendActivityChange(activity.Changes, "labels",
+			formatActivityUUIDs(before.Labels), formatActivityUUIDs(after.Labels))
+		return len(activity.Changes) > 0
+	case "move":
+		if before == nil || after == nil ||
+			(before.BoardColumnID == after.BoardColumnID && before.Position == after.Position) {
+			return false
+		}
+		activity.Name = after.Name
+		activity.ColumnID = uuidPtr(after.BoardColumnID)
+		activity.FromColumnID = uuidPtr(before.BoardColumnID)
+		activity.FromColumnName = columnNames[before.BoardColumnID]
+		activity.FromPosition = uintPtr(before.Position)
+		activity.ToColumnID = uuidPtr(after.BoardColumnID)
+		activity.ToColumnName = columnNames[after.BoardColumnID]
+		activity.ToPosition = uintPtr(after.Position)
+	}
+
+	return true
+}
+
+// 라벨, 댓글, 체크리스트, 첨부 파일 - CardID는 속한 카드 (라벨은 보드 단위라 없음)
+func buildCardDetailActivity(activity *entity.CardActivity, result entity.BoardChangeResult) bool {
+	targetID := result.TargetID
+	activity.TargetID = &targetID
+
+	switch result.Type {
+	case "label":
+		before, after := result.PrevLabel, result.Label
+		if after != nil {
+			activity.Name = after.Name
+		} else if before != nil {
+			activity.Name = before.Name
+		}
+		if result.Action == "update" && before != nil && after != nil {
+			activity.Changes = appendActivityChange(activity.Changes, "name", before.Name, after.Name)
+			activity.Changes = appendActivityChange(activity.Changes, "color", before.Color, after.Color)
+			return len(activity.Changes) > 0
+		}
+		return after != nil || before != nil
+	case "comment":
+		before, after := result.PrevComment, result.Comment
+		comment := after
+		if comment == nil {
+			comment = before
+		}
+		if comment == nil {
+			return false
+		}
+		activity.CardID = uuidPtr(comment.CardID)
+		activity.Name = contentPreview(comment.Content)
+		if result.Action == "update" && before != nil && after != nil {
+			activity.Changes = appendActivityChange(activity.Changes, "content",
+				contentPreview(before.Content), contentPreview(after.Content))
+			activity.Changes = appendActivityChange(activity.Changes, "mentions",
+				formatActivityUserIDs(before.Mentions), formatActivityUserIDs(after.Mentions))
+			return len(activity.Changes) > 0
+		}
+	case "checklist":
+		before, after := result.PrevChecklist, result.Checklist
+		checklist := after
+		if checklist == nil {
+			checklist = before
+		}
+		if checklist == nil {
+			return false
+		}
+		activity.CardID = uuidPtr(checklist.CardID)
+		activity.Name = checklist.Name
+		switch result.Action {
+		case "update":
+			if before == nil || after == nil {
+				return false
+			}
+			activity.Changes = appendActivityChange(activity.Changes, "name", before.Name, after.Name)
+			return len(activity.Changes) > 0
+		case "move":
+			if before == nil || after == nil || before.Position == after.Position {
+				return false
+			}
+			activity.FromPosition = uintPtr(before.Position)
+			activity.ToPosition = uintPtr(after.Position)
+		}
+	case "checklist_item":
+		before, after := result.PrevChecklistItem, result.ChecklistItem
+		item := after
+		if item == nil {
+			item = before
+		}
+		if item == nil {
+			return false
+		}
+		activity.CardID = uuidPtr(item.CardID)
+		activity.Name = contentPreview(item.Content)
+		switch result.Action {
+		case "update":
+			if before == nil || after == nil {
+				return false
+			}
+			activity.Changes = appendActivityChange(activity.Changes, "content", before.Content, after.Content)
+			activity.Changes = appendActivityChange(activity.Changes, "checked",
+				fmt.Sprint(before.Checked), fmt.Sprint(after.Checked))
+			return len(activity.Changes) > 0
+		case "move":
+			if before == nil || after == nil ||
+				(before.ChecklistID == after.ChecklistID && before.Position == after.Position) {
+				return false
+			}
+			activity.FromPosition = uintPtr(before.Position)
+			activity.ToPosition = uintPtr(after.Position)
+			if before.CardID != after.CardID {
+				activity.Changes = appendActivityChange(activity.Changes, "card_id", before.CardID.String(), after.CardID.String())
+			}
+		}
+	case "attachment":
+		before, after := result.PrevAttachment, result.Attachment
+		attachment := after
+		if attachment == nil {
+			attachment = before
+		}
+		if attachment == nil {
+			return false
+		}
+		activity.CardID = uuidPtr(attachment.CardID)
+		activity.Name = attachment.Name
+		if result.Action == "update" {
+			if before == nil || after == nil {
+				return false
+			}
+			activity.Changes = appendActivityChange(activity.Changes, "name", before.Name, after.Name)
+			return len(activity.Changes) > 0
+		}
+	default:
+		return false
+	}
+
+	return true
+}
+
+func appendActivityChange(changes []entity.CardActivityChange, field string, before string, after string) []entity.CardActivityChange {
+	if before == after {
+		return changes
+	}
+	return append(changes, entity.CardActivityChange{Field: field, Before: before, After: after})
+}
+
+func contentPreview(content string) string {
+	runes := []rune(content)
+	if len(runes) <= cardActivityContentPreviewLength {
+		return content
+	}
+	return string(runes[:cardActivityContentPreviewLength]) + "..."
+}
+
+func formatActivityTime(value time.Time) string {
+	if value.IsZero() {
+		return ""
+	}
+	return value.Format(time.RFC3339)
+}
+
+// 할당자는 순서와 관계없이 비교 (조회 순서가 다를 수 있음)
+func formatActivityUserIDs(userIDs []uint) string {
+	sorted := append([]uint{}, userIDs...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	values := make([]string, len(sorted))
+	for i, userID := range sorted {
+		values[i] = fmt.Sprint(userID)
+	}
+	return strings.Join(values, ",")
+}
+
+func formatActivityUUIDs(ids []uuid.UUID) string {
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = id.String()
+	}
+	sort.Strings(values)
+	return strings.Join(values, ",")
+}
+
+func uintPtr(value uint) *uint {
+	return &value
+}
+
+func uuidPtr(value uuid.UUID) *uuid.UUID {
+	return &value
+}
@@ -0,0 +1,347 @@
+package usecase
+
+import (
+	"link/internal/board/entity"
+	"link/pkg/common"
+	"link/pkg/dto/req"
+	"link/pkg/dto/res"
+	"log"
+	"net/http"
+	"regexp"
+
+	"github.com/google/uuid"
+)
+
+// 카드 첨부 파일 URL 경로 (config/di.go의 보드 파일 업로드 미들웨어와 같아야 함)
+const BoardAttachmentURLPrefix = "/static/boards"
+
+var labelColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// ! 카드 라벨, 댓글, 체크리스트, 첨부 파일 관련
+// 첨부 파일 업로드 - 카드 연결은 자동 저장(attachment create)으로
+func (u *boardUsecase) UploadBoardAttachments(userId uint, projectID uint, boardID uint, files []req.BoardAttachmentRequest) (*res.UploadBoardAttachmentsResponse, error) {
+	board, err := u.boardRepo.GetBoardByID(boardID)
+	if err != nil {
+		return nil, common.NewError(http.StatusNotFound, "보드를 찾을 수 없습니다.", err)
+	}
+
+	if board.ProjectID != projectID {
+		return nil, common.NewError(http.StatusBadRequest, "프로젝트에 속한 보드가 아닙니다.", nil)
+	}
+
+	role, err := u.boardRepo.CheckBoardUserRole(boardID, userId)
+	if err != nil {
+		return nil, common.NewError(http.StatusForbidden, "해당 보드에 접근할 수 없습니다.", err)
+	}
+
+	if role < entity.BoardRoleMaintainer {
+		return nil, common.NewError(http.StatusForbidden, "해당 보드의 수정 권한이 없습니다.", nil)
+	}
+
+	if len(files) == 0 {
+		return nil, common.NewError(http.StatusBadRequest, "업로드할 파일이 없습니다.", nil)
+	}
+
+	response := &res.UploadBoardAttachmentsResponse{
+		Files: make([]res.BoardAttachmentFileResponse, len(files)),
+	}
+	for i, file := range files {
+		response.Files[i] = res.BoardAttachmentFileResponse{
+			URL:         file.URL,
+			Name:        file.Name,
+			Size:        file.Size,
+			ContentType: file.ContentType,
+		}
+	}
+
+	return response, nil
+}
+
+// 댓글 수정은 작성자만, 삭제는 작성자 또는 보드 관리자 이상 / 멘션은 보드 사용자만 가능
+func (u *boardUsecase) checkCardCommentChanges(userId uint, role int, boardID uint, changes []entity.BoardChange) error {
+	var commentIDs []uuid.UUID
+	hasMentions := false
+	for _, change := range changes {
+		if change.Type != "comment" {
+			continue
+		}
+		if change.Action == "update" || change.Action == "delete" {
+			commentIDs = append(commentIDs, change.TargetID)
+		}
+		if len(change.Mentions) > 0 {
+			hasMentions = true
+		}
+	}
+
+	if len(commentIDs) > 0 {
+		comments, err := u.boardRepo.GetBoardCardCommentsByIDs(boardID, commentIDs)
+		if err != nil {
+			return common.NewError(http.StatusInternalServerError, "댓글 조회 실패", err)
+		}
+
+		authors := make(map[uuid.UUID]uint, len(comments))
+		for _, comment := range comments {
+			authors[comment.ID] = comment.UserID
+		}
+
+		for _, change := range changes {
+			if change.Type != "comment" {
+				continue
+			}
+			// 없는 댓글은 적용할 때 conflict로 처리
+			authorID, ok := authors[change.TargetID]
+			if !ok || authorID == userId {
+				continue
+			}
+			if change.Action == "update" {
+				return common.NewError(http.StatusForbidden, "다른 사용자의 댓글은 수정할 수 없습니다.", nil)
+			}
+			if change.Action == "delete" && role < entity.BoardRoleAdmin {
+				return common.NewError(http.StatusForbidden, "다른 사용자의 댓글은 삭제할 수 없습니다.", nil)
+			}
+		}
+	}
+
+	if hasMentions {
+		boardUsers, err := u.boardRepo.GetBoardUsersByBoardID(boardID)
+		if err != nil {
+			return common.NewError(http.StatusInternalServerError, "보드 사용자 조회 실패", err)
+		}
+
+		members := make(map[uint]bool, len(boardUsers))
+		for _, boardUser := range boardUsers {
+			members[boardUser.UserID] = true
+		}
+
+		for _, change := range changes {
+			for _, mentionedID := range change.Mentions {
+				if !members[mentionedID] {
+					return common.NewError(http.StatusBadRequest, "보드 사용자만 멘션할 수 있습니다.", nil)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// 댓글 작성/수정으로 새로 멘션된 사용자에게만 알림 (자기 자신 제외)
+// 알림 대상은 보드 (카드 ID는 uuid라 알림 target_id에 담을 수 없음)
+func (u *boardUsecase) notifyCardCommentMentions(userId uint, boardID uint, results []entity.BoardChangeResult) {
+	for _, result := range results {
+		if result.Type != "comment" || result.Status == entity.BoardChangeConflict || result.Comment == nil {
+			continue
+		}
+
+		notified := map[uint]bool{userId: true}
+		if result.PrevComment != nil {
+			for _, mentionedID := range result.PrevComment.Mentions {
+				notified[mentionedID] = true
+			}
+		}
+
+		for _, mentionedID := range result.Comment.Mentions {
+			if notified[mentionedID] {
+				continue
+			}
+			notified[mentionedID] = true
+
+			if _, err := u.notificationUsecase.CreateMention(req.SendMentionNotificationRequest{
+				SenderID:   userId,
+				ReceiverID: mentionedID,
+				TargetType: "BOARD",
+				TargetID:   boardID,
+			}); err != nil {
+				log.Printf("댓글 멘션 알림 실패: %v", err)
+			}
+		}
+	}
+}
+
+// 칸반보드 조회용 카드별 라벨, 댓글, 체크리스트, 첨부 파일
+type boardCardDetails struct {
+	labels      []res.BoardLabelResponse
+	cardLabels  map[uuid.UUID][]uuid.UUID
+	comments    map[uuid.UUID][]res.BoardCardCommentResponse
+	checklists  map[uuid.UUID][]res.BoardCardChecklistResponse
+	attachments map[uuid.UUID][]res.BoardCardAttachmentResponse
+}
+
+func (u *boardUsecase) getBoardCardDetails(boardID uint) (*boardCardDetails, error) {
+	labels, err := u.boardRepo.GetBoardLabels(boardID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "보드 라벨 조회 실패", err)
+	}
+
+	cardLabels, err := u.boardRepo.GetBoardCardLabels(boardID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "카드 라벨 조회 실패", err)
+	}
+
+	comments, err := u.boardRepo.GetBoardCardComments(boardID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "카드 댓글 조회 실패", err)
+	}
+
+	checklists, err := u.boardRepo.GetBoardCardChecklists(boardID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "카드 체크리스트 조회 실패", err)
+	}
+
+	attachments, err := u.boardRepo.GetBoardCardAttachments(boardID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "카드 첨부 파일 조회 실패", err)
+	}
+
+	details := &boardCardDetails{
+		labels:      make([]res.BoardLabelResponse, len(labels)),
+		cardLabels:  cardLabels,
+		comments:    make(map[uuid.UUID][]res.BoardCardCommentResponse),
+		checklists:  make(map[uuid.UUID][]res.BoardCardChecklistResponse),
+		attachments: make(map[uuid.UUID][]res.BoardCardAttachmentResponse),
+	}
+
+	for i, label := range labels {
+		details.labels[i] = toBoardLabelResponse(label)
+	}
+
+	// 원 댓글을 먼저 넣고 답글은 원 댓글의 replies에 (둘 다 작성 순서)
+	rootIndex := make(map[uuid.UUID]int)
+	for _, comment := range comments {
+		if comment.ParentID == nil {
+			rootIndex[comment.ID] = len(details.comments[comment.CardID])
+			details.comments[comment.CardID] = append(details.comments[comment.CardID], toBoardCardCommentResponse(comment))
+		}
+	}
+	for _, comment := range comments {
+		if comment.ParentID == nil {
+			continue
+		}
+		index, ok := rootIndex[*comment.ParentID]
+		if !ok {
+			details.comments[comment.CardID] = append(details.comments[comment.CardID], toBoardCardCommentResponse(comment))
+			continue
+		}
+		root := &details.comments[comment.CardID][index]
+		root.Replies = append(root.Replies, toBoardCardCommentResponse(comment))
+	}
+
+	for _, checklist := range checklists {
+		details.checklists[checklist.CardID] = append(details.checklists[checklist.CardID], toBoardCardChecklistResponse(checklist))
+	}
+
+	for _, attachment := range attachments {
+		details.attachments[attachment.CardID] = append(details.attachments[attachment.CardID], toBoardCardAttachmentResponse(attachment))
+	}
+
+	return details, nil
+}
+
+func (d *boardCardDetails) fill(card *res.GetKanbanBoardCardResponse) {
+	card.Labels = d.cardLabels[card.ID]
+	if card.Labels == nil {
+		card.Labels = []uuid.UUID{}
+	}
+	card.Comments = d.comments[card.ID]
+	card.Checklists = d.checklists[card.ID]
+	card.Attachments = d.attachments[card.ID]
+
+	// 카드 전체 완료율은 모든 체크리스트 항목 기준
+	var completed, total int
+	for _, checklist := range card.Checklists {
+		completed += checklist.Progress.Completed
+		total += checklist.Progress.Total
+	}
+	if total > 0 {
+		progress := checklistProgress(completed, total)
+		card.ChecklistProgress = &progress
+	}
+}
+
+func checklistProgress(completed int, total int) res.ChecklistProgressResponse {
+	progress := res.ChecklistProgressResponse{Completed: completed, Total: total}
+	if total > 0 {
+		progress.Percent = completed * 100 / total
+	}
+	return progress
+}
+
+func toBoardLabelResponse(label entity.BoardLabel) res.BoardLabelResponse {
+	return res.BoardLabelResponse{
+		ID:        label.ID,
+		Name:      label.Name,
+		Color:     label.Color,
+		Version:   label.Version,
+		CreatedAt: label.CreatedAt,
+		UpdatedAt: label.UpdatedAt,
+	}
+}
+
+func toBoardCardCommentResponse(comment entity.BoardCardComment) res.BoardCardCommentResponse {
+	mentions := comment.Mentions
+	if mentions == nil {
+		mentions = []uint{}
+	}
+	return res.BoardCardCommentResponse{
+		ID:        comment.ID,
+		CardID:    comment.CardID,
+		ParentID:  comment.ParentID,
+		UserID:    comment.UserID,
+		Content:   comment.Content,
+		Mentions:  mentions,
+		Version:   comment.Version,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+	}
+}
+
+func toBoardCardChecklistResponse(checklist entity.BoardCardChecklist) res.BoardCardChecklistResponse {
+	response := res.BoardCardChecklistResponse{
+		ID:        checklist.ID,
+		CardID:    checklist.CardID,
+		Name:      checklist.Name,
+		Position:  checklist.Position,
+		Version:   checklist.Version,
+		Items:     make([]res.BoardCardChecklistItemResponse, len(checklist.Items)),
+		CreatedAt: checklist.CreatedAt,
+		UpdatedAt: checklist.UpdatedAt,
+	}
+
+	completed := 0
+	for i, item := range checklist.Items {
+		response.Items[i] = toBoardCardChecklistItemResponse(item)
+		if item.Checked {
+			completed++
+		}
+	}
+	response.Progress = checklistProgress(completed, len(checklist.Items))
+
+	return response
+}
+
+func toBoardCardChecklistItemResponse(item entity.BoardCardChecklistItem) res.BoardCardChecklistItemResponse {
+	return res.BoardCardChecklistItemResponse{
+		ID:          item.ID,
+		ChecklistID: item.ChecklistID,
+		CardID:      item.CardID,
+		Content:     item.Content,
+		Checked:     item.Checked,
+		Position:    item.Position,
+		Version:     item.Version,
+		CreatedAt:   item.CreatedAt,
+		UpdatedAt:   item.UpdatedAt,
+	}
+}
+
+func toBoardCardAttachmentResponse(attachment entity.BoardCardAttachment) res.BoardCardAttachmentResponse {
+	return res.BoardCardAttachmentResponse{
+		ID:          attachment.ID,
+		CardID:      attachment.CardID,
+		URL:         attachment.URL,
+		Name:        attachment.Name,
+		Size:        attachment.Size,
+		ContentType: attachment.ContentType,
+		UploadedBy:  attachment.UploadedBy,
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
@@ -0,0 +1,612 @@
+package usecase
+
+import (
+	"fmt"
+	"link/internal/board/entity"
+	_userEntity "link/internal/user/entity"
+	"link/pkg/common"
+	"link/pkg/dto/req"
+	"link/pkg/dto/res"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 템플릿 없이 보드를 만들 때 기본 컬럼
+var defaultBoardColumnNames = []string{"To Do", "In Progress", "Done"}
+
+// ! 보드 템플릿 관련
+func (u *boardUsecase) GetBoardTemplates(userId uint) (*res.GetBoardTemplatesResponse, error) {
+	user, err := u.userRepo.GetUserByID(userId)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "사용자 조회 실패", err)
+	}
+
+	companyID := userCompanyID(user)
+	if companyID == 0 {
+		return nil, common.NewError(http.StatusForbidden, "회사에 소속된 사용자만 보드 템플릿을 사용할 수 있습니다.", nil)
+	}
+
+	templates, err := u.boardRepo.GetBoardTemplatesByCompanyID(companyID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "보드 템플릿 조회 실패", err)
+	}
+
+	response := &res.GetBoardTemplatesResponse{
+		Templates: make([]res.BoardTemplateResponse, len(templates)),
+	}
+	for i, template := range templates {
+		response.Templates[i] = toBoardTemplateResponse(template)
+	}
+
+	return response, nil
+}
+
+func (u *boardUsecase) GetBoardTemplate(userId uint, templateID uint) (*res.BoardTemplateResponse, error) {
+	user, err := u.userRepo.GetUserByID(userId)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "사용자 조회 실패", err)
+	}
+
+	template, err := u.getCompanyBoardTemplate(user, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := toBoardTemplateResponse(*template)
+	return &response, nil
+}
+
+// 템플릿 직접 생성 - 카드는 column_index, label_indexes로 컬럼과 라벨을 가리킴
+func (u *boardUsecase) CreateBoardTemplate(userId uint, request *req.CreateBoardTemplateRequest) (*res.BoardTemplateResponse, error) {
+	user, err := u.userRepo.GetUserByID(userId)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "사용자 조회 실패", err)
+	}
+
+	companyID := userCompanyID(user)
+	if companyID == 0 {
+		return nil, common.NewError(http.StatusForbidden, "회사에 소속된 사용자만 보드 템플릿을 만들 수 있습니다.", nil)
+	}
+
+	template := &entity.BoardTemplate{
+		CompanyID:   companyID,
+		Name:        strings.TrimSpace(request.Name),
+		Description: request.Description,
+		CreatedBy:   userId,
+	}
+	if template.Name == "" {
+		return nil, common.NewError(http.StatusBadRequest, "템플릿 이름이 필요합니다.", nil)
+	}
+
+	if len(request.Columns) == 0 || len(request.Columns) > entity.MaxBoardTemplateColumns {
+		return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("템플릿 컬럼은 1개 이상 %d개 이하여야 합니다.", entity.MaxBoardTemplateColumns), nil)
+	}
+	if len(request.Labels) > entity.MaxBoardTemplateLabels {
+		return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("템플릿 라벨은 %d개를 넘을 수 없습니다.", entity.MaxBoardTemplateLabels), nil)
+	}
+	if len(request.Cards) > entity.MaxBoardTemplateCards {
+		return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("템플릿 카드는 %d개를 넘을 수 없습니다.", entity.MaxBoardTemplateCards), nil)
+	}
+
+	template.Columns = make([]entity.BoardTemplateColumn, len(request.Columns))
+	for i, column := range request.Columns {
+		name := strings.TrimSpace(column.Name)
+		if name == "" {
+			return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 컬럼의 이름이 없습니다.", i+1), nil)
+		}
+		template.Columns[i] = entity.BoardTemplateColumn{
+			ID:       uuid.New(),
+			Name:     name,
+			Position: uint(i),
+			WipLimit: column.WipLimit,
+		}
+	}
+
+	template.Labels = make([]entity.BoardTemplateLabel, len(request.Labels))
+	for i, label := range request.Labels {
+		name := strings.TrimSpace(label.Name)
+		if name == "" {
+			return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 라벨의 이름이 없습니다.", i+1), nil)
+		}
+		if !labelColorPattern.MatchString(label.Color) {
+			return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 라벨의 색상은 #RRGGBB 형식이어야 합니다.", i+1), nil)
+		}
+		template.Labels[i] = entity.BoardTemplateLabel{
+			ID:    uuid.New(),
+			Name:  name,
+			Color: label.Color,
+		}
+	}
+
+	// 카드 위치는 컬럼별로 요청 순서대로
+	cardPositions := make([]uint, len(template.Columns))
+	template.Cards = make([]entity.BoardTemplateCard, len(request.Cards))
+	for i, card := range request.Cards {
+		name := strings.TrimSpace(card.Name)
+		if name == "" {
+			return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 카드의 이름이 없습니다.", i+1), nil)
+		}
+		if card.ColumnIndex < 0 || card.ColumnIndex >= len(template.Columns) {
+			return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 카드의 column_index가 올바르지 않습니다.", i+1), nil)
+		}
+
+		labels := make([]uuid.UUID, 0, len(card.LabelIndexes))
+		for _, labelIndex := range card.LabelIndexes {
+			if labelIndex < 0 || labelIndex >= len(template.Labels) {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 카드의 label_indexes가 올바르지 않습니다.", i+1), nil)
+			}
+			labels = append(labels, template.Labels[labelIndex].ID)
+		}
+
+		template.Cards[i] = entity.BoardTemplateCard{
+			ID:       uuid.New(),
+			ColumnID: template.Columns[card.ColumnIndex].ID,
+			Name:     name,
+			Content:  card.Content,
+			Position: cardPositions[card.ColumnIndex],
+			Labels:   uniqueUUIDs(labels),
+		}
+		cardPositions[card.ColumnIndex]++
+	}
+
+	if err := u.boardRepo.CreateBoardTemplate(template); err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "보드 템플릿 생성 실패", err)
+	}
+
+	response := toBoardTemplateResponse(*template)
+	return &response, nil
+}
+
+// 기존 보드의 컬럼, WIP 제한, 라벨 (include_cards면 카드까지)을 템플릿으로 저장
+func (u *boardUsecase) SaveBoardAsTemplate(userId uint, projectID uint, boardID uint, request *req.SaveBoardTemplateRequest) (*res.BoardTemplateResponse, error) {
+	user, err := u.userRepo.GetUserByID(userId)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "사용자 조회 실패", err)
+	}
+
+	companyID := userCompanyID(user)
+	if companyID == 0 {
+		return nil, common.NewError(http.StatusForbidden, "회사에 소속된 사용자만 보드 템플릿을 만들 수 있습니다.", nil)
+	}
+
+	board, err := u.boardRepo.GetBoardByID(boardID)
+	if err != nil {
+		return nil, common.NewError(http.StatusNotFound, "보드를 찾을 수 없습니다.", err)
+	}
+
+	if board.ProjectID != projectID {
+		return nil, common.NewError(http.StatusBadRequest, "프로젝트에 속한 보드가 아닙니다.", nil)
+	}
+
+	project, err := u.projectRepo.GetProjectByID(userId, projectID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "프로젝트 조회 실패", err)
+	}
+
+	// 다른 회사 프로젝트의 보드를 템플릿으로 가져갈 수 없음
+	if project.CompanyID != 0 && project.CompanyID != companyID {
+		return nil, common.NewError(http.StatusForbidden, "다른 회사 프로젝트의 보드는 템플릿으로 저장할 수 없습니다.", nil)
+	}
+
+	role, err := u.boardRepo.CheckBoardUserRole(boardID, userId)
+	if err != nil {
+		return nil, common.NewError(http.StatusForbidden, "해당 보드에 접근할 수 없습니다.", err)
+	}
+
+	if role < entity.BoardRoleMaintainer {
+		return nil, common.NewError(http.StatusForbidden, "해당 보드의 수정 권한이 없습니다.", nil)
+	}
+
+	content, err := u.boardRepo.GetBoardContent(boardID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "보드 내용 조회 실패", err)
+	}
+
+	template := &entity.BoardTemplate{
+		CompanyID:   companyID,
+		Name:        strings.TrimSpace(request.Name),
+		Description: request.Description,
+		CreatedBy:   userId,
+	}
+	if template.Name == "" {
+		return nil, common.NewError(http.StatusBadRequest, "템플릿 이름이 필요합니다.", nil)
+	}
+
+	if len(content.Columns) == 0 {
+		return nil, common.NewError(http.StatusBadRequest, "컬럼이 없는 보드는 템플릿으로 저장할 수 없습니다.", nil)
+	}
+	if len(content.Columns) > entity.MaxBoardTemplateColumns {
+		return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("템플릿 컬럼은 %d개를 넘을 수 없습니다.", entity.MaxBoardTemplateColumns), nil)
+	}
+	if len(content.Labels) > entity.MaxBoardTemplateLabels {
+		return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("템플릿 라벨은 %d개를 넘을 수 없습니다.", entity.MaxBoardTemplateLabels), nil)
+	}
+
+	labelIDs := make(map[uuid.UUID]uuid.UUID, len(content.Labels))
+	template.Labels = make([]entity.BoardTemplateLabel, len(content.Labels))
+	for i, label := range content.Labels {
+		labelIDs[label.ID] = uuid.New()
+		template.Labels[i] = entity.BoardTemplateLabel{
+			ID:    labelIDs[label.ID],
+			Name:  label.Name,
+			Color: label.Color,
+		}
+	}
+
+	template.Columns = make([]entity.BoardTemplateColumn, len(content.Columns))
+	for i, column := range content.Columns {
+		template.Columns[i] = entity.BoardTemplateColumn{
+			ID:       uuid.New(),
+			Name:     column.Name,
+			Position: uint(i),
+			WipLimit: column.WipLimit,
+		}
+		if !request.IncludeCards {
+			continue
+		}
+
+		for j, card := range column.Cards {
+			labels := make([]uuid.UUID, 0, len(card.Labels))
+			for _, labelID := range card.Labels {
+				if templateLabelID, ok := labelIDs[labelID]; ok {
+					labels = append(labels, templateLabelID)
+				}
+			}
+			template.Cards = append(template.Cards, entity.BoardTemplateCard{
+				ID:       uuid.New(),
+				ColumnID: template.Columns[i].ID,
+				Name:     card.Name,
+				Content:  card.Content,
+				Position: uint(j),
+				Labels:   labels,
+			})
+		}
+	}
+	if len(template.Cards) > entity.MaxBoardTemplateCards {
+		return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("템플릿 카드는 %d개를 넘을 수 없습니다.", entity.MaxBoardTemplateCards), nil)
+	}
+
+	if err := u.boardRepo.CreateBoardTemplate(template); err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "보드 템플릿 생성 실패", err)
+	}
+
+	response := toBoardTemplateResponse(*template)
+	return &response, nil
+}
+
+// 템플릿 삭제는 만든 사용자 또는 회사 관리자만 가능 (이미 템플릿으로 만든 보드는 그대로)
+func (u *boardUsecase) DeleteBoardTemplate(userId uint, templateID uint) error {
+	user, err := u.userRepo.GetUserByID(userId)
+	if err != nil {
+		return common.NewError(http.StatusInternalServerError, "사용자 조회 실패", err)
+	}
+
+	template, err := u.getCompanyBoardTemplate(user, templateID)
+	if err != nil {
+		return err
+	}
+
+	if template.CreatedBy != userId && user.Role > _userEntity.RoleCompanySubManager {
+		return common.NewError(http.StatusForbidden, "보드 템플릿 삭제 권한이 없습니다.", nil)
+	}
+
+	if err := u.boardRepo.DeleteBoardTemplate(templateID); err != nil {
+		return common.NewError(http.StatusInternalServerError, "보드 템플릿 삭제 실패", err)
+	}
+
+	return nil
+}
+
+// 보드를 다른 프로젝트(같은 프로젝트도 가능)로 복사
+// 컬럼, 카드, 라벨, 체크리스트, 첨부 파일은 새 UUID로 복사하고 댓글, 활동 기록은 복사하지 않음
+func (u *boardUsecase) CopyBoard(userId uint, projectID uint, boardID uint, request *req.CopyBoardRequest) (*res.CopyBoardResponse, error) {
+	user, err := u.userRepo.GetUserByID(userId)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "사용자 조회 실패", err)
+	}
+
+	sourceBoard, err := u.boardRepo.GetBoardByID(boardID)
+	if err != nil {
+		return nil, common.NewError(http.StatusNotFound, "보드를 찾을 수 없습니다.", err)
+	}
+
+	if sourceBoard.ProjectID != projectID {
+		return nil, common.NewError(http.StatusBadRequest, "프로젝트에 속한 보드가 아닙니다.", nil)
+	}
+
+	role, err := u.boardRepo.CheckBoardUserRole(boardID, userId)
+	if err != nil {
+		return nil, common.NewError(http.StatusForbidden, "해당 보드에 접근할 수 없습니다.", err)
+	}
+
+	if role < entity.BoardRoleMaintainer {
+		return nil, common.NewError(http.StatusForbidden, "해당 보드의 복사 권한이 없습니다.", nil)
+	}
+
+	sourceProject, err := u.projectRepo.GetProjectByID(userId, projectID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "프로젝트 조회 실패", err)
+	}
+
+	targetProject, err := u.projectRepo.GetProjectByID(userId, request.ProjectID)
+	if err != nil {
+		return nil, common.NewError(http.StatusForbidden, "복사할 프로젝트에 접근할 수 없습니다.", err)
+	}
+
+	if sourceProject.CompanyID != targetProject.CompanyID {
+		return nil, common.NewError(http.StatusBadRequest, "같은 회사의 프로젝트로만 복사할 수 있습니다.", nil)
+	}
+
+	boardUsers, err := u.newBoardUsers(userId, request.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := u.boardRepo.GetBoardContent(boardID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "보드 내용 조회 실패", err)
+	}
+
+	// 담당자는 대상 프로젝트에 있는 사용자만 유지
+	var assignees map[uint]bool
+	if request.KeepAssignees {
+		assignees = make(map[uint]bool, len(boardUsers))
+		for _, boardUser := range boardUsers {
+			assignees[boardUser.UserID] = true
+		}
+	}
+
+	title := strings.TrimSpace(request.Title)
+	if title == "" {
+		title = sourceBoard.Title + " (복사본)"
+	}
+
+	board := entity.Board{
+		Title:     title,
+		ProjectID: request.ProjectID,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	if err := u.boardRepo.CreateBoard(&board, boardUsers, copyBoardContent(content, assignees)); err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "보드 복사 실패", err)
+	}
+
+	if err := u.publishBoardCreateEvent(user, &board); err != nil {
+		return nil, err
+	}
+
+	return &res.CopyBoardResponse{
+		BoardID:   board.ID,
+		Title:     board.Title,
+		ProjectID: board.ProjectID,
+		CreatedAt: board.CreatedAt,
+	}, nil
+}
+
+// 사용자 회사의 템플릿만 조회 가능
+func (u *boardUsecase) getCompanyBoardTemplate(user *_userEntity.User, templateID uint) (*entity.BoardTemplate, error) {
+	companyID := userCompanyID(user)
+	if companyID == 0 {
+		return nil, common.NewError(http.StatusForbidden, "회사에 소속된 사용자만 보드 템플릿을 사용할 수 있습니다.", nil)
+	}
+
+	template, err := u.boardRepo.GetBoardTemplateByID(templateID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "보드 템플릿 조회 실패", err)
+	}
+
+	if template == nil || template.CompanyID != companyID {
+		return nil, common.NewError(http.StatusNotFound, "보드 템플릿을 찾을 수 없습니다.", nil)
+	}
+
+	return template, nil
+}
+
+// 회사에 소속되지 않았으면 0
+func userCompanyID(user *_userEntity.User) uint {
+	if user.UserProfile == nil || user.UserProfile.CompanyID == nil {
+		return 0
+	}
+	return *user.UserProfile.CompanyID
+}
+
+func defaultBoardContent() *entity.BoardContent {
+	content := &entity.BoardContent{
+		Columns: make([]entity.BoardColumn, len(defaultBoardColumnNames)),
+	}
+	for i, name := range defaultBoardColumnNames {
+		content.Columns[i] = entity.BoardColumn{
+			ID:   uuid.New(),
+			Name: name,
+		}
+	}
+	return content
+}
+
+// 템플릿으로 보드 내용 생성 - 예시 카드는 일정, 담당자 없이 생성
+func boardContentFromTemplate(template *entity.BoardTemplate) *entity.BoardContent {
+	content := &entity.BoardContent{
+		Columns: make([]entity.BoardColumn, len(template.Columns)),
+		Labels:  make([]entity.BoardLabel, len(template.Labels)),
+	}
+
+	labelIDs := make(map[uuid.UUID]uuid.UUID, len(template.Labels))
+	for i, label := range template.Labels {
+		labelIDs[label.ID] = uuid.New()
+		content.Labels[i] = entity.BoardLabel{
+			ID:    labelIDs[label.ID],
+			Name:  label.Name,
+			Color: label.Color,
+		}
+	}
+
+	columnIndexes := make(map[uuid.UUID]int, len(template.Columns))
+	for i, column := range template.Columns {
+		columnIndexes[column.ID] = i
+		content.Columns[i] = entity.BoardColumn{
+			ID:       uuid.New(),
+			Name:     column.Name,
+			WipLimit: column.WipLimit,
+		}
+	}
+
+	// 템플릿 카드는 Position 순서로 조회되므로 컬럼별로 순서대로 쌓으면 됨
+	for _, card := range template.Cards {
+		columnIndex, ok := columnIndexes[card.ColumnID]
+		if !ok {
+			continue
+		}
+
+		labels := make([]uuid.UUID, 0, len(card.Labels))
+		for _, labelID := range card.Labels {
+			if boardLabelID, ok := labelIDs[labelID]; ok {
+				labels = append(labels, boardLabelID)
+			}
+		}
+		content.Columns[columnIndex].Cards = append(content.Columns[columnIndex].Cards, entity.BoardCard{
+			ID:      uuid.New(),
+			Name:    card.Name,
+			Content: card.Content,
+			Labels:  labels,
+		})
+	}
+
+	return content
+}
+
+// 보드 내용을 새 UUID로 복사 - 카드의 컬럼/라벨, 체크리스트/첨부 파일의 카드도 새 ID로 바꿈
+// assignees가 nil이면 담당자는 비우고, 있으면 그 안에 있는 사용자만 유지
+func copyBoardContent(content *entity.BoardContent, assignees map[uint]bool) *entity.BoardContent {
+	copied := &entity.BoardContent{
+		Columns: make([]entity.BoardColumn, len(content.Columns)),
+		Labels:  make([]entity.BoardLabel, len(content.Labels)),
+	}
+
+	labelIDs := make(map[uuid.UUID]uuid.UUID, len(content.Labels))
+	for i, label := range content.Labels {
+		labelIDs[label.ID] = uuid.New()
+		copied.Labels[i] = entity.BoardLabel{
+			ID:    labelIDs[label.ID],
+			Name:  label.Name,
+			Color: label.Color,
+		}
+	}
+
+	cardIDs := make(map[uuid.UUID]uuid.UUID)
+	for i, column := range content.Columns {
+		copied.Columns[i] = entity.BoardColumn{
+			ID:       uuid.New(),
+			Name:     column.Name,
+			WipLimit: column.WipLimit,
+			Cards:    make([]entity.BoardCard, len(column.Cards)),
+		}
+		for j, card := range column.Cards {
+			cardIDs[card.ID] = uuid.New()
+			copiedCard := entity.BoardCard{
+				ID:        cardIDs[card.ID],
+				Name:      card.Name,
+				Content:   card.Content,
+				StartDate: card.StartDate,
+				EndDate:   card.EndDate,
+			}
+			for _, userID := range card.Assignees {
+				if assignees[userID] {
+					copiedCard.Assignees = append(copiedCard.Assignees, userID)
+				}
+			}
+			for _, labelID := range card.Labels {
+				if copiedLabelID, ok := labelIDs[labelID]; ok {
+					copiedCard.Labels = append(copiedCard.Labels, copiedLabelID)
+				}
+			}
+			copied.Columns[i].Cards[j] = copiedCard
+		}
+	}
+
+	for _, checklist := range content.Checklists {
+		cardID, ok := cardIDs[checklist.CardID]
+		if !ok {
+			continue
+		}
+		copiedChecklist := entity.BoardCardChecklist{
+			ID:       uuid.New(),
+			CardID:   cardID,
+			Name:     checklist.Name,
+			Position: checklist.Position,
+			Items:    make([]entity.BoardCardChecklistItem, len(checklist.Items)),
+		}
+		for i, item := range checklist.Items {
+			copiedChecklist.Items[i] = entity.BoardCardChecklistItem{
+				ID:       uuid.New(),
+				Content:  item.Content,
+				Checked:  item.Checked,
+				Position: item.Position,
+			}
+		}
+		copied.Checklists = append(copied.Checklists, copiedChecklist)
+	}
+
+	// 첨부 파일은 같은 파일을 가리키도록 복사
+	for _, attachment := range content.Attachments {
+		cardID, ok := cardIDs[attachment.CardID]
+		if !ok {
+			continue
+		}
+		copied.Attachments = append(copied.Attachments, entity.BoardCardAttachment{
+			ID:          uuid.New(),
+			CardID:      cardID,
+			URL:         attachment.URL,
+			Name:        attachment.Name,
+			Size:        attachment.Size,
+			ContentType: attachment.ContentType,
+			UploadedBy:  attachment.UploadedBy,
+		})
+	}
+
+	return copied
+}
+
+func toBoardTemplateResponse(template entity.BoardTemplate) res.BoardTemplateResponse {
+	response := res.BoardTemplateResponse{
+		ID:          template.ID,
+		CompanyID:   template.CompanyID,
+		Name:        template.Name,
+		Description: template.Description,
+		CreatedBy:   template.CreatedBy,
+		Columns:     make([]res.BoardTemplateColumnResponse, len(template.Columns)),
+		Labels:      make([]res.BoardTemplateLabelResponse, len(template.Labels)),
+		Cards:       make([]res.BoardTemplateCardResponse, len(template.Cards)),
+		CreatedAt:   template.CreatedAt,
+		UpdatedAt:   template.UpdatedAt,
+	}
+	for i, column := range template.Columns {
+		response.Columns[i] = res.BoardTemplateColumnResponse{
+			ID:       column.ID,
+			Name:     column.Name,
+			Position: column.Position,
+			WipLimit: column.WipLimit,
+		}
+	}
+	for i, label := range template.Labels {
+		response.Labels[i] = res.BoardTemplateLabelResponse{
+			ID:    label.ID,
+			Name:  label.Name,
+			Color: label.Color,
+		}
+	}
+	for i, card := range template.Cards {
+		response.Cards[i] = res.BoardTemplateCardResponse{
+			ID:       card.ID,
+			ColumnID: card.ColumnID,
+			Name:     card.Name,
+			Content:  card.Content,
+			Position: card.Position,
+			Labels:   card.Labels,
+		}
+	}
+	return response
+}
@@ -2,8 +2,10 @@ package usecase
 
 import (
 	"encoding/json"
+	"fmt"
 	"link/internal/board/entity"
 	_boardRepo "link/internal/board/repository"
+	_notificationUsecase "link/internal/notification/usecase"
 	_projectRepo "link/internal/project/repository"
 	_userEntity "link/internal/user/entity"
 	_userRepo "link/internal/user/repository"
@@ -13,6 +15,7 @@ import (
 	"link/pkg/dto/res"
 	"log"
 	"net/http"
+	"strings"
 	"time"
 
 	_nats "link/pkg/nats"
@@ -27,33 +30,48 @@ type BoardUsecase interface {
 	UpdateBoard(userId uint, boardID uint, request *req.UpdateBoardRequest) error
 	DeleteBoard(userId uint, boardID uint) error
 
-	AutoSaveBoard(userId uint, projectID uint, boardID uint, request *req.BoardStateUpdateReqeust) error
+	AutoSaveBoard(userId uint, projectID uint, boardID uint, request *req.BoardStateUpdateReqeust) (*res.BoardStateUpdateResponse, error)
 	GetKanbanBoard(userId uint, boardID uint) (*res.GetKanbanBoardResponse, error)
+
+	GetBoardActivities(userId uint, boardID uint, queryParams *req.GetBoardActivitiesQueryParams) (*res.GetBoardActivitiesResponse, error)
+	GetCardActivities(userId uint, cardID uuid.UUID, queryParams *req.GetBoardActivitiesQueryParams) (*res.GetBoardActivitiesResponse, error)
+
+	UploadBoardAttachments(userId uint, projectID uint, boardID uint, files []req.BoardAttachmentRequest) (*res.UploadBoardAttachmentsResponse, error)
+
+	GetBoardTemplates(userId uint) (*res.GetBoardTemplatesResponse, error)
+	GetBoardTemplate(userId uint, templateID uint) (*res.BoardTemplateResponse, error)
+	CreateBoardTemplate(userId uint, request *req.CreateBoardTemplateRequest) (*res.BoardTemplateResponse, error)
+	SaveBoardAsTemplate(userId uint, projectID uint, boardID uint, request *req.SaveBoardTemplateRequest) (*res.BoardTemplateResponse, error)
+	DeleteBoardTemplate(userId uint, templateID uint) error
+	CopyBoard(userId uint, projectID uint, boardID uint, request *req.CopyBoardRequest) (*res.CopyBoardResponse, error)
 }
 
 type boardUsecase struct {
-	boardRepo     _boardRepo.BoardRepository
-	userRepo      _userRepo.UserRepository
-	projectRepo   _projectRepo.ProjectRepository
-	natsPublisher *_nats.NatsPublisher
+	boardRepo           _boardRepo.BoardRepository
+	userRepo            _userRepo.UserRepository
+	projectRepo         _projectRepo.ProjectRepository
+	notificationUsecase _notificationUsecase.NotificationUsecase
+	natsPublisher       *_nats.NatsPublisher
 }
 
 func NewBoardUsecase(
 	boardRepo _boardRepo.BoardRepository,
 	userRepo _userRepo.UserRepository,
 	projectRepo _projectRepo.ProjectRepository,
+	notificationUsecase _notificationUsecase.NotificationUsecase,
 	natsPublisher *_nats.NatsPublisher) BoardUsecase {
 	return &boardUsecase{
-		boardRepo:     boardRepo,
-		userRepo:      userRepo,
-		projectRepo:   projectRepo,
-		natsPublisher: natsPublisher,
+		boardRepo:           boardRepo,
+		userRepo:            userRepo,
+		projectRepo:         projectRepo,
+		notificationUsecase: notificationUsecase,
+		natsPublisher:       natsPublisher,
 	}
 }
 
 // ! 보드 관련
 func (u *boardUsecase) CreateBoard(userId uint, request *req.CreateBoardRequest) error {
-	_, err := u.userRepo.GetUserByID(userId)
+	user, err := u.userRepo.GetUserByID(userId)
 	if err != nil {
 		return common.NewError(http.StatusBadRequest, "사용자 조회 실패", err)
 	}
@@ -66,16 +84,43 @@ func (u *boardUsecase) CreateBoard(userId uint, request *req.CreateBoardRequest)
 		return common.NewError(http.StatusForbidden, "프로젝트 접근 권한 없음", nil)
 	}
 
+	// 템플릿을 고르면 템플릿의 컬럼, 라벨, 예시 카드로, 아니면 기본 컬럼으로 생성
+	content := defaultBoardContent()
+	if request.TemplateID != nil {
+		template, err := u.getCompanyBoardTemplate(user, *request.TemplateID)
+		if err != nil {
+			return err
+		}
+		if hasAcess.CompanyID != 0 && hasAcess.CompanyID != template.CompanyID {
+			return common.NewError(http.StatusBadRequest, "다른 회사의 보드 템플릿은 사용할 수 없습니다.", nil)
+		}
+		content = boardContentFromTemplate(template)
+	}
+
 	board := entity.Board{
 		Title:     request.Title,
 		ProjectID: request.ProjectID,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
-	//userId를 제외한 나머지 projectUser들의 boardRole을 0으로 변경
-	projectUsers, err := u.projectRepo.GetProjectUsers(request.ProjectID)
+
+	boardUsers, err := u.newBoardUsers(userId, request.ProjectID)
 	if err != nil {
-		return common.NewError(http.StatusInternalServerError, "프로젝트 사용자 조회 실패", err)
+		return err
+	}
+
+	if err := u.boardRepo.CreateBoard(&board, boardUsers, content); err != nil {
+		return common.NewError(http.StatusInternalServerError, "보드 생성 실패", err)
+	}
+
+	return u.publishBoardCreateEvent(user, &board)
+}
+
+// 프로젝트 사용자를 보드 사용자로 - userId(생성자)는 관리자, 나머지는 일반 사용자
+func (u *boardUsecase) newBoardUsers(userId uint, projectID uint) ([]entity.BoardUser, error) {
+	projectUsers, err := u.projectRepo.GetProjectUsers(projectID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "프로젝트 사용자 조회 실패", err)
 	}
 
 	boardUsers := make([]entity.BoardUser, 0, len(projectUsers))
@@ -86,49 +131,15 @@ func (u *boardUsecase) CreateBoard(userId uint, request *req.CreateBoardRequest)
 		}
 
 		boardUsers = append(boardUsers, entity.BoardUser{
-			UserID:  projectUser.UserID,
-			Role:    role,
-			BoardID: board.ID,
+			UserID: projectUser.UserID,
+			Role:   role,
 		})
 	}
 
-	if err := u.boardRepo.CreateBoard(&board, boardUsers); err != nil {
-		return common.NewError(http.StatusInternalServerError, "보드 생성 실패", err)
-	}
-
-	//TODO 더미로 생성 이후 삭제 필요
-	defaultColums := []entity.BoardColumn{ // 일단 더미로 생성
-		{
-			ID:        uuid.New(),
-			Name:      "To Do",
-			BoardID:   board.ID,
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-		},
-		{
-			ID:        uuid.New(),
-			Name:      "In Progress",
-			BoardID:   board.ID,
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-		},
-		{
-			ID:        uuid.New(),
-			Name:      "Done",
-			BoardID:   board.ID,
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-		},
-	}
-
-	for _, column := range defaultColums {
-		if err := u.boardRepo.CreateBoardColumn(&column); err != nil {
-			return common.NewError(http.StatusInternalServerError, "보드 컬럼 생성 실패", err)
-		}
-	}
-
-	user, _ := u.userRepo.GetUserByID(userId)
+	return boardUsers, nil
+}
 
+func (u *boardUsecase) publishBoardCreateEvent(user *_userEntity.User, board *entity.Board) error {
 	//mongoDB 에 로그성 데이터는 nats로 전송
 	docID := uuid.New().String()
 
@@ -331,335 +342,436 @@ func (u *boardUsecase) DeleteBoard(userId uint, boardID uint) error {
 	return nil
 }
 
-func (u *boardUsecase) AutoSaveBoard(userId uint, projectID uint, boardID uint, request *req.BoardStateUpdateReqeust) error {
+func (u *boardUsecase) AutoSaveBoard(userId uint, projectID uint, boardID uint, request *req.BoardStateUpdateReqeust) (*res.BoardStateUpdateResponse, error) {
 	user, err := u.userRepo.GetUserByID(userId)
 	if err != nil {
-		return common.NewError(http.StatusInternalServerError, "사용자 조회 실패", err)
+		return nil, common.NewError(http.StatusInternalServerError, "사용자 조회 실패", err)
 	}
 
 	_, err = u.projectRepo.GetProjectByID(userId, projectID)
 	if err != nil {
-		return common.NewError(http.StatusInternalServerError, "프로젝트 조회 실패", err)
+		return nil, common.NewError(http.StatusInternalServerError, "프로젝트 조회 실패", err)
 	}
 
-	_, err = u.boardRepo.GetBoardByID(boardID)
+	board, err := u.boardRepo.GetBoardByID(boardID)
 	if err != nil {
-		return common.NewError(http.StatusInternalServerError, "보드 조회 실패", err)
+		return nil, common.NewError(http.StatusInternalServerError, "보드 조회 실패", err)
 	}
 
-	_, err = u.boardRepo.GetBoardUsersByBoardID(boardID)
-	if err != nil {
-		return common.NewError(http.StatusInternalServerError, "보드 사용자 조회 실패", err)
+	if board.ProjectID != projectID {
+		return nil, common.NewError(http.StatusBadRequest, "프로젝트에 속한 보드가 아닙니다.", nil)
 	}
 
 	role, err := u.boardRepo.CheckBoardUserRole(boardID, userId)
 	if err != nil {
-		return common.NewError(http.StatusInternalServerError, "보드 사용자 권한 조회 실패", err)
+		return nil, common.NewError(http.StatusInternalServerError, "보드 사용자 권한 조회 실패", err)
 	}
 
 	if role < entity.BoardRoleMaintainer {
-		return common.NewError(http.StatusForbidden, "해당 보드의 수정 권한이 없습니다.", nil)
+		return nil, common.NewError(http.StatusForbidden, "해당 보드의 수정 권한이 없습니다.", nil)
 	}
 
-	if request.Changes == nil {
-		return common.NewError(http.StatusBadRequest, "변경사항이 없습니다.", nil)
+	if len(request.Changes) == 0 {
+		return nil, common.NewError(http.StatusBadRequest, "변경사항이 없습니다.", nil)
 	}
 
-	for _, change := range request.Changes {
-		switch change.Type {
-		case "column":
-			if change.Action == "create" {
-
-				// 새로운 컬럼 생성
-				newColumn := entity.BoardColumn{
-					ID:        *change.ColumnID,
-					Name:      *change.Name,
-					BoardID:   boardID,
-					CreatedAt: time.Now(),
-					UpdatedAt: time.Now(),
-				}
-				if err := u.boardRepo.CreateBoardColumn(&newColumn); err != nil {
-					return common.NewError(http.StatusInternalServerError, "보드 컬럼 생성 실패", err)
-				}
-
-				natsData := map[string]interface{}{
-					"topic": "link.event.board.state.update",
-					"payload": map[string]interface{}{
-						"user_id":     userId,
-						"user_name":   *user.Name,
-						"project_id":  projectID,
-						"board_id":    boardID,
-						"target_type": "COLUMN",
-						"target_id":   *change.ColumnID,
-						"action":      "CREATE",
-						"timestamp":   time.Now(),
-					},
-				}
-
-				jsonData, err := json.Marshal(natsData)
-				if err != nil {
-					return common.NewError(http.StatusInternalServerError, "NATS 데이터 직렬화 실패", err)
-				}
-
-				go u.natsPublisher.PublishEvent("link.event.board.state.update", jsonData)
-
-			} else if change.Action == "update" {
-				// 컬럼 이름 변경
-				column, err := u.boardRepo.GetBoardColumnByID(*change.ColumnID)
-				if err != nil {
-					return common.NewError(http.StatusInternalServerError, "보드 컬럼 조회 실패", err)
-				}
-				if change.Name != nil {
-					column.Name = *change.Name
-				}
-				if err := u.boardRepo.UpdateBoardColumn(column); err != nil {
-					return common.NewError(http.StatusInternalServerError, "보드 컬럼 업데이트 실패", err)
-				}
-
-				natsData := map[string]interface{}{
-					"topic": "link.event.board.state.update",
-					"payload": map[string]interface{}{
-						"user_id":     userId,
-						"user_name":   *user.Name,
-						"project_id":  projectID,
-						"board_id":    boardID,
-						"target_type": "COLUMN",
-						"target_id":   *change.ColumnID,
-						"action":      "UPDATE",
-						"timestamp":   time.Now(),
-					},
-				}
-
-				jsonData, err := json.Marshal(natsData)
-				if err != nil {
-					return common.NewError(http.StatusInternalServerError, "NATS 데이터 직렬화 실패", err)
-				}
-
-				go u.natsPublisher.PublishEvent("link.event.board.state.update", jsonData)
+	changes, err := toBoardChanges(userId, boardID, request.Changes)
+	if err != nil {
+		return nil, err
+	}
 
-			} else if change.Action == "delete" {
-				// 컬럼 삭제
-				if err := u.boardRepo.DeleteBoardColumn(*change.ColumnID); err != nil {
-					return common.NewError(http.StatusInternalServerError, "보드 컬럼 삭제 실패", err)
-				}
+	if err := u.checkCardCommentChanges(userId, role, boardID, changes); err != nil {
+		return nil, err
+	}
+	if err := checkCardContentChanges(u.boardRepo, boardID, changes); err != nil {
+		return nil, err
+	}
 
-				natsData := map[string]interface{}{
-					"topic": "link.event.board.state.update",
-					"payload": map[string]interface{}{
-						"user_id":     userId,
-						"user_name":   *user.Name,
-						"project_id":  projectID,
-						"board_id":    boardID,
-						"target_type": "COLUMN",
-						"target_id":   *change.ColumnID,
-						"action":      "DELETE",
-						"timestamp":   time.Now(),
-					},
-				}
+	//TODO 변경사항 묶음 전체를 한 트랜잭션으로 적용 - 버전이 맞지 않는 수정/삭제는 conflict로 돌려줌
+	results, err := u.boardRepo.ApplyBoardChanges(boardID, changes)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "보드 상태 저장 실패", err)
+	}
 
-				jsonData, err := json.Marshal(natsData)
-				if err != nil {
-					return common.NewError(http.StatusInternalServerError, "NATS 데이터 직렬화 실패", err)
-				}
+	response := &res.BoardStateUpdateResponse{
+		Results: make([]res.BoardChangeResultResponse, len(results)),
+	}
+	events := make([]map[string]interface{}, 0, len(results))
+	for i, result := range results {
+		response.Results[i] = toBoardChangeResultResponse(result)
+		if result.Status == entity.BoardChangeConflict {
+			response.ConflictCount++
+			continue
+		}
+		response.AppliedCount++
 
-				go u.natsPublisher.PublishEvent("link.event.board.state.update", jsonData)
-
-			} else if change.Action == "move" {
-				// 컬럼 이동
-				if change.Position != nil {
-					if err := u.boardRepo.MoveBoardColumn(*change.ColumnID, *change.Position); err != nil {
-						return common.NewError(http.StatusInternalServerError, "보드 컬럼 이동 실패", err)
-					}
-
-					natsData := map[string]interface{}{
-						"topic": "link.event.board.state.update",
-						"payload": map[string]interface{}{
-							"user_id":     userId,
-							"user_name":   *user.Name,
-							"project_id":  projectID,
-							"board_id":    boardID,
-							"target_type": "COLUMN",
-							"target_id":   *change.ColumnID,
-							"action":      "MOVE",
-							"timestamp":   time.Now(),
-						},
-					}
-
-					jsonData, err := json.Marshal(natsData)
-					if err != nil {
-						return common.NewError(http.StatusInternalServerError, "NATS 데이터 직렬화 실패", err)
-					}
-
-					go u.natsPublisher.PublishEvent("link.event.board.state.update", jsonData)
-				}
-			}
-		case "card":
-			if change.Action == "create" {
+		event := map[string]interface{}{
+			"target_type": strings.ToUpper(result.Type),
+			"target_id":   result.TargetID,
+			"action":      strings.ToUpper(result.Action),
+		}
+		if column := response.Results[i].Column; column != nil {
+			event["column"] = column
+		}
+		if card := response.Results[i].Card; card != nil {
+			event["card"] = card
+		}
+		if label := response.Results[i].Label; label != nil {
+			event["label"] = label
+		}
+		if comment := response.Results[i].Comment; comment != nil {
+			event["comment"] = comment
+		}
+		if checklist := response.Results[i].Checklist; checklist != nil {
+			event["checklist"] = checklist
+		}
+		if checklistItem := response.Results[i].ChecklistItem; checklistItem != nil {
+			event["checklist_item"] = checklistItem
+		}
+		if attachment := response.Results[i].Attachment; attachment != nil {
+			event["attachment"] = attachment
+		}
+		events = append(events, event)
+	}
+
+	// 누가 무엇을 바꿨는지 활동 기록으로 남김 (카드/컬럼 변경 하나당 하나)
+	if len(events) > 0 {
+		u.saveBoardActivities(userId, *user.Name, projectID, boardID, results)
+	}
+
+	// 변경사항 묶음당 한 번만 전파
+	if len(events) > 0 {
+		natsData := map[string]interface{}{
+			"topic": "link.event.board.state.update",
+			"payload": map[string]interface{}{
+				"user_id":    userId,
+				"user_name":  *user.Name,
+				"project_id": projectID,
+				"board_id":   boardID,
+				"changes":    events,
+				"timestamp":  time.Now(),
+			},
+		}
 
-				column, err := u.boardRepo.GetBoardColumnByID(*change.ColumnID)
-				if err != nil || column == nil {
-					return common.NewError(http.StatusInternalServerError, "카드 생성 실패: 컬럼이 존재하지 않음", err)
-				}
-				// 새로운 카드 생성
-				newCard := entity.BoardCard{
-					ID:            change.CardID,
-					Name:          *change.Name,
-					Content:       *change.Content,
-					BoardID:       boardID,
-					BoardColumnID: *change.ColumnID,
-					Assignees:     change.Assignees,
-					CreatedAt:     time.Now(),
-					UpdatedAt:     time.Now(),
-				}
+		jsonData, err := json.Marshal(natsData)
+		if err != nil {
+			// 이미 저장은 끝났으므로 전파만 실패 처리
+			log.Printf("NATS 데이터 직렬화 실패: %v", err)
+		} else {
+			go u.natsPublisher.PublishEvent("link.event.board.state.update", jsonData)
+		}
+	}
 
-				if err := u.boardRepo.CreateBoardCard(&newCard); err != nil {
-					return common.NewError(http.StatusInternalServerError, "보드 카드 생성 실패", err)
-				}
+	// 댓글에서 새로 멘션된 사용자에게 알림
+	u.notifyCardCommentMentions(userId, boardID, results)
 
-				natsData := map[string]interface{}{
-					"topic": "link.event.board.state.update",
-					"payload": map[string]interface{}{
-						"user_id":     userId,
-						"user_name":   *user.Name,
-						"project_id":  projectID,
-						"board_id":    boardID,
-						"target_type": "CARD",
-						"target_id":   change.CardID,
-						"action":      "CREATE",
-						"timestamp":   time.Now(),
-					},
-				}
+	// 협업 편집 중인 카드의 내용이 바뀌었으면 편집 문서에도 반영
+	for _, result := range results {
+		if result.Type != "card" || result.Action != "update" || result.Status != entity.BoardChangeApplied ||
+			result.Card == nil || changes[result.Index].Content == nil {
+			continue
+		}
 
-				jsonData, err := json.Marshal(natsData)
-				if err != nil {
-					return common.NewError(http.StatusInternalServerError, "NATS 데이터 직렬화 실패", err)
-				}
+		payload, err := replaceCardDocumentContent(u.boardRepo, userId, result.TargetID, result.Card.Content)
+		if err != nil {
+			log.Printf("카드 문서 반영 실패: %v", err)
+			continue
+		}
+		if payload == nil {
+			continue
+		}
 
-				go u.natsPublisher.PublishEvent("link.event.board.state.update", jsonData)
-			} else if change.Action == "update" {
-				// 카드 업데이트
-				card, err := u.boardRepo.GetBoardCardByID(change.CardID)
-				if err != nil {
-					return common.NewError(http.StatusInternalServerError, "보드 카드 조회 실패", err)
-				}
-				if change.Name != nil {
-					card.Name = *change.Name
-				}
-				if change.Content != nil {
-					card.Content = *change.Content
-				}
+		jsonData, err := json.Marshal(map[string]interface{}{
+			"topic":   "link.event.board.card.doc",
+			"payload": payload,
+		})
+		if err != nil {
+			log.Printf("NATS 데이터 직렬화 실패: %v", err)
+			continue
+		}
+		go u.natsPublisher.PublishEvent("link.event.board.card.doc", jsonData)
+	}
 
-				if change.Assignees != nil {
-					card.Assignees = change.Assignees
-				}
+	return response, nil
+}
 
-				loc, err := time.LoadLocation("Asia/Seoul")
-				if err != nil {
-					log.Printf("시간대 로드 실패: %v", err)
-					return common.NewError(http.StatusBadRequest, "시간대 로드 실패", err)
-				}
-				if change.StartDate != nil {
-					startTime, err := time.ParseInLocation("2006-01-02 15:04:05", *change.StartDate, loc)
-					if err != nil {
-						log.Printf("시작일 파싱 실패: %v", err)
-						return common.NewError(http.StatusBadRequest, "시작일 파싱 실패", err)
-					}
-					card.StartDate = startTime
-				}
-				if change.EndDate != nil {
-					endTime, err := time.ParseInLocation("2006-01-02 15:04:05", *change.EndDate, loc)
-					if err != nil {
-						log.Printf("종료일 파싱 실패: %v", err)
-						return common.NewError(http.StatusBadRequest, "종료일 파싱 실패", err)
-					}
-					card.EndDate = endTime
-				}
+// 요청의 변경사항을 검증하고 엔티티로 변환 (날짜는 한국 시간 기준)
+func toBoardChanges(userId uint, boardID uint, requestChanges []req.Change) ([]entity.BoardChange, error) {
+	loc, err := time.LoadLocation("Asia/Seoul")
+	if err != nil {
+		log.Printf("시간대 로드 실패: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "시간대 로드 실패", err)
+	}
 
-				card.StartDate = time.Now()
-				card.EndDate = time.Now()
+	parseDate := func(value *string, field string) (*time.Time, error) {
+		if value == nil {
+			return nil, nil
+		}
+		parsed, err := time.ParseInLocation("2006-01-02 15:04:05", *value, loc)
+		if err != nil {
+			log.Printf("%s 파싱 실패: %v", field, err)
+			return nil, common.NewError(http.StatusBadRequest, field+" 파싱 실패", err)
+		}
+		return &parsed, nil
+	}
 
-				if err := u.boardRepo.UpdateBoardCard(card); err != nil {
-					return common.NewError(http.StatusInternalServerError, "보드 카드 업데이트 실패", err)
-				}
+	changes := make([]entity.BoardChange, len(requestChanges))
+	for i, change := range requestChanges {
+		if change.BoardID != 0 && change.BoardID != boardID {
+			return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항의 보드가 일치하지 않습니다.", i+1), nil)
+		}
 
-				natsData := map[string]interface{}{
-					"topic": "link.event.board.state.update",
-					"payload": map[string]interface{}{
-						"user_id":     userId,
-						"user_name":   *user.Name,
-						"project_id":  projectID,
-						"board_id":    boardID,
-						"target_type": "CARD",
-						"target_id":   change.CardID,
-						"action":      "UPDATE",
-						"timestamp":   time.Now(),
-					},
-				}
+		switch change.Action {
+		case "create", "update", "delete", "move":
+		default:
+			return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항의 action이 올바르지 않습니다.", i+1), nil)
+		}
 
-				jsonData, err := json.Marshal(natsData)
-				if err != nil {
-					return common.NewError(http.StatusInternalServerError, "NATS 데이터 직렬화 실패", err)
-				}
+		targetID := uuid.Nil
+		switch change.Type {
+		case "column":
+			if change.ColumnID == nil {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항에 컬럼 ID가 없습니다.", i+1), nil)
+			}
+			if change.Action == "create" && change.Name == nil {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항에 컬럼 이름이 없습니다.", i+1), nil)
+			}
+			if change.Action == "move" && change.Position == nil {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항에 이동할 위치가 없습니다.", i+1), nil)
+			}
+		case "card":
+			if change.CardID == uuid.Nil {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항에 카드 ID가 없습니다.", i+1), nil)
+			}
+			if change.Action == "create" && (change.ColumnID == nil || change.Name == nil) {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항에 컬럼 ID 또는 카드 이름이 없습니다.", i+1), nil)
+			}
+			if change.Action == "move" && change.ColumnID == nil && change.Position == nil {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항에 이동할 컬럼 또는 위치가 없습니다.", i+1), nil)
+			}
+		case "label":
+			if change.LabelID == nil {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항에 라벨 ID가 없습니다.", i+1), nil)
+			}
+			targetID = *change.LabelID
+			if change.Action == "move" {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항의 action이 올바르지 않습니다.", i+1), nil)
+			}
+			if change.Action == "create" && (change.Name == nil || change.Color == nil) {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항에 라벨 이름 또는 색상이 없습니다.", i+1), nil)
+			}
+			if change.Color != nil && !labelColorPattern.MatchString(*change.Color) {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항의 라벨 색상은 #RRGGBB 형식이어야 합니다.", i+1), nil)
+			}
+		case "comment":
+			if change.CommentID == nil {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항에 댓글 ID가 없습니다.", i+1), nil)
+			}
+			targetID = *change.CommentID
+			if change.Action == "move" {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항의 action이 올바르지 않습니다.", i+1), nil)
+			}
+			if change.Action == "create" && change.CardID == uuid.Nil {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항에 카드 ID가 없습니다.", i+1), nil)
+			}
+			if (change.Action == "create" || change.Content != nil) &&
+				(change.Content == nil || strings.TrimSpace(*change.Content) == "") {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항에 댓글 내용이 없습니다.", i+1), nil)
+			}
+			if change.Content != nil && len([]rune(*change.Content)) > entity.MaxCardCommentLength {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항의 댓글은 %d자를 넘을 수 없습니다.", i+1, entity.MaxCardCommentLength), nil)
+			}
+		case "checklist":
+			if change.ChecklistID == nil {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항에 체크리스트 ID가 없습니다.", i+1), nil)
+			}
+			targetID = *change.ChecklistID
+			if change.Action == "create" && (change.CardID == uuid.Nil || change.Name == nil) {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항에 카드 ID 또는 체크리스트 이름이 없습니다.", i+1), nil)
+			}
+			if change.Action == "move" && change.Position == nil {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항에 이동할 위치가 없습니다.", i+1), nil)
+			}
+		case "checklist_item":
+			if change.ItemID == nil {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항에 체크리스트 항목 ID가 없습니다.", i+1), nil)
+			}
+			targetID = *change.ItemID
+			if change.Action == "create" && (change.ChecklistID == nil || change.Content == nil) {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항에 체크리스트 ID 또는 항목 내용이 없습니다.", i+1), nil)
+			}
+			if change.Action == "move" && change.ChecklistID == nil && change.Position == nil {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항에 이동할 체크리스트 또는 위치가 없습니다.", i+1), nil)
+			}
+		case "attachment":
+			if change.AttachmentID == nil {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항에 첨부 파일 ID가 없습니다.", i+1), nil)
+			}
+			targetID = *change.AttachmentID
+			if change.Action == "move" {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항의 action이 올바르지 않습니다.", i+1), nil)
+			}
+			if change.Action == "create" && (change.CardID == uuid.Nil || change.URL == nil || change.Name == nil) {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항에 카드 ID, 파일 url 또는 파일 이름이 없습니다.", i+1), nil)
+			}
+			// 업로드 API로 올린 파일만 연결 가능
+			if change.URL != nil && (!strings.HasPrefix(*change.URL, BoardAttachmentURLPrefix+"/") || strings.Contains(*change.URL, "..")) {
+				return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항의 파일 url이 올바르지 않습니다.", i+1), nil)
+			}
+		default:
+			return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항의 type이 올바르지 않습니다.", i+1), nil)
+		}
 
-				go u.natsPublisher.PublishEvent("link.event.board.state.update", jsonData)
+		// 첨부 파일 외에는 버전으로 동시 수정을 확인하므로 수정/삭제에 버전 필수
+		if (change.Action == "update" || change.Action == "delete") && change.Type != "attachment" && change.Version == nil {
+			return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항에 버전이 없습니다.", i+1), nil)
+		}
+		if change.Type == "card" && change.Action == "update" && change.Content != nil && change.ContentVersion == nil {
+			return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("%d번째 변경사항에 내용 버전이 없습니다.", i+1), nil)
+		}
 
-			} else if change.Action == "delete" {
-				// 카드 삭제
-				if err := u.boardRepo.DeleteBoardCard(change.CardID); err != nil {
-					return common.NewError(http.StatusInternalServerError, "보드 카드 삭제 실패", err)
-				}
+		startDate, err := parseDate(change.StartDate, "시작일")
+		if err != nil {
+			return nil, err
+		}
+		endDate, err := parseDate(change.EndDate, "종료일")
+		if err != nil {
+			return nil, err
+		}
 
-				natsData := map[string]interface{}{
-					"topic": "link.event.board.state.update",
-					"payload": map[string]interface{}{
-						"user_id":     userId,
-						"user_name":   *user.Name,
-						"project_id":  projectID,
-						"board_id":    boardID,
-						"target_type": "CARD",
-						"target_id":   change.CardID,
-						"action":      "DELETE",
-						"timestamp":   time.Now(),
-					},
-				}
+		changes[i] = entity.BoardChange{
+			Type:      change.Type,
+			Action:    change.Action,
+			ColumnID:  change.ColumnID,
+			CardID:    change.CardID,
+			Position:  change.Position,
+			Name:      change.Name,
+			Content:   change.Content,
+			StartDate: startDate,
+			EndDate:   endDate,
+			Assignees: change.Assignees,
+			WipLimit:  change.WipLimit,
+
+			UserID:      userId,
+			TargetID:    targetID,
+			ParentID:    change.ParentID,
+			Labels:      uniqueUUIDs(change.Labels),
+			Mentions:    uniqueUserIDs(change.Mentions),
+			Color:       change.Color,
+			Checked:     change.Checked,
+			URL:         change.URL,
+			Size:        change.Size,
+			ContentType: change.ContentType,
+		}
+		if change.Type == "checklist_item" {
+			changes[i].ChecklistID = change.ChecklistID
+		}
+		if change.Version != nil {
+			version := int(*change.Version)
+			changes[i].Version = &version
+		}
+		if change.ContentVersion != nil {
+			contentVersion := int(*change.ContentVersion)
+			changes[i].ContentVersion = &contentVersion
+		}
+	}
 
-				jsonData, err := json.Marshal(natsData)
-				if err != nil {
-					return common.NewError(http.StatusInternalServerError, "NATS 데이터 직렬화 실패", err)
-				}
+	return changes, nil
+}
 
-				go u.natsPublisher.PublishEvent("link.event.board.state.update", jsonData)
+// 순서는 유지하고 중복만 제거 (nil이면 nil - 생략과 빈 배열을 구분)
+func uniqueUUIDs(ids []uuid.UUID) []uuid.UUID {
+	if ids == nil {
+		return nil
+	}
+	seen := make(map[uuid.UUID]bool, len(ids))
+	result := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result
+}
 
-			} else if change.Action == "move" {
-				if err := u.boardRepo.MoveBoardCard(change.CardID, change.ColumnID, change.Position); err != nil {
-					return common.NewError(http.StatusInternalServerError, "보드 카드 이동 실패", err)
-				}
+func uniqueUserIDs(ids []uint) []uint {
+	if ids == nil {
+		return nil
+	}
+	seen := make(map[uint]bool, len(ids))
+	result := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result
+}
 
-				natsData := map[string]interface{}{
-					"topic": "link.event.board.state.update",
-					"payload": map[string]interface{}{
-						"user_id":     userId,
-						"user_name":   *user.Name,
-						"project_id":  projectID,
-						"board_id":    boardID,
-						"target_type": "CARD",
-						"target_id":   change.CardID,
-						"action":      "MOVE",
-						"timestamp":   time.Now(),
-					},
-				}
+func toBoardChangeResultResponse(result entity.BoardChangeResult) res.BoardChangeResultResponse {
+	response := res.BoardChangeResultResponse{
+		Index:    result.Index,
+		Type:     result.Type,
+		Action:   result.Action,
+		TargetID: result.TargetID,
+		Status:   result.Status,
+	}
 
-				jsonData, err := json.Marshal(natsData)
-				if err != nil {
-					return common.NewError(http.StatusInternalServerError, "NATS 데이터 직렬화 실패", err)
-				}
+	if column := result.Column; column != nil {
+		response.Column = &res.GetKanbanBoardColumnResponse{
+			ID:        column.ID,
+			Name:      column.Name,
+			Position:  column.Position,
+			WipLimit:  column.WipLimit,
+			Version:   column.Version,
+			CreatedAt: column.CreatedAt,
+			UpdatedAt: column.UpdatedAt,
+		}
+	}
 
-				go u.natsPublisher.PublishEvent("link.event.board.state.update", jsonData)
-			}
+	if card := result.Card; card != nil {
+		response.Card = &res.GetKanbanBoardCardResponse{
+			ID:        card.ID,
+			ColumnID:  card.BoardColumnID,
+			Name:      card.Name,
+			Content:   card.Content,
+			Position:  card.Position,
+			StartDate: card.StartDate,
+			EndDate:   card.EndDate,
+			Assignees: card.Assignees,
+			Version:   card.Version,
+			CreatedAt: card.CreatedAt,
+			UpdatedAt: card.UpdatedAt,
+
+			ContentVersion: card.ContentVersion,
+			Labels:         card.Labels,
 		}
 	}
 
-	return nil
+	if label := result.Label; label != nil {
+		labelResponse := toBoardLabelResponse(*label)
+		response.Label = &labelResponse
+	}
+	if comment := result.Comment; comment != nil {
+		commentResponse := toBoardCardCommentResponse(*comment)
+		response.Comment = &commentResponse
+	}
+	if checklist := result.Checklist; checklist != nil {
+		checklistResponse := toBoardCardChecklistResponse(*checklist)
+		response.Checklist = &checklistResponse
+	}
+	if item := result.ChecklistItem; item != nil {
+		itemResponse := toBoardCardChecklistItemResponse(*item)
+		response.ChecklistItem = &itemResponse
+	}
+	if attachment := result.Attachment; attachment != nil {
+		attachmentResponse := toBoardCardAttachmentResponse(*attachment)
+		response.Attachment = &attachmentResponse
+	}
+
+	return response
 }
 
 // 칸반보드 렌더링 조회
@@ -689,6 +801,12 @@ func (u *boardUsecase) GetKanbanBoard(userId uint, boardID uint) (*res.GetKanban
 		return nil, common.NewError(http.StatusInternalServerError, "보드 컬럼 조회 실패", err)
 	}
 
+	// 카드 라벨, 댓글, 체크리스트, 첨부 파일은 보드 단위로 한 번에 조회
+	details, err := u.getBoardCardDetails(boardID)
+	if err != nil {
+		return nil, err
+	}
+
 	// 컬럼 응답 구성
 	columnsResponse := make([]res.GetKanbanBoardColumnResponse, len(columns))
 	for i, column := range columns {
@@ -709,6 +827,7 @@ func (u *boardUsecase) GetKanbanBoard(userId uint, boardID uint) (*res.GetKanban
 
 			cardsResponse[j] = res.GetKanbanBoardCardResponse{
 				ID:        card.ID,
+				ColumnID:  card.BoardColumnID,
 				Name:      card.Name,
 				Content:   card.Content,
 				Position:  card.Position,
@@ -717,17 +836,22 @@ func (u *boardUsecase) GetKanbanBoard(userId uint, boardID uint) (*res.GetKanban
 				Version:   card.Version,
 				CreatedAt: card.CreatedAt,
 				UpdatedAt: card.UpdatedAt,
+
+				ContentVersion: card.ContentVersion,
 			}
 
 			for _, assignee := range assignees {
 				cardsResponse[j].Assignees = append(cardsResponse[j].Assignees, assignee.UserID)
 			}
+			details.fill(&cardsResponse[j])
 		}
 
 		columnsResponse[i] = res.GetKanbanBoardColumnResponse{
 			ID:        column.ID,
 			Name:      column.Name,
 			Position:  column.Position,
+			WipLimit:  column.WipLimit,
+			Version:   column.Version,
 			Cards:     cardsResponse,
 			CreatedAt: column.CreatedAt,
 			UpdatedAt: column.UpdatedAt,
@@ -798,6 +922,7 @@ func (u *boardUsecase) GetKanbanBoard(userId uint, boardID uint) (*res.GetKanban
 		UpdatedAt:     board.UpdatedAt,
 		Columns:       columnsResponse,
 		BoardUsers:    usersResponse,
+		Labels:        details.labels,
 	}
 
 	return response, nil
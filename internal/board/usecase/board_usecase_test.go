@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"link/pkg/common"
+	"link/pkg/dto/req"
+)
+
+func stringPtr(v string) *string {
+	return &v
+}
+
+func TestToBoardChangesValidation(t *testing.T) {
+	cardID := uuid.New()
+	columnID := uuid.New()
+
+	tests := []struct {
+		name   string
+		change req.Change
+	}{
+		{"다른 보드", req.Change{Type: "card", Action: "update", BoardID: 2, CardID: cardID, Version: uintPtr(1)}},
+		{"잘못된 action", req.Change{Type: "card", Action: "rename", BoardID: 1, CardID: cardID, Version: uintPtr(1)}},
+		{"수정에 버전 없음", req.Change{Type: "card", Action: "update", BoardID: 1, CardID: cardID, Name: stringPtr("이름")}},
+		{"삭제에 버전 없음", req.Change{Type: "column", Action: "delete", BoardID: 1, ColumnID: &columnID}},
+		{"내용 수정에 내용 버전 없음", req.Change{Type: "card", Action: "update", BoardID: 1, CardID: cardID, Version: uintPtr(1), Content: stringPtr("내용")}},
+		{"이동할 위치 없음", req.Change{Type: "card", Action: "move", BoardID: 1, CardID: cardID}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := toBoardChanges(1, 1, []req.Change{tt.change})
+			var appError *common.AppError
+			if !errors.As(err, &appError) || appError.StatusCode != http.StatusBadRequest {
+				t.Fatalf("400 에러여야 합니다: %v", err)
+			}
+		})
+	}
+}
+
+func TestToBoardChangesVersions(t *testing.T) {
+	cardID := uuid.New()
+
+	changes, err := toBoardChanges(1, 1, []req.Change{
+		{Type: "card", Action: "update", BoardID: 1, CardID: cardID, Version: uintPtr(3), ContentVersion: uintPtr(7), Content: stringPtr("내용")},
+		{Type: "card", Action: "move", BoardID: 1, CardID: cardID, Position: uintPtr(0)},
+	})
+	if err != nil {
+		t.Fatalf("toBoardChanges 오류: %v", err)
+	}
+
+	if changes[0].Version == nil || *changes[0].Version != 3 {
+		t.Errorf("Version = %v, want 3", changes[0].Version)
+	}
+	if changes[0].ContentVersion == nil || *changes[0].ContentVersion != 7 {
+		t.Errorf("ContentVersion = %v, want 7", changes[0].ContentVersion)
+	}
+	// 이동은 버전 없이도 현재 위치 기준으로 적용
+	if changes[1].Version != nil {
+		t.Errorf("Version = %v, want nil", *changes[1].Version)
+	}
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	_boardRepo "link/internal/board/repository"
+	"link/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+const defaultCardDocumentSnapshotInterval = 30 * time.Second
+
+// CardDocumentSnapshotter redis에서 협업 편집 중인 카드 문서를 주기적으로 postgres에 저장
+// board_cards.content를 갱신하고 board_card_snapshots에 리비전별 스냅샷을 남김
+type CardDocumentSnapshotter struct {
+	boardRepo _boardRepo.BoardRepository
+	interval  time.Duration
+}
+
+func NewCardDocumentSnapshotter(boardRepo _boardRepo.BoardRepository) *CardDocumentSnapshotter {
+	interval := defaultCardDocumentSnapshotInterval
+	if value := os.Getenv("CARD_DOCUMENT_SNAPSHOT_INTERVAL"); value != "" {
+		if parsed, err := time.ParseDuration(value); err == nil && parsed > 0 {
+			interval = parsed
+		}
+	}
+
+	return &CardDocumentSnapshotter{boardRepo: boardRepo, interval: interval}
+}
+
+// Run 워커 시작 (main에서 고루틴으로 실행) - ctx가 끝나면 바뀐 문서를 저장하고 종료
+func (s *CardDocumentSnapshotter) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if err := s.Snapshot(); err != nil {
+				logger.LogError(fmt.Sprintf("종료 전 카드 문서 스냅샷 저장 실패: %v", err))
+			}
+			return
+		case <-ticker.C:
+			if err := s.Snapshot(); err != nil {
+				logger.LogError(fmt.Sprintf("카드 문서 스냅샷 저장 실패: %v", err))
+			}
+		}
+	}
+}
+
+// Snapshot 바뀐 문서를 가져와 저장하고, 실패 시 다음 주기에 다시 저장하도록 되돌림
+func (s *CardDocumentSnapshotter) Snapshot() error {
+	documents, err := s.boardRepo.DrainDirtyCardDocuments()
+	if err != nil {
+		return err
+	}
+
+	if len(documents) == 0 {
+		return nil
+	}
+
+	if err := s.boardRepo.SaveCardDocumentSnapshots(documents); err != nil {
+		cardIDs := make([]uuid.UUID, len(documents))
+		for i, document := range documents {
+			cardIDs[i] = document.CardID
+		}
+		if restoreErr := s.boardRepo.RestoreDirtyCardDocuments(cardIDs); restoreErr != nil {
+			return fmt.Errorf("%v (변경 표시 복구 실패: %v)", err, restoreErr)
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,459 @@
+package usecase
+
+import (
+	"encoding/json"
+	"fmt"
+	"link/internal/board/entity"
+	_boardRepo "link/internal/board/repository"
+	"link/pkg/common"
+	"link/pkg/dto/req"
+	"link/pkg/dto/res"
+	"link/pkg/util"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 다른 연산이 먼저 저장되면 다시 변환해서 저장 시도
+const maxCardOperationAttempts = 5
+
+// CardDocumentUsecase /ws/board 카드 내용 협업 편집 (ot.js 호환 OT)
+// 서버가 리비전 순서를 정하고, 클라이언트 연산은 그 사이에 적용된 연산들을 기준으로 변환해서 적용
+// 결과는 handler에서 WebSocketHub.BroadcastToBoard로 전파
+type CardDocumentUsecase interface {
+	OpenCardDocument(userId uint, boardID uint, cardID uuid.UUID, onlineUserIDs []uint) (*res.CardDocumentResponse, error)
+	ApplyCardOperation(userId uint, boardID uint, request *req.CardDocumentRequest) (*res.CardOperationPayload, error)
+	UpdateCardCursor(userId uint, userName string, boardID uint, request *req.CardDocumentRequest) (*res.CardCursorPayload, error)
+	CloseCardDocument(userId uint, boardID uint, cardID uuid.UUID, clientID string) ([]res.CardCursorPayload, error)
+	LeaveCardDocuments(userId uint, boardID uint, clientIDs []string) ([]res.CardCursorPayload, error)
+}
+
+type cardDocumentUsecase struct {
+	boardRepo _boardRepo.BoardRepository
+}
+
+func NewCardDocumentUsecase(boardRepo _boardRepo.BoardRepository) CardDocumentUsecase {
+	return &cardDocumentUsecase{boardRepo: boardRepo}
+}
+
+func newCardDocumentResyncError() error {
+	return common.NewError(http.StatusConflict, "문서가 변경되어 다시 불러와야 합니다.", nil)
+}
+
+func (u *cardDocumentUsecase) checkBoardRole(userId uint, boardID uint, minRole int) error {
+	role, err := u.boardRepo.CheckBoardUserRole(boardID, userId)
+	if err != nil {
+		return common.NewError(http.StatusForbidden, "해당 보드에 접근할 수 없습니다.", err)
+	}
+	if role < minRole {
+		return common.NewError(http.StatusForbidden, "해당 보드의 수정 권한이 없습니다.", nil)
+	}
+	return nil
+}
+
+// redis에 문서가 없으면 카드 내용으로 새 세션 문서를 올림 (리비전은 마지막 스냅샷부터 이어감)
+func (u *cardDocumentUsecase) loadCardDocument(boardID uint, cardID uuid.UUID) (*entity.CardDocument, error) {
+	document, err := u.boardRepo.GetCardDocument(cardID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "카드 문서 조회 실패", err)
+	}
+
+	if document == nil {
+		card, err := u.boardRepo.GetBoardCardByID(cardID)
+		if err != nil || card == nil || card.BoardID != boardID {
+			return nil, common.NewError(http.StatusNotFound, "카드를 찾을 수 없습니다.", err)
+		}
+
+		revision, err := u.boardRepo.GetLatestCardSnapshotRevision(cardID)
+		if err != nil {
+			return nil, common.NewError(http.StatusInternalServerError, "카드 스냅샷 조회 실패", err)
+		}
+
+		document, err = u.boardRepo.InitCardDocument(&entity.CardDocument{
+			CardID:    cardID,
+			BoardID:   boardID,
+			Session:   uuid.NewString(),
+			Content:   card.Content,
+			Revision:  revision,
+			UpdatedAt: card.UpdatedAt,
+		})
+		if err != nil {
+			return nil, common.NewError(http.StatusInternalServerError, "카드 문서 생성 실패", err)
+		}
+	}
+
+	if document.BoardID != boardID {
+		return nil, common.NewError(http.StatusNotFound, "카드를 찾을 수 없습니다.", nil)
+	}
+
+	return document, nil
+}
+
+// fromRevision 이후 연산을 순서대로 - 보관 범위를 벗어났으면 ok=false
+func (u *cardDocumentUsecase) operationsSince(document *entity.CardDocument, fromRevision int) ([]util.TextOperation, bool, error) {
+	if fromRevision == document.Revision {
+		return nil, true, nil
+	}
+
+	stored, err := u.boardRepo.GetCardOperations(document.CardID, fromRevision)
+	if err != nil {
+		return nil, false, common.NewError(http.StatusInternalServerError, "카드 연산 조회 실패", err)
+	}
+	if len(stored) != document.Revision-fromRevision {
+		return nil, false, nil
+	}
+
+	operations := make([]util.TextOperation, len(stored))
+	for i, operation := range stored {
+		if err := json.Unmarshal([]byte(operation.Operation), &operations[i]); err != nil {
+			return nil, false, common.NewError(http.StatusInternalServerError, "카드 연산 파싱 실패", err)
+		}
+	}
+	return operations, true, nil
+}
+
+func (u *cardDocumentUsecase) OpenCardDocument(userId uint, boardID uint, cardID uuid.UUID, onlineUserIDs []uint) (*res.CardDocumentResponse, error) {
+	if err := u.checkBoardRole(userId, boardID, entity.BoardRoleUser); err != nil {
+		return nil, err
+	}
+
+	document, err := u.loadCardDocument(boardID, cardID)
+	if err != nil {
+		return nil, err
+	}
+
+	cursors, err := u.boardRepo.GetBoardCardCursors(boardID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "카드 커서 조회 실패", err)
+	}
+
+	// onlineUserIDs가 nil이면 (클러스터 모드) 시간으로만 판단
+	var online map[uint]bool
+	if onlineUserIDs != nil {
+		online = make(map[uint]bool, len(onlineUserIDs))
+		for _, id := range onlineUserIDs {
+			online[id] = true
+		}
+	}
+
+	response := &res.CardDocumentResponse{
+		CardID:   document.CardID,
+		BoardID:  document.BoardID,
+		Session:  document.Session,
+		Content:  document.Content,
+		Revision: document.Revision,
+		Cursors:  make([]res.CardCursorPayload, 0),
+	}
+
+	length := util.Utf16Length(document.Content)
+	for _, cursor := range cursors {
+		if cursor.CardID != cardID || time.Since(cursor.UpdatedAt) > entity.CardCursorTTL {
+			continue
+		}
+		if online != nil && !online[cursor.UserID] {
+			continue
+		}
+
+		// 커서 이후에 적용된 연산만큼 위치 이동
+		if cursor.Revision < document.Revision {
+			operations, ok, err := u.operationsSince(document, cursor.Revision)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				for i := range operations {
+					cursor.Anchor = operations[i].TransformIndex(cursor.Anchor)
+					cursor.Head = operations[i].TransformIndex(cursor.Head)
+				}
+			}
+		}
+
+		payload := toCardCursorPayload(boardID, cursor)
+		payload.Revision = document.Revision
+		payload.Anchor = clampIndex(payload.Anchor, length)
+		payload.Head = clampIndex(payload.Head, length)
+		response.Cursors = append(response.Cursors, payload)
+	}
+
+	return response, nil
+}
+
+func (u *cardDocumentUsecase) ApplyCardOperation(userId uint, boardID uint, request *req.CardDocumentRequest) (*res.CardOperationPayload, error) {
+	if err := u.checkBoardRole(userId, boardID, entity.BoardRoleMaintainer); err != nil {
+		return nil, err
+	}
+
+	if request.ClientID == "" {
+		return nil, common.NewError(http.StatusBadRequest, "클라이언트 ID가 없습니다.", nil)
+	}
+
+	var operation util.TextOperation
+	if err := json.Unmarshal(request.Operation, &operation); err != nil {
+		return nil, common.NewError(http.StatusBadRequest, "잘못된 편집 연산입니다.", err)
+	}
+
+	for attempt := 0; attempt < maxCardOperationAttempts; attempt++ {
+		document, err := u.loadCardDocument(boardID, request.CardID)
+		if err != nil {
+			return nil, err
+		}
+
+		if document.Session != request.Session || request.Revision > document.Revision {
+			return nil, newCardDocumentResyncError()
+		}
+
+		concurrent, ok, err := u.operationsSince(document, request.Revision)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, newCardDocumentResyncError()
+		}
+
+		// 클라이언트가 모르는 사이에 적용된 연산들을 기준으로 변환
+		transformed := &operation
+		for i := range concurrent {
+			if transformed, _, err = util.TransformTextOperations(transformed, &concurrent[i]); err != nil {
+				return nil, common.NewError(http.StatusBadRequest, "편집 연산이 문서와 맞지 않습니다.", err)
+			}
+		}
+
+		payload, saved, err := commitCardOperation(u.boardRepo, document, userId, request.ClientID, transformed)
+		if err != nil {
+			return nil, err
+		}
+		if saved {
+			return payload, nil
+		}
+	}
+
+	return nil, common.NewError(http.StatusConflict, "동시 편집이 많아 연산을 적용하지 못했습니다. 문서를 다시 불러와주세요.", nil)
+}
+
+func (u *cardDocumentUsecase) UpdateCardCursor(userId uint, userName string, boardID uint, request *req.CardDocumentRequest) (*res.CardCursorPayload, error) {
+	if err := u.checkBoardRole(userId, boardID, entity.BoardRoleUser); err != nil {
+		return nil, err
+	}
+
+	if request.ClientID == "" || request.Anchor == nil || request.Head == nil {
+		return nil, common.NewError(http.StatusBadRequest, "커서 정보가 없습니다.", nil)
+	}
+
+	document, err := u.boardRepo.GetCardDocument(request.CardID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "카드 문서 조회 실패", err)
+	}
+	if document == nil || document.BoardID != boardID || document.Session != request.Session || request.Revision > document.Revision {
+		return nil, newCardDocumentResyncError()
+	}
+
+	anchor, head := *request.Anchor, *request.Head
+	operations, ok, err := u.operationsSince(document, request.Revision)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, newCardDocumentResyncError()
+	}
+	for i := range operations {
+		anchor = operations[i].TransformIndex(anchor)
+		head = operations[i].TransformIndex(head)
+	}
+
+	length := util.Utf16Length(document.Content)
+	cursor := entity.CardCursor{
+		CardID:    document.CardID,
+		ClientID:  request.ClientID,
+		UserID:    userId,
+		UserName:  userName,
+		Revision:  document.Revision,
+		Anchor:    clampIndex(anchor, length),
+		Head:      clampIndex(head, length),
+		UpdatedAt: time.Now(),
+	}
+	if err := u.boardRepo.SaveBoardCardCursor(boardID, &cursor); err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "카드 커서 저장 실패", err)
+	}
+
+	payload := toCardCursorPayload(boardID, cursor)
+	return &payload, nil
+}
+
+func (u *cardDocumentUsecase) CloseCardDocument(userId uint, boardID uint, cardID uuid.UUID, clientID string) ([]res.CardCursorPayload, error) {
+	return u.removeCursors(userId, boardID, func(cursor entity.CardCursor) bool {
+		return cursor.CardID == cardID && cursor.ClientID == clientID
+	})
+}
+
+// 보드 연결이 끊기면 그 연결에서 쓰던 커서 제거
+func (u *cardDocumentUsecase) LeaveCardDocuments(userId uint, boardID uint, clientIDs []string) ([]res.CardCursorPayload, error) {
+	if len(clientIDs) == 0 {
+		return nil, nil
+	}
+
+	clients := make(map[string]bool, len(clientIDs))
+	for _, clientID := range clientIDs {
+		clients[clientID] = true
+	}
+	return u.removeCursors(userId, boardID, func(cursor entity.CardCursor) bool {
+		return clients[cursor.ClientID]
+	})
+}
+
+func (u *cardDocumentUsecase) removeCursors(userId uint, boardID uint, match func(cursor entity.CardCursor) bool) ([]res.CardCursorPayload, error) {
+	cursors, err := u.boardRepo.GetBoardCardCursors(boardID)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "카드 커서 조회 실패", err)
+	}
+
+	removed := make([]entity.CardCursor, 0)
+	for _, cursor := range cursors {
+		// 다른 사용자의 커서는 지울 수 없음, 오래된 커서는 함께 정리
+		if (cursor.UserID == userId && match(cursor)) || time.Since(cursor.UpdatedAt) > entity.CardCursorTTL {
+			removed = append(removed, cursor)
+		}
+	}
+	if err := u.boardRepo.DeleteBoardCardCursors(boardID, removed); err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "카드 커서 삭제 실패", err)
+	}
+
+	payloads := make([]res.CardCursorPayload, len(removed))
+	for i, cursor := range removed {
+		payloads[i] = toCardCursorPayload(boardID, cursor)
+		payloads[i].Removed = true
+	}
+	return payloads, nil
+}
+
+// 변환이 끝난 연산을 문서에 적용하고 다음 리비전으로 저장 - 그 사이 다른 연산이 저장되었으면 saved=false
+func commitCardOperation(boardRepo _boardRepo.BoardRepository, document *entity.CardDocument, userId uint, clientID string, operation *util.TextOperation) (*res.CardOperationPayload, bool, error) {
+	content, err := operation.Apply(document.Content)
+	if err != nil {
+		return nil, false, common.NewError(http.StatusBadRequest, "편집 연산이 문서와 맞지 않습니다.", err)
+	}
+	if util.Utf16Length(content) > entity.MaxCardContentLength {
+		return nil, false, common.NewError(http.StatusBadRequest, "카드 내용이 너무 깁니다.", nil)
+	}
+
+	operationJSON, err := json.Marshal(operation)
+	if err != nil {
+		return nil, false, common.NewError(http.StatusInternalServerError, "카드 연산 직렬화 실패", err)
+	}
+
+	next := *document
+	next.Content = content
+	next.Revision = document.Revision + 1
+	next.UpdatedBy = userId
+	next.UpdatedAt = time.Now()
+
+	saved, err := boardRepo.SaveCardOperation(&next, &entity.CardOperation{
+		Revision:  next.Revision,
+		UserID:    userId,
+		ClientID:  clientID,
+		Operation: string(operationJSON),
+		CreatedAt: next.UpdatedAt,
+	})
+	if err != nil {
+		return nil, false, common.NewError(http.StatusInternalServerError, "카드 연산 저장 실패", err)
+	}
+	if !saved {
+		return nil, false, nil
+	}
+
+	return &res.CardOperationPayload{
+		CardID:    next.CardID,
+		BoardID:   next.BoardID,
+		Session:   next.Session,
+		Revision:  next.Revision,
+		ClientID:  clientID,
+		UserID:    userId,
+		Operation: operationJSON,
+	}, true, nil
+}
+
+// 협업 편집 중인 카드의 내용은 OT 연산으로만 수정 (자동 저장으로 통째로 바꾸면 다른 사람의 편집이 사라짐)
+// 편집 중 = redis 문서가 있고 CardCursorTTL 안에 연산이나 커서 움직임이 있음
+func checkCardContentChanges(boardRepo _boardRepo.BoardRepository, boardID uint, changes []entity.BoardChange) error {
+	var cursors []entity.CardCursor
+	cursorsLoaded := false
+	for i, change := range changes {
+		if change.Type != "card" || change.Action != "update" || change.Content == nil {
+			continue
+		}
+		document, err := boardRepo.GetCardDocument(change.CardID)
+		if err != nil {
+			return common.NewError(http.StatusInternalServerError, "카드 문서 조회 실패", err)
+		}
+		if document == nil {
+			continue
+		}
+
+		editing := time.Since(document.UpdatedAt) <= entity.CardCursorTTL
+		if !editing {
+			if !cursorsLoaded {
+				cursors, err = boardRepo.GetBoardCardCursors(boardID)
+				if err != nil {
+					return common.NewError(http.StatusInternalServerError, "카드 커서 조회 실패", err)
+				}
+				cursorsLoaded = true
+			}
+			for _, cursor := range cursors {
+				if cursor.CardID == change.CardID && time.Since(cursor.UpdatedAt) <= entity.CardCursorTTL {
+					editing = true
+					break
+				}
+			}
+		}
+		if editing {
+			return common.NewError(http.StatusConflict, fmt.Sprintf("%d번째 변경사항의 카드는 협업 편집 중이라 내용을 자동 저장으로 수정할 수 없습니다.", i+1), nil)
+		}
+	}
+	return nil
+}
+
+// 검사 후 적용 사이에 편집 세션이 열린 경우 편집 중인 문서에도 전체 교체 연산으로 반영 (편집 중이 아니면 nil)
+func replaceCardDocumentContent(boardRepo _boardRepo.BoardRepository, userId uint, cardID uuid.UUID, content string) (*res.CardOperationPayload, error) {
+	for attempt := 0; attempt < maxCardOperationAttempts; attempt++ {
+		document, err := boardRepo.GetCardDocument(cardID)
+		if err != nil {
+			return nil, common.NewError(http.StatusInternalServerError, "카드 문서 조회 실패", err)
+		}
+		if document == nil || document.Content == content {
+			return nil, nil
+		}
+
+		payload, saved, err := commitCardOperation(boardRepo, document, userId, "autosave", util.ReplaceTextOperation(document.Content, content))
+		if err != nil {
+			return nil, err
+		}
+		if saved {
+			return payload, nil
+		}
+	}
+
+	return nil, common.NewError(http.StatusConflict, "동시 편집이 많아 카드 문서에 반영하지 못했습니다.", nil)
+}
+
+func toCardCursorPayload(boardID uint, cursor entity.CardCursor) res.CardCursorPayload {
+	return res.CardCursorPayload{
+		CardID:   cursor.CardID,
+		BoardID:  boardID,
+		ClientID: cursor.ClientID,
+		UserID:   cursor.UserID,
+		UserName: cursor.UserName,
+		Revision: cursor.Revision,
+		Anchor:   cursor.Anchor,
+		Head:     cursor.Head,
+	}
+}
+
+func clampIndex(index int, length int) int {
+	if index < 0 {
+		return 0
+	}
+	if index > length {
+		return length
+	}
+	return index
+}
@@ -5,11 +5,26 @@ import (
 	"time"
 )
 
+// 그룹 채팅방 역할
+// owner: 이름 변경, 초대, 내보내기, 역할 변경, 방장 위임 / admin: 이름 변경, 초대, 일반 참여자 내보내기 / member: 별칭 변경만
+const (
+	ChatRoomRoleOwner  = "owner"
+	ChatRoomRoleAdmin  = "admin"
+	ChatRoomRoleMember = "member"
+)
+
+// 채팅방당 고정할 수 있는 메시지 수
+const MaxPinnedChatMessages = 20
+
 type ChatRoom struct {
 	ID        uint                `json:"id"`
 	Name      string              `json:"name"`
 	IsPrivate bool                `json:"is_private"`      // 그룹 채팅인지 1:1 채팅인지 구분
 	Users     []*_userEntity.User `json:"users,omitempty"` // 사용자 정보 배열로 변경
+	OwnerID   uint                `json:"owner_id,omitempty"`
+
+	IsAnnouncement bool  `json:"is_announcement,omitempty"` // 회사 공지 채팅방
+	CompanyID      *uint `json:"company_id,omitempty"`
 }
 
 type Chat struct {
@@ -25,6 +40,49 @@ type Chat struct {
 	UnreadCount uint      `json:"unread_count,omitempty"`
 	CreatedAt   time.Time `json:"created_at,omitempty"`
 	UpdatedAt   time.Time `json:"updated_at,omitempty"`
+
+	ReplyTo     string           `json:"reply_to,omitempty"`      // 답장 대상 메시지 ID
+	ReplyToChat *Chat            `json:"reply_to_chat,omitempty"` // 답장 대상 메시지 (미리보기용)
+	Attachments []ChatAttachment `json:"attachments,omitempty"`
+	Reactions   []ChatReaction   `json:"reactions,omitempty"`
+	EditHistory []ChatEdit       `json:"edit_history,omitempty"`
+	EditedAt    *time.Time       `json:"edited_at,omitempty"`
+	Score       float64          `json:"score,omitempty"` // 검색 관련도
+	PinnedBy    *uint            `json:"pinned_by,omitempty"`
+	PinnedAt    *time.Time       `json:"pinned_at,omitempty"`
+}
+
+// 채팅 검색 조건 - ChatRoomIDs는 사용자가 참여중인 채팅방
+type ChatSearchFilter struct {
+	Query       string
+	ChatRoomIDs []uint
+	SenderID    uint
+	From        *time.Time
+	To          *time.Time
+	Page        int
+	Limit       int
+}
+
+// 첨부 파일
+type ChatAttachment struct {
+	URL         string `json:"url"`
+	Name        string `json:"name"`
+	Size        int64  `json:"size"`
+	ContentType string `json:"content_type,omitempty"`
+}
+
+// 이모지 반응 (이모지는 게시글 좋아요와 같은 emojis 테이블 사용)
+type ChatReaction struct {
+	EmojiID uint   `json:"emoji_id"`
+	Unified string `json:"unified"`
+	Content string `json:"content"`
+	UserIDs []uint `json:"user_ids"`
+}
+
+// 수정 이력 - 수정 전 내용
+type ChatEdit struct {
+	Content  string    `json:"content"`
+	EditedAt time.Time `json:"edited_at"`
 }
 
 type ChatMeta struct {
@@ -33,6 +91,9 @@ type ChatMeta struct {
 	PageSize   int    `json:"page_size"`
 	NextCursor string `json:"next_cursor,omitempty"`
 	HasMore    *bool  `json:"has_more"`
-	PrevPage   int    `json:"prev_page"`
-	NextPage   int    `json:"next_page"`
+	// 메시지로 이동했을 때 이후 메시지 커서
+	NewerCursor string `json:"newer_cursor,omitempty"`
+	HasNewer    *bool  `json:"has_newer,omitempty"`
+	PrevPage    int    `json:"prev_page"`
+	NextPage    int    `json:"next_page"`
 }
@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+// 회사 채팅 보관 정책 (RetentionDays 0 = 영구 보관)
+type ChatRetentionPolicy struct {
+	CompanyID     uint      `json:"company_id"`
+	RetentionDays int       `json:"retention_days"`
+	UpdatedBy     uint      `json:"updated_by,omitempty"`
+	UpdatedAt     time.Time `json:"updated_at,omitempty"`
+}
+
+type ChatLegalHold struct {
+	ID         uint       `json:"id"`
+	CompanyID  uint       `json:"company_id"`
+	ChatRoomID *uint      `json:"chat_room_id,omitempty"`
+	Reason     string     `json:"reason"`
+	CreatedBy  uint       `json:"created_by"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ReleasedAt *time.Time `json:"released_at,omitempty"`
+}
+
+// 삭제 대상 채팅방 - 참여자 회사 중 가장 긴 보관 기간 적용
+type ChatRoomRetention struct {
+	ChatRoomID    uint
+	RetentionDays int
+}
@@ -1,6 +1,10 @@
 package repository
 
-import "link/internal/chat/entity"
+import (
+	"time"
+
+	"link/internal/chat/entity"
+)
 
 type ChatRepository interface {
 	CreateChatRoom(chatRoom *entity.ChatRoom) error
@@ -18,8 +22,48 @@ type ChatRepository interface {
 	SaveMessage(chat *entity.Chat) error
 	GetChatMessages(chatRoomID uint, queryOptions map[string]interface{}) (*entity.ChatMeta, []*entity.Chat, error)
 	DeleteChatMessage(senderID uint, chatRoomID uint, chatMessageID string) error
+	GetChatMessagesAround(chatRoomID uint, chatMessageID string, limit int) (*entity.ChatMeta, []*entity.Chat, error)
+	SearchChatMessages(filter *entity.ChatSearchFilter) ([]*entity.Chat, int64, error)
+
+	//TODO 답장/수정/반응 관련
+	GetChatMessageByID(chatRoomID uint, chatMessageID string) (*entity.Chat, error)
+	UpdateChatMessage(chat *entity.Chat, content string) (*entity.Chat, error)
+	AddChatReaction(userId uint, chatMessageID string, reaction *entity.ChatReaction) ([]entity.ChatReaction, error)
+	RemoveChatReaction(userId uint, chatMessageID string, unified string) ([]entity.ChatReaction, error)
+
+	//TODO 읽음 처리 관련
+	MarkMessagesAsRead(userId uint, chatRoomID uint, lastMessageID string) (int64, error)
+	GetUnreadCounts(userId uint, chatRoomIDs []uint) (map[uint]int64, error)
+
+	//TODO 그룹 채팅방 관리 관련
+	GetChatRoomUserRole(userId uint, chatRoomId uint) (string, error)
+	UpdateChatRoomName(chatRoomId uint, name string) error
+	UpdateChatRoomAlias(userId uint, chatRoomId uint, alias string) error
+	UpdateChatRoomUserRole(userId uint, chatRoomId uint, role string) error
+	TransferChatRoomOwner(ownerId uint, targetUserId uint, chatRoomId uint) error
+	PromoteNextChatRoomOwner(chatRoomId uint) (uint, error)
+	SyncCompanyAnnouncementChatRooms(userId uint, companyId *uint) error
+
+	//TODO 고정 메시지 관련
+	CountPinnedChatMessages(chatRoomID uint) (int64, error)
+	PinChatMessage(chatRoomID uint, chatMessageID string, userId uint, maxPinned int64) (bool, int64, error)
+	UnpinChatMessage(chatRoomID uint, chatMessageID string) (bool, error)
+	GetPinnedChatMessages(chatRoomID uint) ([]*entity.Chat, error)
+
+	//TODO 보관 정책 관련
+	GetChatRetentionPolicy(companyId uint) (*entity.ChatRetentionPolicy, error)
+	UpsertChatRetentionPolicy(policy *entity.ChatRetentionPolicy) error
+	GetChatLegalHolds(companyId uint) ([]*entity.ChatLegalHold, error)
+	CreateChatLegalHold(hold *entity.ChatLegalHold) error
+	ReleaseChatLegalHold(companyId uint, holdId uint, releasedBy uint) (bool, error)
+	IsChatRoomInCompany(chatRoomId uint, companyId uint) bool
+	GetChatRoomRetentions(basicRetentionDays int) ([]*entity.ChatRoomRetention, error)
+	PurgeChatMessages(chatRoomId uint, before time.Time) (int64, error)
+	GetChatMessageSenderIDs(chatRoomId uint) ([]uint, error)
+	StreamChatMessages(chatRoomId uint, fn func(chat *entity.Chat) error) error
 
 	//TODO 레디스 관련
 	SetChatRoomToRedis(roomId uint, chatRoomInfo map[string]interface{}) error
 	GetChatRoomByIdFromRedis(roomId uint) (*entity.ChatRoom, error)
+	DeleteChatRoomFromRedis(roomId uint) error
 }
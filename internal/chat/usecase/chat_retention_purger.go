@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"link/pkg/logger"
+)
+
+const defaultChatRetentionPurgeInterval = time.Hour
+
+// ChatRetentionPurger 회사별 보관 기간이 지난 채팅 메시지를 주기적으로 삭제 (법적 보존 조치 채팅방 제외)
+// 여러 노드에서 동시에 돌아도 같은 조건으로 지우기만 하므로 결과는 같음
+type ChatRetentionPurger struct {
+	chatRetentionUsecase ChatRetentionUsecase
+	interval             time.Duration
+}
+
+func NewChatRetentionPurger(chatRetentionUsecase ChatRetentionUsecase) *ChatRetentionPurger {
+	interval := defaultChatRetentionPurgeInterval
+	if value := os.Getenv("CHAT_RETENTION_PURGE_INTERVAL"); value != "" {
+		if parsed, err := time.ParseDuration(value); err == nil && parsed > 0 {
+			interval = parsed
+		}
+	}
+
+	return &ChatRetentionPurger{chatRetentionUsecase: chatRetentionUsecase, interval: interval}
+}
+
+// Run 워커 시작 (main에서 고루틴으로 실행) - ctx가 끝나면 종료
+func (p *ChatRetentionPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := p.chatRetentionUsecase.PurgeExpiredChatMessages()
+			if err != nil {
+				logger.LogError(fmt.Sprintf("보관 기간 지난 채팅 삭제 실패: %v", err))
+			}
+			if purged > 0 {
+				fmt.Printf("보관 기간 지난 채팅 메시지 %d개 삭제\n", purged)
+			}
+		}
+	}
+}
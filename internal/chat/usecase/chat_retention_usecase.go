@@ -0,0 +1,363 @@
+package usecase
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"link/internal/chat/entity"
+	_chatRepo "link/internal/chat/repository"
+	_companyEntity "link/internal/company/entity"
+	_companyRepo "link/internal/company/repository"
+	_userRepo "link/internal/user/repository"
+	"link/pkg/common"
+	"link/pkg/dto/req"
+	"link/pkg/dto/res"
+)
+
+const (
+	ChatExportFormatJSONL = "jsonl"
+	ChatExportFormatCSV   = "csv"
+)
+
+// Basic 등급은 최대 90일까지만 보관 (기본값도 90일), Pro 등급은 기본 영구 보관
+const basicChatRetentionDays = 90
+
+type ChatRetentionUsecase interface {
+	//TODO 회사 관리자 - 보관 정책, 법적 보존 조치, 내보내기
+	GetChatRetention(requestUserId uint) (*res.ChatRetentionResponse, error)
+	UpdateChatRetention(requestUserId uint, request *req.UpdateChatRetentionRequest) (*res.ChatRetentionResponse, error)
+	GetChatLegalHolds(requestUserId uint) ([]*res.ChatLegalHoldResponse, error)
+	CreateChatLegalHold(requestUserId uint, request *req.CreateChatLegalHoldRequest) (*res.ChatLegalHoldResponse, error)
+	ReleaseChatLegalHold(requestUserId uint, holdId uint) error
+	ExportChatMessages(requestUserId uint, chatRoomId uint, format string) (func(w io.Writer) error, error)
+
+	//TODO 보관 기간 지난 메시지 삭제 (ChatRetentionPurger)
+	PurgeExpiredChatMessages() (int64, error)
+}
+
+type chatRetentionUsecase struct {
+	chatRepository    _chatRepo.ChatRepository
+	userRepository    _userRepo.UserRepository
+	companyRepository _companyRepo.CompanyRepository
+}
+
+func NewChatRetentionUsecase(
+	chatRepository _chatRepo.ChatRepository,
+	userRepository _userRepo.UserRepository,
+	companyRepository _companyRepo.CompanyRepository,
+) ChatRetentionUsecase {
+	return &chatRetentionUsecase{
+		chatRepository:    chatRepository,
+		userRepository:    userRepository,
+		companyRepository: companyRepository,
+	}
+}
+
+// 등급별 기본 보관 기간과 최대 보관 기간 (0 = 영구/제한 없음)
+func chatRetentionLimit(grade int) (defaultDays int, maxDays int) {
+	if grade == _companyEntity.CompanyGradeBasic {
+		return basicChatRetentionDays, basicChatRetentionDays
+	}
+	return 0, 0
+}
+
+// TODO 회사 채팅 보관 정책 조회
+func (u *chatRetentionUsecase) GetChatRetention(requestUserId uint) (*res.ChatRetentionResponse, error) {
+	companyId, err := u.requestUserCompanyId(requestUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	company, err := u.companyRepository.GetCompanyByID(companyId)
+	if err != nil {
+		log.Printf("회사 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 조회에 실패했습니다", err)
+	}
+
+	policy, err := u.chatRepository.GetChatRetentionPolicy(companyId)
+	if err != nil {
+		log.Printf("채팅 보관 정책 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅 보관 정책 조회에 실패했습니다", err)
+	}
+
+	defaultDays, maxDays := chatRetentionLimit(company.Grade)
+	response := &res.ChatRetentionResponse{
+		CompanyID:     companyId,
+		Grade:         company.Grade,
+		RetentionDays: defaultDays,
+		MaxDays:       maxDays,
+		IsDefault:     policy == nil,
+	}
+	if policy != nil {
+		response.RetentionDays = policy.RetentionDays
+		response.UpdatedAt = &policy.UpdatedAt
+	}
+	return response, nil
+}
+
+// TODO 회사 채팅 보관 정책 저장 - Basic 등급은 1~90일만 가능
+func (u *chatRetentionUsecase) UpdateChatRetention(requestUserId uint, request *req.UpdateChatRetentionRequest) (*res.ChatRetentionResponse, error) {
+	companyId, err := u.requestUserCompanyId(requestUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	company, err := u.companyRepository.GetCompanyByID(companyId)
+	if err != nil {
+		log.Printf("회사 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "회사 조회에 실패했습니다", err)
+	}
+
+	days := *request.RetentionDays
+	if _, maxDays := chatRetentionLimit(company.Grade); maxDays > 0 && (days == 0 || days > maxDays) {
+		return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("현재 등급에서는 최대 %d일까지 보관할 수 있습니다", maxDays), nil)
+	}
+
+	policy := &entity.ChatRetentionPolicy{
+		CompanyID:     companyId,
+		RetentionDays: days,
+		UpdatedBy:     requestUserId,
+	}
+	if err := u.chatRepository.UpsertChatRetentionPolicy(policy); err != nil {
+		log.Printf("채팅 보관 정책 저장 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅 보관 정책 저장에 실패했습니다", err)
+	}
+
+	return u.GetChatRetention(requestUserId)
+}
+
+// TODO 해제되지 않은 법적 보존 조치 목록
+func (u *chatRetentionUsecase) GetChatLegalHolds(requestUserId uint) ([]*res.ChatLegalHoldResponse, error) {
+	companyId, err := u.requestUserCompanyId(requestUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	holds, err := u.chatRepository.GetChatLegalHolds(companyId)
+	if err != nil {
+		log.Printf("법적 보존 조치 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "법적 보존 조치 조회에 실패했습니다", err)
+	}
+
+	response := make([]*res.ChatLegalHoldResponse, len(holds))
+	for i, hold := range holds {
+		response[i] = toChatLegalHoldResponse(hold)
+	}
+	return response, nil
+}
+
+// TODO 법적 보존 조치 - 채팅방을 지정하면 참여자 전원이 회사 소속인 채팅방만 가능
+func (u *chatRetentionUsecase) CreateChatLegalHold(requestUserId uint, request *req.CreateChatLegalHoldRequest) (*res.ChatLegalHoldResponse, error) {
+	companyId, err := u.requestUserCompanyId(requestUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	reason := strings.TrimSpace(request.Reason)
+	if reason == "" {
+		return nil, common.NewError(http.StatusBadRequest, "보존 사유를 입력해주세요", nil)
+	}
+
+	if request.ChatRoomID != nil && !u.chatRepository.IsChatRoomInCompany(*request.ChatRoomID, companyId) {
+		return nil, common.NewError(http.StatusNotFound, "회사 채팅방이 아닙니다", nil)
+	}
+
+	hold := &entity.ChatLegalHold{
+		CompanyID:  companyId,
+		ChatRoomID: request.ChatRoomID,
+		Reason:     reason,
+		CreatedBy:  requestUserId,
+	}
+	if err := u.chatRepository.CreateChatLegalHold(hold); err != nil {
+		log.Printf("법적 보존 조치 생성 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "법적 보존 조치에 실패했습니다", err)
+	}
+
+	return toChatLegalHoldResponse(hold), nil
+}
+
+// TODO 법적 보존 조치 해제
+func (u *chatRetentionUsecase) ReleaseChatLegalHold(requestUserId uint, holdId uint) error {
+	companyId, err := u.requestUserCompanyId(requestUserId)
+	if err != nil {
+		return err
+	}
+
+	released, err := u.chatRepository.ReleaseChatLegalHold(companyId, holdId, requestUserId)
+	if err != nil {
+		log.Printf("법적 보존 조치 해제 중 DB 오류: %v", err)
+		return common.NewError(http.StatusInternalServerError, "법적 보존 조치 해제에 실패했습니다", err)
+	}
+	if !released {
+		return common.NewError(http.StatusNotFound, "법적 보존 조치가 없습니다", nil)
+	}
+	return nil
+}
+
+// TODO 채팅방 대화 내보내기 (jsonl | csv)
+// 권한 확인과 발신자 이름 조회는 먼저 하고, 메시지는 응답에 바로 쓰도록 함수로 반환
+func (u *chatRetentionUsecase) ExportChatMessages(requestUserId uint, chatRoomId uint, format string) (func(w io.Writer) error, error) {
+	if format != ChatExportFormatJSONL && format != ChatExportFormatCSV {
+		return nil, common.NewError(http.StatusBadRequest, "format은 jsonl 또는 csv만 가능합니다", nil)
+	}
+
+	companyId, err := u.requestUserCompanyId(requestUserId)
+	if err != nil {
+		return nil, err
+	}
+	if !u.chatRepository.IsChatRoomInCompany(chatRoomId, companyId) {
+		return nil, common.NewError(http.StatusNotFound, "회사 채팅방이 아닙니다", nil)
+	}
+
+	//TODO 발신자 이름은 메시지에 저장된 이름 대신 현재 사용자 이름으로
+	senderIds, err := u.chatRepository.GetChatMessageSenderIDs(chatRoomId)
+	if err != nil {
+		log.Printf("채팅 발신자 조회 중 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅 내보내기에 실패했습니다", err)
+	}
+	senderNames := make(map[uint]string, len(senderIds))
+	if len(senderIds) > 0 {
+		users, err := u.userRepository.GetUserByIds(senderIds)
+		if err != nil {
+			log.Printf("채팅 발신자 이름 조회 중 DB 오류: %v", err)
+			return nil, common.NewError(http.StatusInternalServerError, "채팅 내보내기에 실패했습니다", err)
+		}
+		for _, user := range users {
+			if user.ID != nil && user.Name != nil {
+				senderNames[*user.ID] = *user.Name
+			}
+		}
+	}
+
+	senderName := func(chat *entity.Chat) string {
+		if name, ok := senderNames[chat.SenderID]; ok {
+			return name
+		}
+		return chat.SenderName // 탈퇴한 사용자
+	}
+
+	return func(w io.Writer) error {
+		if format == ChatExportFormatCSV {
+			return u.exportChatMessagesCSV(w, chatRoomId, senderName)
+		}
+		return u.exportChatMessagesJSONL(w, chatRoomId, senderName)
+	}, nil
+}
+
+type chatExportLine struct {
+	ChatMessageID string                       `json:"chat_message_id"`
+	ChatRoomID    uint                         `json:"chat_room_id"`
+	SenderID      uint                         `json:"sender_id"`
+	SenderName    string                       `json:"sender_name"`
+	Content       string                       `json:"content"`
+	ReplyTo       string                       `json:"reply_to,omitempty"`
+	Attachments   []res.ChatAttachmentResponse `json:"attachments,omitempty"`
+	EditedAt      *time.Time                   `json:"edited_at,omitempty"`
+	CreatedAt     time.Time                    `json:"created_at"`
+}
+
+func (u *chatRetentionUsecase) exportChatMessagesJSONL(w io.Writer, chatRoomId uint, senderName func(chat *entity.Chat) string) error {
+	encoder := json.NewEncoder(w)
+	return u.chatRepository.StreamChatMessages(chatRoomId, func(chat *entity.Chat) error {
+		return encoder.Encode(&chatExportLine{
+			ChatMessageID: chat.ID,
+			ChatRoomID:    chat.ChatRoomID,
+			SenderID:      chat.SenderID,
+			SenderName:    senderName(chat),
+			Content:       chat.Content,
+			ReplyTo:       chat.ReplyTo,
+			Attachments:   toChatAttachmentResponses(chat.Attachments),
+			EditedAt:      chat.EditedAt,
+			CreatedAt:     chat.CreatedAt,
+		})
+	})
+}
+
+func (u *chatRetentionUsecase) exportChatMessagesCSV(w io.Writer, chatRoomId uint, senderName func(chat *entity.Chat) string) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"chat_message_id", "created_at", "sender_id", "sender_name", "content", "reply_to", "attachments", "edited_at"}); err != nil {
+		return err
+	}
+
+	err := u.chatRepository.StreamChatMessages(chatRoomId, func(chat *entity.Chat) error {
+		attachments := make([]string, len(chat.Attachments))
+		for i, attachment := range chat.Attachments {
+			attachments[i] = attachment.URL
+		}
+		editedAt := ""
+		if chat.EditedAt != nil {
+			editedAt = chat.EditedAt.Format(time.RFC3339)
+		}
+		return writer.Write([]string{
+			chat.ID,
+			chat.CreatedAt.Format(time.RFC3339),
+			strconv.FormatUint(uint64(chat.SenderID), 10),
+			senderName(chat),
+			chat.Content,
+			chat.ReplyTo,
+			strings.Join(attachments, " "),
+			editedAt,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// TODO 보관 기간이 지난 메시지 삭제 - 삭제한 메시지 수 반환
+// 한 채팅방에서 실패해도 나머지 채팅방은 계속 진행
+func (u *chatRetentionUsecase) PurgeExpiredChatMessages() (int64, error) {
+	retentions, err := u.chatRepository.GetChatRoomRetentions(basicChatRetentionDays)
+	if err != nil {
+		return 0, err
+	}
+
+	var purged int64
+	var failed []string
+	now := time.Now()
+	for _, retention := range retentions {
+		before := now.AddDate(0, 0, -retention.RetentionDays)
+		count, err := u.chatRepository.PurgeChatMessages(retention.ChatRoomID, before)
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%d: %v", retention.ChatRoomID, err))
+			continue
+		}
+		purged += count
+	}
+
+	if len(failed) > 0 {
+		return purged, fmt.Errorf("채팅방 %d개 메시지 삭제 실패 (%s)", len(failed), strings.Join(failed, ", "))
+	}
+	return purged, nil
+}
+
+func (u *chatRetentionUsecase) requestUserCompanyId(requestUserId uint) (uint, error) {
+	user, err := u.userRepository.GetUserByID(requestUserId)
+	if err != nil {
+		return 0, common.NewError(http.StatusBadRequest, "존재 하지 않는 사용자 입니다", err)
+	}
+	if user.UserProfile == nil || user.UserProfile.CompanyID == nil {
+		return 0, common.NewError(http.StatusBadRequest, "회사가 존재하지 않습니다", nil)
+	}
+	return *user.UserProfile.CompanyID, nil
+}
+
+func toChatLegalHoldResponse(hold *entity.ChatLegalHold) *res.ChatLegalHoldResponse {
+	return &res.ChatLegalHoldResponse{
+		ID:         hold.ID,
+		ChatRoomID: hold.ChatRoomID,
+		Reason:     hold.Reason,
+		CreatedBy:  hold.CreatedBy,
+		CreatedAt:  hold.CreatedAt,
+	}
+}
@@ -4,10 +4,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"math"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/nats-io/nats.go"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"link/internal/chat/entity"
 	_chatRepo "link/internal/chat/repository"
@@ -26,9 +29,28 @@ type ChatUsecase interface {
 	GetChatRoomById(roomId uint) (*res.ChatRoomInfoResponse, error)
 	LeaveChatRoom(userId uint, chatRoomId uint) error
 
-	SaveMessage(senderID uint, chatRoomID uint, content string) (*entity.Chat, error)
+	SaveMessage(senderID uint, chatRoomID uint, request *req.SendMessageRequest) (*res.ChatPayload, error)
 	GetChatMessages(userId uint, chatRoomID uint, queryParams *req.GetChatMessagesQueryParams) (*res.GetChatMessagesResponse, error)
 	DeleteChatMessage(senderID uint, request *req.DeleteChatMessageRequest) error
+	SearchChatMessages(userId uint, queryParams *req.SearchChatMessagesQueryParams) (*res.SearchChatMessagesResponse, error)
+	CreateAnnouncementChatRoom(userId uint, request *req.CreateAnnouncementChatRoomRequest) (*res.CreateChatRoomResponse, error)
+	PinChatMessage(userId uint, request *req.PinChatMessageRequest) (*res.ChatPinPayload, error)
+	UnpinChatMessage(userId uint, request *req.PinChatMessageRequest) (*res.ChatPinPayload, error)
+	GetPinnedChatMessages(userId uint, chatRoomID uint) ([]*res.ChatMessagesResponse, error)
+	EditChatMessage(senderID uint, request *req.EditChatMessageRequest) (*res.ChatEditPayload, error)
+	GetChatMessageHistory(userId uint, chatRoomID uint, chatMessageID string) ([]*res.ChatEditHistoryResponse, error)
+	AddChatReaction(userId uint, request *req.ChatReactionRequest) (*res.ChatReactionPayload, error)
+	RemoveChatReaction(userId uint, request *req.ChatReactionRequest) (*res.ChatReactionPayload, error)
+
+	RenameChatRoom(userId uint, chatRoomId uint, request *req.RenameChatRoomRequest) (*res.ChatSystemPayload, error)
+	UpdateChatRoomAlias(userId uint, chatRoomId uint, request *req.UpdateChatRoomAliasRequest) (*res.ChatRoomAliasPayload, error)
+	InviteChatRoomMembers(userId uint, chatRoomId uint, request *req.InviteChatRoomMembersRequest) (*res.ChatSystemPayload, error)
+	RemoveChatRoomMember(userId uint, chatRoomId uint, targetUserId uint) (*res.ChatSystemPayload, error)
+	TransferChatRoomOwner(userId uint, chatRoomId uint, request *req.TransferChatRoomOwnerRequest) (*res.ChatSystemPayload, error)
+	UpdateChatRoomMemberRole(userId uint, chatRoomId uint, targetUserId uint, request *req.UpdateChatRoomMemberRoleRequest) (*res.ChatSystemPayload, error)
+
+	CheckChatRoomMember(userId uint, chatRoomID uint) error
+	MarkMessagesAsRead(userId uint, chatRoomID uint, lastMessageID string) (int64, error)
 
 	SetChatRoomToRedis(roomId uint, chatRoomInfo map[string]interface{}) error
 	GetChatRoomByIdFromRedis(roomId uint) (*res.ChatRoomInfoResponse, error)
@@ -142,6 +164,17 @@ func (uc *chatUsecase) CreateChatRoom(userId uint, request *req.CreateChatRoomRe
 		Users:     userPointers,
 	}
 
+	//TODO 그룹 채팅방은 만든 사용자가 방장 (참여자에 없으면 첫번째 사용자)
+	if !chatRoom.IsPrivate {
+		chatRoom.OwnerID = *users[0].ID
+		for _, user := range users {
+			if *user.ID == userId {
+				chatRoom.OwnerID = userId
+				break
+			}
+		}
+	}
+
 	err = uc.chatRepository.CreateChatRoom(chatRoom)
 	if err != nil {
 		log.Printf("채팅방 생성 중 DB 오류: %v", err)
@@ -163,6 +196,7 @@ func (uc *chatUsecase) CreateChatRoom(userId uint, request *req.CreateChatRoomRe
 	//TODO 레디스에 채팅방 저장
 
 	response := &res.CreateChatRoomResponse{
+		ID:        chatRoom.ID,
 		Name:      chatRoom.Name,
 		IsPrivate: chatRoom.IsPrivate,
 		Users:     usersResponse,
@@ -200,14 +234,21 @@ func (uc *chatUsecase) GetChatRoomById(roomId uint) (*res.ChatRoomInfoResponse,
 			if leftAt, ok := chatRoomUser["left_at"].(time.Time); ok {
 				userResponse[i].LeftAt = &leftAt
 			}
+			if role, ok := chatRoomUser["role"].(string); ok && !chatRoom.IsPrivate {
+				userResponse[i].Role = &role
+			}
 		}
 	}
 
 	chatRoomResponse := &res.ChatRoomInfoResponse{
-		ID:        chatRoom.ID,
-		Name:      chatRoom.Name,
-		IsPrivate: &chatRoom.IsPrivate,
-		Users:     userResponse,
+		ID:             chatRoom.ID,
+		Name:           chatRoom.Name,
+		IsPrivate:      &chatRoom.IsPrivate,
+		Users:          userResponse,
+		IsAnnouncement: chatRoom.IsAnnouncement,
+	}
+	if chatRoom.OwnerID != 0 {
+		chatRoomResponse.OwnerID = &chatRoom.OwnerID
 	}
 
 	return chatRoomResponse, nil
@@ -223,6 +264,16 @@ func (uc *chatUsecase) GetChatRoomList(userId uint) ([]*res.ChatRoomInfoResponse
 
 	chatRoomListResponse := make([]*res.ChatRoomInfoResponse, len(chatRooms))
 
+	//TODO 채팅방별 읽지 않은 메시지 수 - 조회 실패해도 목록은 반환
+	chatRoomIDs := make([]uint, len(chatRooms))
+	for i, chatRoom := range chatRooms {
+		chatRoomIDs[i] = chatRoom.ID
+	}
+	unreadCounts, err := uc.chatRepository.GetUnreadCounts(userId, chatRoomIDs)
+	if err != nil {
+		log.Printf("읽지 않은 메시지 수 조회 중 DB 오류: %v", err)
+	}
+
 	for i, chatRoom := range chatRooms {
 		userResponse := make([]res.UserInfoResponse, len(chatRoom.Users))
 
@@ -242,10 +293,15 @@ func (uc *chatUsecase) GetChatRoomList(userId uint) ([]*res.ChatRoomInfoResponse
 		}
 
 		chatRoomListResponse[i] = &res.ChatRoomInfoResponse{
-			ID:        chatRoom.ID,
-			Name:      chatRoom.Name,
-			IsPrivate: &chatRoom.IsPrivate,
-			Users:     userResponse,
+			ID:             chatRoom.ID,
+			Name:           chatRoom.Name,
+			IsPrivate:      &chatRoom.IsPrivate,
+			Users:          userResponse,
+			IsAnnouncement: chatRoom.IsAnnouncement,
+		}
+		if unreadCounts != nil {
+			unreadCount := unreadCounts[chatRoom.ID]
+			chatRoomListResponse[i].UnreadCount = &unreadCount
 		}
 
 	}
@@ -263,7 +319,7 @@ func (uc *chatUsecase) LeaveChatRoom(userId uint, chatRoomId uint) error {
 	}
 
 	//TODO 채팅방이 있는지 확인
-	_, err = uc.chatRepository.GetChatRoomById(chatRoomId)
+	chatRoom, err := uc.chatRepository.GetChatRoomById(chatRoomId)
 	if err != nil {
 		fmt.Printf("채팅방 나가기 중 채팅방 조회 오류: %v", err)
 		return common.NewError(http.StatusNotFound, "존재하지 않는 채팅방입니다", err)
@@ -275,6 +331,14 @@ func (uc *chatUsecase) LeaveChatRoom(userId uint, chatRoomId uint) error {
 		return common.NewError(http.StatusInternalServerError, "채팅방 나가기에 실패했습니다", err)
 	}
 
+	//TODO 방장이 나가면 관리자 또는 가장 먼저 참여한 사용자에게 방장 위임
+	if !chatRoom.IsPrivate && chatRoom.OwnerID == userId {
+		if _, err := uc.chatRepository.PromoteNextChatRoomOwner(chatRoomId); err != nil {
+			log.Printf("다음 방장 지정 중 DB 오류: %v", err)
+		}
+	}
+	uc.deleteChatRoomCache(chatRoomId)
+
 	//TODO []byte로 변환
 	auditLeaveData, err := json.Marshal(map[string]interface{}{
 		"roomId":        chatRoomId,
@@ -352,10 +416,347 @@ func (uc *chatUsecase) AddUserChatRoom(requestUserId uint, targetUserId uint, ch
 	return nil
 }
 
-// TODO 단체방 채팅 초대
+// TODO 채팅방 역할 확인 - 참여중이 아니거나 roles에 없는 역할이면 403
+func (uc *chatUsecase) requireChatRoomRole(userId uint, chatRoomId uint, roles ...string) (string, error) {
+	role, err := uc.chatRepository.GetChatRoomUserRole(userId, chatRoomId)
+	if err != nil {
+		log.Printf("채팅방 역할 조회 중 DB 오류: %v", err)
+		return "", common.NewError(http.StatusInternalServerError, "채팅방 역할 조회에 실패했습니다", err)
+	}
+	if role == "" {
+		return "", common.NewError(http.StatusForbidden, "채팅방에 참여중인 사용자가 아닙니다", nil)
+	}
+	for _, allowed := range roles {
+		if role == allowed {
+			return role, nil
+		}
+	}
+	return "", common.NewError(http.StatusForbidden, "권한이 없습니다", nil)
+}
+
+// TODO 그룹 채팅방 조회 - 1:1 채팅방은 관리 기능 없음
+func (uc *chatUsecase) getGroupChatRoom(chatRoomId uint) (*entity.ChatRoom, error) {
+	chatRoom, err := uc.chatRepository.GetChatRoomById(chatRoomId)
+	if err != nil {
+		log.Printf("채팅방 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 채팅방입니다", err)
+	}
+	if chatRoom.IsPrivate {
+		return nil, common.NewError(http.StatusBadRequest, "1:1 채팅방에서는 사용할 수 없습니다", nil)
+	}
+	return chatRoom, nil
+}
+
+// 이름, 참여자가 바뀌면 레디스 캐시 삭제 (실패해도 다음 조회 때 DB에서 다시 불러옴)
+func (uc *chatUsecase) deleteChatRoomCache(chatRoomId uint) {
+	if err := uc.chatRepository.DeleteChatRoomFromRedis(chatRoomId); err != nil {
+		log.Printf("채팅방 캐시 삭제 중 오류: %v", err)
+	}
+}
+
+func newChatSystemPayload(chatRoomId uint, action string, actor *_userEntity.User, content string) *res.ChatSystemPayload {
+	return &res.ChatSystemPayload{
+		ChatRoomID: chatRoomId,
+		Action:     action,
+		ActorID:    *actor.ID,
+		ActorName:  *actor.Name,
+		Content:    content,
+		CreatedAt:  time.Now().Format(time.RFC3339),
+	}
+}
+
+// TODO 채팅방 이름 변경 (방장, 관리자)
+func (uc *chatUsecase) RenameChatRoom(userId uint, chatRoomId uint, request *req.RenameChatRoomRequest) (*res.ChatSystemPayload, error) {
+	name := strings.TrimSpace(request.Name)
+	if name == "" {
+		return nil, common.NewError(http.StatusBadRequest, "채팅방 이름이 비어있습니다", nil)
+	}
+
+	if _, err := uc.getGroupChatRoom(chatRoomId); err != nil {
+		return nil, err
+	}
+	if _, err := uc.requireChatRoomRole(userId, chatRoomId, entity.ChatRoomRoleOwner, entity.ChatRoomRoleAdmin); err != nil {
+		return nil, err
+	}
+
+	actor, err := uc.userRepository.GetUserByID(userId)
+	if err != nil {
+		log.Printf("채팅방 이름 변경 중 사용자 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 사용자입니다", err)
+	}
+
+	if err := uc.chatRepository.UpdateChatRoomName(chatRoomId, name); err != nil {
+		log.Printf("채팅방 이름 변경 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅방 이름 변경에 실패했습니다", err)
+	}
+	uc.deleteChatRoomCache(chatRoomId)
+
+	payload := newChatSystemPayload(chatRoomId, "rename", actor, fmt.Sprintf("%s님이 채팅방 이름을 '%s'(으)로 변경했습니다.", *actor.Name, name))
+	payload.Name = name
+	return payload, nil
+}
+
+// TODO 사용자별 채팅방 별칭 변경 - 1:1 채팅방도 가능, 본인에게만 보이므로 시스템 메시지 없음
+func (uc *chatUsecase) UpdateChatRoomAlias(userId uint, chatRoomId uint, request *req.UpdateChatRoomAliasRequest) (*res.ChatRoomAliasPayload, error) {
+	alias := strings.TrimSpace(request.Alias)
+	if alias == "" {
+		return nil, common.NewError(http.StatusBadRequest, "채팅방 별칭이 비어있습니다", nil)
+	}
+
+	if err := uc.CheckChatRoomMember(userId, chatRoomId); err != nil {
+		return nil, err
+	}
+
+	if err := uc.chatRepository.UpdateChatRoomAlias(userId, chatRoomId, alias); err != nil {
+		log.Printf("채팅방 별칭 변경 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅방 별칭 변경에 실패했습니다", err)
+	}
+	uc.deleteChatRoomCache(chatRoomId)
+
+	return &res.ChatRoomAliasPayload{
+		ChatRoomID: chatRoomId,
+		Alias:      alias,
+		UpdatedAt:  time.Now().Format(time.RFC3339),
+	}, nil
+}
+
+// 같은 회사 소속인지 확인 (둘 다 회사가 없으면 같은 것으로 봄)
+func isSameCompany(a *_userEntity.User, b *_userEntity.User) bool {
+	var aCompanyID, bCompanyID *uint
+	if a.UserProfile != nil {
+		aCompanyID = a.UserProfile.CompanyID
+	}
+	if b.UserProfile != nil {
+		bCompanyID = b.UserProfile.CompanyID
+	}
+	if aCompanyID == nil || bCompanyID == nil {
+		return aCompanyID == nil && bCompanyID == nil
+	}
+	return *aCompanyID == *bCompanyID
+}
+
+// TODO 단체방 채팅 초대 (방장, 관리자) - 나갔던 사용자도 다시 초대 가능
+func (uc *chatUsecase) InviteChatRoomMembers(userId uint, chatRoomId uint, request *req.InviteChatRoomMembersRequest) (*res.ChatSystemPayload, error) {
+	chatRoom, err := uc.getGroupChatRoom(chatRoomId)
+	if err != nil {
+		return nil, err
+	}
+	if chatRoom.IsAnnouncement {
+		return nil, common.NewError(http.StatusBadRequest, "공지 채팅방 참여자는 회사 소속으로 관리됩니다", nil)
+	}
+	if _, err := uc.requireChatRoomRole(userId, chatRoomId, entity.ChatRoomRoleOwner, entity.ChatRoomRoleAdmin); err != nil {
+		return nil, err
+	}
+
+	actor, err := uc.userRepository.GetUserByID(userId)
+	if err != nil {
+		log.Printf("채팅방 초대 중 사용자 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 사용자입니다", err)
+	}
+
+	users, err := uc.userRepository.GetUserByIds(request.UserIDs)
+	if err != nil {
+		log.Printf("채팅방 초대 중 사용자 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅방 초대에 실패했습니다", err)
+	}
+	if len(users) == 0 {
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 사용자입니다", nil)
+	}
+	//TODO 다른 회사 사용자는 초대할 수 없음
+	for i := range users {
+		if !isSameCompany(actor, &users[i]) {
+			return nil, common.NewError(http.StatusForbidden, "같은 회사 사용자만 초대할 수 있습니다", nil)
+		}
+	}
+
+	invitedIDs := make([]uint, 0, len(users))
+	invitedNames := make([]string, 0, len(users))
+	for _, user := range users {
+		// 이미 참여중인 사용자는 건너뜀
+		if uc.chatRepository.IsUserInChatRoom(*user.ID, chatRoomId) {
+			continue
+		}
+		if err := uc.chatRepository.AddUserToGroupChatRoom(userId, *user.ID, chatRoomId); err != nil {
+			log.Printf("채팅방 초대 중 DB 오류: %v", err)
+			return nil, common.NewError(http.StatusInternalServerError, "채팅방 초대에 실패했습니다", err)
+		}
+		invitedIDs = append(invitedIDs, *user.ID)
+		invitedNames = append(invitedNames, *user.Name)
+	}
+	if len(invitedIDs) == 0 {
+		return nil, common.NewError(http.StatusBadRequest, "이미 채팅방에 참여중인 사용자입니다", nil)
+	}
+	uc.deleteChatRoomCache(chatRoomId)
+
+	payload := newChatSystemPayload(chatRoomId, "invite", actor, fmt.Sprintf("%s님이 %s님을 초대했습니다.", *actor.Name, strings.Join(invitedNames, ", ")))
+	payload.TargetUserIDs = invitedIDs
+	return payload, nil
+}
+
+// TODO 채팅방 내보내기 - 방장은 누구나, 관리자는 일반 참여자만 내보낼 수 있음
+func (uc *chatUsecase) RemoveChatRoomMember(userId uint, chatRoomId uint, targetUserId uint) (*res.ChatSystemPayload, error) {
+	if userId == targetUserId {
+		return nil, common.NewError(http.StatusBadRequest, "본인은 내보낼 수 없습니다. 채팅방 나가기를 이용해주세요", nil)
+	}
+
+	chatRoom, err := uc.getGroupChatRoom(chatRoomId)
+	if err != nil {
+		return nil, err
+	}
+	if chatRoom.IsAnnouncement {
+		return nil, common.NewError(http.StatusBadRequest, "공지 채팅방 참여자는 회사 소속으로 관리됩니다", nil)
+	}
+	role, err := uc.requireChatRoomRole(userId, chatRoomId, entity.ChatRoomRoleOwner, entity.ChatRoomRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	targetRole, err := uc.chatRepository.GetChatRoomUserRole(targetUserId, chatRoomId)
+	if err != nil {
+		log.Printf("채팅방 역할 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅방 내보내기에 실패했습니다", err)
+	}
+	if targetRole == "" {
+		return nil, common.NewError(http.StatusNotFound, "채팅방에 참여중인 사용자가 아닙니다", nil)
+	}
+	if targetRole == entity.ChatRoomRoleOwner || (role == entity.ChatRoomRoleAdmin && targetRole != entity.ChatRoomRoleMember) {
+		return nil, common.NewError(http.StatusForbidden, "권한이 없습니다", nil)
+	}
+
+	actor, err := uc.userRepository.GetUserByID(userId)
+	if err != nil {
+		log.Printf("채팅방 내보내기 중 사용자 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 사용자입니다", err)
+	}
+	target, err := uc.userRepository.GetUserByID(targetUserId)
+	if err != nil {
+		log.Printf("채팅방 내보내기 중 대상 사용자 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 사용자입니다", err)
+	}
+
+	if err := uc.chatRepository.LeaveChatRoom(targetUserId, chatRoomId); err != nil {
+		log.Printf("채팅방 내보내기 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅방 내보내기에 실패했습니다", err)
+	}
+	uc.deleteChatRoomCache(chatRoomId)
+
+	//TODO 내보낸 사용자의 소켓이 어느 노드에 있는지 모르므로 이벤트로 각 노드에서 채팅방 구독 해제
+	removedData, err := json.Marshal(map[string]interface{}{
+		"roomId": chatRoomId,
+		"userId": targetUserId,
+	})
+	if err == nil {
+		err = uc.natsPublisher.PublishEvent("chat.room.removed", removedData)
+	}
+	if err != nil {
+		log.Printf("채팅방 내보내기 이벤트 발행 오류: %v", err)
+	}
+
+	payload := newChatSystemPayload(chatRoomId, "kick", actor, fmt.Sprintf("%s님이 %s님을 내보냈습니다.", *actor.Name, *target.Name))
+	payload.TargetUserIDs = []uint{targetUserId}
+	return payload, nil
+}
+
+// TODO 방장 위임 (방장만) - 기존 방장은 관리자가 됨
+func (uc *chatUsecase) TransferChatRoomOwner(userId uint, chatRoomId uint, request *req.TransferChatRoomOwnerRequest) (*res.ChatSystemPayload, error) {
+	if userId == request.UserID {
+		return nil, common.NewError(http.StatusBadRequest, "이미 방장입니다", nil)
+	}
+
+	if _, err := uc.getGroupChatRoom(chatRoomId); err != nil {
+		return nil, err
+	}
+	if _, err := uc.requireChatRoomRole(userId, chatRoomId, entity.ChatRoomRoleOwner); err != nil {
+		return nil, err
+	}
+	if !uc.chatRepository.IsUserInChatRoom(request.UserID, chatRoomId) {
+		return nil, common.NewError(http.StatusNotFound, "채팅방에 참여중인 사용자가 아닙니다", nil)
+	}
+
+	actor, err := uc.userRepository.GetUserByID(userId)
+	if err != nil {
+		log.Printf("방장 위임 중 사용자 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 사용자입니다", err)
+	}
+	target, err := uc.userRepository.GetUserByID(request.UserID)
+	if err != nil {
+		log.Printf("방장 위임 중 대상 사용자 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 사용자입니다", err)
+	}
+
+	if err := uc.chatRepository.TransferChatRoomOwner(userId, request.UserID, chatRoomId); err != nil {
+		log.Printf("방장 위임 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "방장 위임에 실패했습니다", err)
+	}
+	uc.deleteChatRoomCache(chatRoomId)
+
+	payload := newChatSystemPayload(chatRoomId, "owner", actor, fmt.Sprintf("%s님이 %s님에게 방장을 넘겼습니다.", *actor.Name, *target.Name))
+	payload.TargetUserIDs = []uint{request.UserID}
+	payload.Role = entity.ChatRoomRoleOwner
+	return payload, nil
+}
+
+// TODO 참여자 역할 변경 (방장만) - 관리자 지정/해제
+func (uc *chatUsecase) UpdateChatRoomMemberRole(userId uint, chatRoomId uint, targetUserId uint, request *req.UpdateChatRoomMemberRoleRequest) (*res.ChatSystemPayload, error) {
+	if request.Role != entity.ChatRoomRoleAdmin && request.Role != entity.ChatRoomRoleMember {
+		return nil, common.NewError(http.StatusBadRequest, "역할은 admin 또는 member만 가능합니다", nil)
+	}
+	if userId == targetUserId {
+		return nil, common.NewError(http.StatusBadRequest, "본인의 역할은 변경할 수 없습니다", nil)
+	}
+
+	if _, err := uc.getGroupChatRoom(chatRoomId); err != nil {
+		return nil, err
+	}
+	if _, err := uc.requireChatRoomRole(userId, chatRoomId, entity.ChatRoomRoleOwner); err != nil {
+		return nil, err
+	}
+
+	targetRole, err := uc.chatRepository.GetChatRoomUserRole(targetUserId, chatRoomId)
+	if err != nil {
+		log.Printf("채팅방 역할 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "역할 변경에 실패했습니다", err)
+	}
+	if targetRole == "" {
+		return nil, common.NewError(http.StatusNotFound, "채팅방에 참여중인 사용자가 아닙니다", nil)
+	}
+	if targetRole == request.Role {
+		return nil, common.NewError(http.StatusBadRequest, "이미 같은 역할입니다", nil)
+	}
+
+	actor, err := uc.userRepository.GetUserByID(userId)
+	if err != nil {
+		log.Printf("역할 변경 중 사용자 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 사용자입니다", err)
+	}
+	target, err := uc.userRepository.GetUserByID(targetUserId)
+	if err != nil {
+		log.Printf("역할 변경 중 대상 사용자 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 사용자입니다", err)
+	}
+
+	if err := uc.chatRepository.UpdateChatRoomUserRole(targetUserId, chatRoomId, request.Role); err != nil {
+		log.Printf("역할 변경 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "역할 변경에 실패했습니다", err)
+	}
+	uc.deleteChatRoomCache(chatRoomId)
+
+	content := fmt.Sprintf("%s님이 %s님을 관리자로 지정했습니다.", *actor.Name, *target.Name)
+	if request.Role == entity.ChatRoomRoleMember {
+		content = fmt.Sprintf("%s님이 %s님의 관리자 권한을 해제했습니다.", *actor.Name, *target.Name)
+	}
+	payload := newChatSystemPayload(chatRoomId, "role", actor, content)
+	payload.TargetUserIDs = []uint{targetUserId}
+	payload.Role = request.Role
+	return payload, nil
+}
 
 // TODO 메시지 저장
-func (uc *chatUsecase) SaveMessage(senderID uint, chatRoomID uint, content string) (*entity.Chat, error) {
+func (uc *chatUsecase) SaveMessage(senderID uint, chatRoomID uint, request *req.SendMessageRequest) (*res.ChatPayload, error) {
+	if request.Content == "" && len(request.Attachments) == 0 {
+		return nil, common.NewError(http.StatusBadRequest, "메시지 내용이 없습니다", nil)
+	}
+
 	//TODO SenderID 조회
 	sender, err := uc.userRepository.GetUserByID(senderID)
 	if err != nil {
@@ -367,38 +768,76 @@ func (uc *chatUsecase) SaveMessage(senderID uint, chatRoomID uint, content strin
 	if sender.UserProfile != nil && sender.UserProfile.Image != nil {
 		senderImage = *sender.UserProfile.Image
 	}
+
+	attachments := make([]entity.ChatAttachment, len(request.Attachments))
+	for i, attachment := range request.Attachments {
+		attachments[i] = entity.ChatAttachment{
+			URL:         attachment.URL,
+			Name:        attachment.Name,
+			Size:        attachment.Size,
+			ContentType: attachment.ContentType,
+		}
+	}
+
+	//TODO 메시지 ID는 여기서 발급 - 몽고 _id로 저장하고 답장/수정/반응 대상으로 사용
 	chat := &entity.Chat{
+		ID:          primitive.NewObjectID().Hex(),
 		SenderID:    senderID,
 		ChatRoomID:  chatRoomID,
 		SenderName:  *sender.Name,
 		SenderEmail: *sender.Email,
 		SenderImage: senderImage,
-		Content:     content,
+		Content:     request.Content,
+		ReplyTo:     request.ReplyTo,
+		Attachments: attachments,
 		CreatedAt:   time.Now(),
 	}
 
 	//TODO 채팅방 조회
-	_, err = uc.chatRepository.GetChatRoomById(chatRoomID)
+	chatRoom, err := uc.chatRepository.GetChatRoomById(chatRoomID)
 	if err != nil {
 		log.Printf("채팅방 조회 중 DB 오류: %v", err)
 		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 채팅방입니다", err)
 	}
 
-	// err = uc.chatRepository.SaveMessage(chat)
-	// if err != nil {
-	// 	log.Printf("메시지 저장 중 DB 오류: %v", err)
-	// 	return nil, common.NewError(http.StatusInternalServerError, "메시지 저장에 실패했습니다", err)
-	// }
+	if err := uc.CheckChatRoomMember(senderID, chatRoomID); err != nil {
+		return nil, err
+	}
+
+	//TODO 공지 채팅방은 회사 관리자, 부관리자만 작성
+	if chatRoom.IsAnnouncement && !canManageAnnouncement(sender) {
+		return nil, common.NewError(http.StatusForbidden, "공지 채팅방은 회사 관리자만 작성할 수 있습니다", nil)
+	}
+
+	//TODO 답장 대상은 같은 채팅방의 메시지만 가능
+	var replyTo *res.ChatReplyResponse
+	if request.ReplyTo != "" {
+		replyToChat, err := uc.getChatMessage(chatRoomID, request.ReplyTo)
+		if err != nil {
+			return nil, err
+		}
+		replyTo = toChatReplyResponse(replyToChat)
+	}
+
+	//TODO 답장, 첨부 파일을 포함해 메시지 저장 (nats 이벤트는 저장 후 알림용)
+	err = uc.chatRepository.SaveMessage(chat)
+	if err != nil {
+		log.Printf("메시지 저장 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "메시지 저장에 실패했습니다", err)
+	}
 
 	publishData := map[string]interface{}{
 		"topic":   "link.event.chat.message",
 		"eventId": "chat_test",
 		"payload": map[string]interface{}{
+			"message_id":   chat.ID,
 			"chat_room_id": chatRoomID,
 			"sender_id":    senderID,
 			"sender_name":  *sender.Name,
 			"sender_email": *sender.Email,
-			"content":      content,
+			"content":      chat.Content,
+			"reply_to":     chat.ReplyTo,
+			"attachments":  chat.Attachments,
 		},
 	}
 
@@ -412,7 +851,18 @@ func (uc *chatUsecase) SaveMessage(senderID uint, chatRoomID uint, content strin
 		uc.natsPublisher.PublishEvent("link.event.chat.message", jsonData)
 	}()
 
-	return chat, nil
+	return &res.ChatPayload{
+		ChatMessageID: chat.ID,
+		ChatRoomID:    chat.ChatRoomID,
+		SenderID:      chat.SenderID,
+		SenderName:    chat.SenderName,
+		SenderEmail:   chat.SenderEmail,
+		SenderImage:   chat.SenderImage,
+		Content:       chat.Content,
+		ReplyTo:       replyTo,
+		Attachments:   toChatAttachmentResponses(chat.Attachments),
+		CreatedAt:     chat.CreatedAt.Format(time.RFC3339),
+	}, nil
 }
 
 // TODO 채팅방 내용 조회
@@ -439,10 +889,24 @@ func (uc *chatUsecase) GetChatMessages(userId uint, chatRoomID uint, queryParams
 	if queryParams.Cursor != nil {
 		if queryParams.Cursor.CreatedAt != "" {
 			queryOptions["cursor"].(map[string]interface{})["created_at"] = queryParams.Cursor.CreatedAt
+			queryOptions["cursor"].(map[string]interface{})["direction"] = queryParams.Cursor.Direction
 		}
 	}
 
-	chatMeta, chatMessages, err := uc.chatRepository.GetChatMessages(chatRoomID, queryOptions)
+	var chatMeta *entity.ChatMeta
+	var chatMessages []*entity.Chat
+	if queryParams.Around != "" {
+		//TODO 검색 결과에서 메시지로 이동 - 해당 메시지 주변 페이지
+		if !primitive.IsValidObjectID(queryParams.Around) {
+			return nil, common.NewError(http.StatusBadRequest, "메시지 ID 형식이 올바르지 않습니다", nil)
+		}
+		chatMeta, chatMessages, err = uc.chatRepository.GetChatMessagesAround(chatRoomID, queryParams.Around, queryParams.Limit)
+		if err == nil && chatMeta == nil {
+			return nil, common.NewError(http.StatusNotFound, "존재하지 않는 메시지입니다", nil)
+		}
+	} else {
+		chatMeta, chatMessages, err = uc.chatRepository.GetChatMessages(chatRoomID, queryOptions)
+	}
 	if err != nil {
 		log.Printf("채팅 내용 조회 중 DB 오류: %v", err)
 		return nil, common.NewError(http.StatusInternalServerError, "채팅 내용 조회에 실패했습니다", err)
@@ -450,32 +914,136 @@ func (uc *chatUsecase) GetChatMessages(userId uint, chatRoomID uint, queryParams
 
 	chatMessagesResponse := make([]*res.ChatMessagesResponse, len(chatMessages))
 	for i, chatMessage := range chatMessages {
-		chatMessagesResponse[i] = &res.ChatMessagesResponse{
-			ChatMessageID: chatMessage.ID,
-			Content:       chatMessage.Content,
-			SenderID:      chatMessage.SenderID,
-			SenderName:    chatMessage.SenderName,
-			SenderImage:   chatMessage.SenderImage, //! 메시지 작성할때 송신자 이미지 추가
-			ChatRoomID:    chatMessage.ChatRoomID,
-			// UnreadCount: chatMessage.UnreadCount,
-			CreatedAt: _util.ParseKst(chatMessage.CreatedAt).Format(time.DateTime),
-		}
+		chatMessagesResponse[i] = toChatMessagesResponse(chatMessage, userId)
 	}
 
 	return &res.GetChatMessagesResponse{
 		ChatMessages: chatMessagesResponse,
 		Meta: &res.ChatMeta{
-			NextCursor: chatMeta.NextCursor,
-			HasMore:    chatMeta.HasMore,
-			TotalCount: chatMeta.TotalCount,
-			TotalPages: chatMeta.TotalPages,
-			PageSize:   chatMeta.PageSize,
-			PrevPage:   chatMeta.PrevPage,
-			NextPage:   chatMeta.NextPage,
+			NextCursor:  chatMeta.NextCursor,
+			HasMore:     chatMeta.HasMore,
+			NewerCursor: chatMeta.NewerCursor,
+			HasNewer:    chatMeta.HasNewer,
+			TotalCount:  chatMeta.TotalCount,
+			TotalPages:  chatMeta.TotalPages,
+			PageSize:    chatMeta.PageSize,
+			PrevPage:    chatMeta.PrevPage,
+			NextPage:    chatMeta.NextPage,
 		},
 	}, nil
 }
 
+// TODO 채팅 메시지 검색 - 참여중인 채팅방의 메시지만 검색
+func (uc *chatUsecase) SearchChatMessages(userId uint, queryParams *req.SearchChatMessagesQueryParams) (*res.SearchChatMessagesResponse, error) {
+	query := strings.TrimSpace(queryParams.Query)
+	if query == "" {
+		return nil, common.NewError(http.StatusBadRequest, "검색어가 없습니다", nil)
+	}
+
+	filter := &entity.ChatSearchFilter{
+		Query:    query,
+		SenderID: queryParams.SenderID,
+		Page:     queryParams.Page,
+		Limit:    queryParams.Limit,
+	}
+
+	if queryParams.From != "" {
+		from, _, err := parseSearchDate(queryParams.From)
+		if err != nil {
+			return nil, common.NewError(http.StatusBadRequest, "from 날짜 형식이 올바르지 않습니다", err)
+		}
+		filter.From = &from
+	}
+	if queryParams.To != "" {
+		to, dateOnly, err := parseSearchDate(queryParams.To)
+		if err != nil {
+			return nil, common.NewError(http.StatusBadRequest, "to 날짜 형식이 올바르지 않습니다", err)
+		}
+		if dateOnly {
+			to = to.AddDate(0, 0, 1)
+		}
+		filter.To = &to
+	}
+
+	//TODO 검색 범위 - 참여중인 채팅방
+	chatRooms, err := uc.chatRepository.GetChatRoomList(userId)
+	if err != nil {
+		log.Printf("채팅 검색 중 채팅방 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅 검색에 실패했습니다", err)
+	}
+
+	chatRoomNames := make(map[uint]string, len(chatRooms))
+	for _, chatRoom := range chatRooms {
+		chatRoomNames[chatRoom.ID] = chatRoom.Name
+		for _, user := range chatRoom.Users {
+			if user.ID != nil && *user.ID == userId && len(user.ChatRoomUsers) > 0 {
+				if alias, ok := user.ChatRoomUsers[0]["alias_name"].(string); ok && alias != "" {
+					chatRoomNames[chatRoom.ID] = alias
+				}
+			}
+		}
+	}
+
+	if queryParams.ChatRoomID != 0 {
+		if !uc.chatRepository.IsUserInChatRoom(userId, queryParams.ChatRoomID) {
+			return nil, common.NewError(http.StatusForbidden, "채팅방에 참여중인 사용자가 아닙니다", nil)
+		}
+		filter.ChatRoomIDs = []uint{queryParams.ChatRoomID}
+	} else {
+		for chatRoomID := range chatRoomNames {
+			filter.ChatRoomIDs = append(filter.ChatRoomIDs, chatRoomID)
+		}
+	}
+
+	response := &res.SearchChatMessagesResponse{
+		Results: []*res.ChatSearchResultResponse{},
+		Meta: &res.ChatSearchMeta{
+			PageSize: filter.Limit,
+			Page:     filter.Page,
+		},
+	}
+	if len(filter.ChatRoomIDs) == 0 {
+		return response, nil
+	}
+
+	chatMessages, totalCount, err := uc.chatRepository.SearchChatMessages(filter)
+	if err != nil {
+		log.Printf("채팅 검색 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅 검색에 실패했습니다", err)
+	}
+
+	terms := _util.SearchTerms(query)
+	for _, chatMessage := range chatMessages {
+		response.Results = append(response.Results, &res.ChatSearchResultResponse{
+			ChatMessageID: chatMessage.ID,
+			ChatRoomID:    chatMessage.ChatRoomID,
+			ChatRoomName:  chatRoomNames[chatMessage.ChatRoomID],
+			SenderID:      chatMessage.SenderID,
+			SenderName:    chatMessage.SenderName,
+			SenderImage:   chatMessage.SenderImage,
+			Content:       chatMessage.Content,
+			Snippet:       _util.HighlightSnippet(chatMessage.Content, terms, 100),
+			Score:         chatMessage.Score,
+			CreatedAt:     _util.ParseKst(chatMessage.CreatedAt).Format(time.DateTime),
+		})
+	}
+
+	response.Meta.TotalCount = int(totalCount)
+	response.Meta.TotalPages = int(math.Ceil(float64(totalCount) / float64(filter.Limit)))
+	response.Meta.HasMore = int64(filter.Page*filter.Limit) < totalCount
+
+	return response, nil
+}
+
+// 검색 날짜 - 2006-01-02(KST 자정) 또는 RFC3339
+func parseSearchDate(value string) (time.Time, bool, error) {
+	if date, err := time.ParseInLocation(time.DateOnly, value, time.FixedZone("KST", 9*60*60)); err == nil {
+		return date, true, nil
+	}
+	date, err := time.Parse(time.RFC3339, value)
+	return date, false, err
+}
+
 // TODO 채팅 메시지 삭제
 func (uc *chatUsecase) DeleteChatMessage(senderID uint, request *req.DeleteChatMessageRequest) error {
 
@@ -487,6 +1055,449 @@ func (uc *chatUsecase) DeleteChatMessage(senderID uint, request *req.DeleteChatM
 	return nil
 }
 
+// TODO 채팅 메시지 수정 - 본인이 보낸 메시지만 가능, 수정 전 내용은 이력으로 남김
+func (uc *chatUsecase) EditChatMessage(senderID uint, request *req.EditChatMessageRequest) (*res.ChatEditPayload, error) {
+	if err := uc.CheckChatRoomMember(senderID, request.ChatRoomID); err != nil {
+		return nil, err
+	}
+
+	chat, err := uc.getChatMessage(request.ChatRoomID, request.ChatMessageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if chat.SenderID != senderID {
+		return nil, common.NewError(http.StatusForbidden, "본인이 보낸 메시지만 수정할 수 있습니다", nil)
+	}
+
+	if chat.Content != request.Content {
+		updatedChat, err := uc.chatRepository.UpdateChatMessage(chat, request.Content)
+		if err != nil {
+			log.Printf("채팅 메시지 수정 중 DB 오류: %v", err)
+			return nil, common.NewError(http.StatusInternalServerError, "채팅 메시지 수정에 실패했습니다", err)
+		}
+		if updatedChat == nil {
+			return nil, common.NewError(http.StatusConflict, "다른 곳에서 먼저 수정된 메시지입니다. 다시 시도해주세요", nil)
+		}
+		chat = updatedChat
+	}
+
+	updatedAt := chat.CreatedAt
+	if chat.EditedAt != nil {
+		updatedAt = *chat.EditedAt
+	}
+
+	return &res.ChatEditPayload{
+		ChatMessageID: chat.ID,
+		ChatRoomID:    chat.ChatRoomID,
+		Content:       chat.Content,
+		UpdatedAt:     _util.ParseKst(updatedAt).Format(time.DateTime),
+	}, nil
+}
+
+// TODO 채팅 메시지 수정 이력 (수정 전 내용, 오래된 순)
+func (uc *chatUsecase) GetChatMessageHistory(userId uint, chatRoomID uint, chatMessageID string) ([]*res.ChatEditHistoryResponse, error) {
+	if err := uc.CheckChatRoomMember(userId, chatRoomID); err != nil {
+		return nil, err
+	}
+
+	chat, err := uc.getChatMessage(chatRoomID, chatMessageID)
+	if err != nil {
+		return nil, err
+	}
+
+	historyResponse := make([]*res.ChatEditHistoryResponse, len(chat.EditHistory))
+	for i, edit := range chat.EditHistory {
+		historyResponse[i] = &res.ChatEditHistoryResponse{
+			Content:  edit.Content,
+			EditedAt: _util.ParseKst(edit.EditedAt).Format(time.DateTime),
+		}
+	}
+
+	return historyResponse, nil
+}
+
+// TODO 채팅 메시지 이모지 반응 추가
+func (uc *chatUsecase) AddChatReaction(userId uint, request *req.ChatReactionRequest) (*res.ChatReactionPayload, error) {
+	if request.Content == "" {
+		return nil, common.NewError(http.StatusBadRequest, "이모지가 없습니다", nil)
+	}
+
+	if err := uc.CheckChatRoomMember(userId, request.ChatRoomID); err != nil {
+		return nil, err
+	}
+
+	chat, err := uc.getChatMessage(request.ChatRoomID, request.ChatMessageID)
+	if err != nil {
+		return nil, err
+	}
+
+	reactions, err := uc.chatRepository.AddChatReaction(userId, chat.ID, &entity.ChatReaction{
+		Unified: request.Unified,
+		Content: request.Content,
+	})
+	if err != nil {
+		log.Printf("채팅 메시지 이모지 반응 추가 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "이모지 반응 추가에 실패했습니다", err)
+	}
+
+	return &res.ChatReactionPayload{
+		ChatMessageID: chat.ID,
+		ChatRoomID:    chat.ChatRoomID,
+		UserID:        userId,
+		Reactions:     toChatReactionResponses(reactions, userId),
+	}, nil
+}
+
+// TODO 채팅 메시지 이모지 반응 취소
+func (uc *chatUsecase) RemoveChatReaction(userId uint, request *req.ChatReactionRequest) (*res.ChatReactionPayload, error) {
+	if err := uc.CheckChatRoomMember(userId, request.ChatRoomID); err != nil {
+		return nil, err
+	}
+
+	chat, err := uc.getChatMessage(request.ChatRoomID, request.ChatMessageID)
+	if err != nil {
+		return nil, err
+	}
+
+	reactions, err := uc.chatRepository.RemoveChatReaction(userId, chat.ID, request.Unified)
+	if err != nil {
+		log.Printf("채팅 메시지 이모지 반응 취소 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "이모지 반응 취소에 실패했습니다", err)
+	}
+
+	return &res.ChatReactionPayload{
+		ChatMessageID: chat.ID,
+		ChatRoomID:    chat.ChatRoomID,
+		UserID:        userId,
+		Reactions:     toChatReactionResponses(reactions, userId),
+	}, nil
+}
+
+// 채팅방의 메시지 조회 (ID 형식 오류 400, 없으면 404)
+func (uc *chatUsecase) getChatMessage(chatRoomID uint, chatMessageID string) (*entity.Chat, error) {
+	if !primitive.IsValidObjectID(chatMessageID) {
+		return nil, common.NewError(http.StatusBadRequest, "메시지 ID 형식이 올바르지 않습니다", nil)
+	}
+
+	chat, err := uc.chatRepository.GetChatMessageByID(chatRoomID, chatMessageID)
+	if err != nil {
+		log.Printf("채팅 메시지 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "채팅 메시지 조회에 실패했습니다", err)
+	}
+	if chat == nil {
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 메시지입니다", nil)
+	}
+
+	return chat, nil
+}
+
+func toChatMessagesResponse(chatMessage *entity.Chat, userId uint) *res.ChatMessagesResponse {
+	response := &res.ChatMessagesResponse{
+		ChatMessageID: chatMessage.ID,
+		Content:       chatMessage.Content,
+		SenderID:      chatMessage.SenderID,
+		SenderName:    chatMessage.SenderName,
+		SenderImage:   chatMessage.SenderImage, //! 메시지 작성할때 송신자 이미지 추가
+		ChatRoomID:    chatMessage.ChatRoomID,
+		ReplyTo:       toChatReplyResponse(chatMessage.ReplyToChat),
+		Attachments:   toChatAttachmentResponses(chatMessage.Attachments),
+		Reactions:     toChatReactionResponses(chatMessage.Reactions, userId),
+		IsEdited:      chatMessage.EditedAt != nil,
+		// UnreadCount: chatMessage.UnreadCount,
+		CreatedAt: _util.ParseKst(chatMessage.CreatedAt).Format(time.DateTime),
+		PinnedBy:  chatMessage.PinnedBy,
+	}
+	if chatMessage.EditedAt != nil {
+		response.UpdatedAt = _util.ParseKst(*chatMessage.EditedAt).Format(time.DateTime)
+	}
+	if chatMessage.PinnedAt != nil {
+		response.PinnedAt = _util.ParseKst(*chatMessage.PinnedAt).Format(time.DateTime)
+	}
+	if chatMessage.ReplyTo != "" && response.ReplyTo == nil {
+		// 답장 대상이 삭제된 경우 ID만 전달
+		response.ReplyTo = &res.ChatReplyResponse{ChatMessageID: chatMessage.ReplyTo}
+	}
+	return response
+}
+
+func toChatReplyResponse(chat *entity.Chat) *res.ChatReplyResponse {
+	if chat == nil {
+		return nil
+	}
+	return &res.ChatReplyResponse{
+		ChatMessageID: chat.ID,
+		SenderID:      chat.SenderID,
+		SenderName:    chat.SenderName,
+		Content:       chat.Content,
+	}
+}
+
+func toChatAttachmentResponses(attachments []entity.ChatAttachment) []res.ChatAttachmentResponse {
+	if len(attachments) == 0 {
+		return nil
+	}
+	result := make([]res.ChatAttachmentResponse, len(attachments))
+	for i, attachment := range attachments {
+		result[i] = res.ChatAttachmentResponse{
+			URL:         attachment.URL,
+			Name:        attachment.Name,
+			Size:        attachment.Size,
+			ContentType: attachment.ContentType,
+		}
+	}
+	return result
+}
+
+func toChatReactionResponses(reactions []entity.ChatReaction, userId uint) []res.ChatReactionResponse {
+	result := make([]res.ChatReactionResponse, 0, len(reactions))
+	for _, reaction := range reactions {
+		isClicked := false
+		for _, reactedUserId := range reaction.UserIDs {
+			if reactedUserId == userId {
+				isClicked = true
+				break
+			}
+		}
+		result = append(result, res.ChatReactionResponse{
+			EmojiID:   reaction.EmojiID,
+			Unified:   reaction.Unified,
+			Content:   reaction.Content,
+			Count:     len(reaction.UserIDs),
+			UserIDs:   reaction.UserIDs,
+			IsClicked: isClicked,
+		})
+	}
+	return result
+}
+
+// 회사 공지 작성 권한 (회사 부관리자 이상)
+func canManageAnnouncement(user *_userEntity.User) bool {
+	return user.Role != 0 && user.Role <= _userEntity.RoleCompanySubManager
+}
+
+// TODO 회사 공지 채팅방 생성 - 현재 회사 사용자 전체를 참여시키고, 이후 회사에 들어온 사용자는 소속 변경 시 참여
+func (uc *chatUsecase) CreateAnnouncementChatRoom(userId uint, request *req.CreateAnnouncementChatRoomRequest) (*res.CreateChatRoomResponse, error) {
+	name := strings.TrimSpace(request.Name)
+	if name == "" {
+		return nil, common.NewError(http.StatusBadRequest, "채팅방 이름이 비어있습니다", nil)
+	}
+
+	user, err := uc.userRepository.GetUserByID(userId)
+	if err != nil {
+		log.Printf("공지 채팅방 생성 중 사용자 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 사용자입니다", err)
+	}
+	if !canManageAnnouncement(user) {
+		return nil, common.NewError(http.StatusForbidden, "공지 채팅방은 회사 관리자만 만들 수 있습니다", nil)
+	}
+	if user.UserProfile == nil || user.UserProfile.CompanyID == nil {
+		return nil, common.NewError(http.StatusBadRequest, "회사가 존재하지 않습니다", nil)
+	}
+	companyId := *user.UserProfile.CompanyID
+
+	userIds, err := uc.userRepository.GetUsersIdsByCompany(companyId)
+	if err != nil {
+		log.Printf("회사 사용자 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "공지 채팅방 생성에 실패했습니다", err)
+	}
+	users, err := uc.userRepository.GetUserByIds(userIds)
+	if err != nil {
+		log.Printf("회사 사용자 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "공지 채팅방 생성에 실패했습니다", err)
+	}
+
+	userPointers := make([]*_userEntity.User, len(users))
+	for i := range users {
+		userPointers[i] = &users[i]
+	}
+
+	chatRoom := &entity.ChatRoom{
+		Name:           name,
+		IsPrivate:      false,
+		Users:          userPointers,
+		OwnerID:        userId,
+		IsAnnouncement: true,
+		CompanyID:      &companyId,
+	}
+	if err := uc.chatRepository.CreateChatRoom(chatRoom); err != nil {
+		log.Printf("공지 채팅방 생성 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "공지 채팅방 생성에 실패했습니다", err)
+	}
+
+	usersResponse := make([]res.UserInfoResponse, len(users))
+	for i, user := range users {
+		usersResponse[i] = res.UserInfoResponse{
+			ID:    user.ID,
+			Name:  user.Name,
+			Email: user.Email,
+		}
+	}
+
+	return &res.CreateChatRoomResponse{
+		ID:             chatRoom.ID,
+		Name:           chatRoom.Name,
+		IsAnnouncement: true,
+		Users:          usersResponse,
+	}, nil
+}
+
+// 메시지 고정 권한 - 공지 채팅방은 회사 관리자, 그룹 채팅방은 방장/관리자, 1:1 채팅방은 참여자 모두
+func (uc *chatUsecase) checkChatPinPermission(user *_userEntity.User, chatRoomID uint) error {
+	chatRoom, err := uc.chatRepository.GetChatRoomById(chatRoomID)
+	if err != nil {
+		log.Printf("채팅방 조회 중 DB 오류: %v", err)
+		return common.NewError(http.StatusNotFound, "존재하지 않는 채팅방입니다", err)
+	}
+
+	switch {
+	case chatRoom.IsAnnouncement:
+		if err := uc.CheckChatRoomMember(*user.ID, chatRoomID); err != nil {
+			return err
+		}
+		if !canManageAnnouncement(user) {
+			return common.NewError(http.StatusForbidden, "권한이 없습니다", nil)
+		}
+	case chatRoom.IsPrivate:
+		return uc.CheckChatRoomMember(*user.ID, chatRoomID)
+	default:
+		_, err := uc.requireChatRoomRole(*user.ID, chatRoomID, entity.ChatRoomRoleOwner, entity.ChatRoomRoleAdmin)
+		return err
+	}
+	return nil
+}
+
+// TODO 메시지 고정 - 채팅방당 MaxPinnedChatMessages개까지
+func (uc *chatUsecase) PinChatMessage(userId uint, request *req.PinChatMessageRequest) (*res.ChatPinPayload, error) {
+	user, err := uc.userRepository.GetUserByID(userId)
+	if err != nil {
+		log.Printf("메시지 고정 중 사용자 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 사용자입니다", err)
+	}
+	if err := uc.checkChatPinPermission(user, request.ChatRoomID); err != nil {
+		return nil, err
+	}
+
+	chat, err := uc.getChatMessage(request.ChatRoomID, request.ChatMessageID)
+	if err != nil {
+		return nil, err
+	}
+	if chat.PinnedAt != nil {
+		return nil, common.NewError(http.StatusBadRequest, "이미 고정된 메시지입니다", nil)
+	}
+
+	pinned, pinnedCount, err := uc.chatRepository.PinChatMessage(request.ChatRoomID, request.ChatMessageID, userId, entity.MaxPinnedChatMessages)
+	if err != nil {
+		log.Printf("메시지 고정 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "메시지 고정에 실패했습니다", err)
+	}
+	if !pinned {
+		if pinnedCount >= entity.MaxPinnedChatMessages {
+			return nil, common.NewError(http.StatusBadRequest, fmt.Sprintf("메시지는 채팅방당 %d개까지 고정할 수 있습니다", entity.MaxPinnedChatMessages), nil)
+		}
+		return nil, common.NewError(http.StatusBadRequest, "이미 고정된 메시지입니다", nil)
+	}
+
+	now := time.Now()
+	chat.PinnedBy = &userId
+	chat.PinnedAt = &now
+
+	return &res.ChatPinPayload{
+		ChatRoomID:    request.ChatRoomID,
+		ChatMessageID: request.ChatMessageID,
+		Pinned:        true,
+		UserID:        userId,
+		UserName:      *user.Name,
+		PinnedCount:   pinnedCount,
+		Message:       toChatMessagesResponse(chat, userId),
+	}, nil
+}
+
+// TODO 메시지 고정 해제
+func (uc *chatUsecase) UnpinChatMessage(userId uint, request *req.PinChatMessageRequest) (*res.ChatPinPayload, error) {
+	if !primitive.IsValidObjectID(request.ChatMessageID) {
+		return nil, common.NewError(http.StatusBadRequest, "메시지 ID 형식이 올바르지 않습니다", nil)
+	}
+
+	user, err := uc.userRepository.GetUserByID(userId)
+	if err != nil {
+		log.Printf("메시지 고정 해제 중 사용자 조회 오류: %v", err)
+		return nil, common.NewError(http.StatusNotFound, "존재하지 않는 사용자입니다", err)
+	}
+	if err := uc.checkChatPinPermission(user, request.ChatRoomID); err != nil {
+		return nil, err
+	}
+
+	unpinned, err := uc.chatRepository.UnpinChatMessage(request.ChatRoomID, request.ChatMessageID)
+	if err != nil {
+		log.Printf("메시지 고정 해제 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "메시지 고정 해제에 실패했습니다", err)
+	}
+	if !unpinned {
+		return nil, common.NewError(http.StatusNotFound, "고정된 메시지가 아닙니다", nil)
+	}
+
+	pinnedCount, err := uc.chatRepository.CountPinnedChatMessages(request.ChatRoomID)
+	if err != nil {
+		log.Printf("고정 메시지 수 조회 중 DB 오류: %v", err)
+	}
+
+	return &res.ChatPinPayload{
+		ChatRoomID:    request.ChatRoomID,
+		ChatMessageID: request.ChatMessageID,
+		Pinned:        false,
+		UserID:        userId,
+		UserName:      *user.Name,
+		PinnedCount:   pinnedCount,
+	}, nil
+}
+
+// TODO 채팅방 고정 메시지 목록
+func (uc *chatUsecase) GetPinnedChatMessages(userId uint, chatRoomID uint) ([]*res.ChatMessagesResponse, error) {
+	if err := uc.CheckChatRoomMember(userId, chatRoomID); err != nil {
+		return nil, err
+	}
+
+	chatMessages, err := uc.chatRepository.GetPinnedChatMessages(chatRoomID)
+	if err != nil {
+		log.Printf("고정 메시지 조회 중 DB 오류: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "고정 메시지 조회에 실패했습니다", err)
+	}
+
+	response := make([]*res.ChatMessagesResponse, len(chatMessages))
+	for i, chatMessage := range chatMessages {
+		response[i] = toChatMessagesResponse(chatMessage, userId)
+	}
+	return response, nil
+}
+
+// TODO 채팅방 참여 여부 확인 (입력중/읽음/수신 확인 이벤트 전 권한 확인)
+func (uc *chatUsecase) CheckChatRoomMember(userId uint, chatRoomID uint) error {
+	if !uc.chatRepository.IsUserInChatRoom(userId, chatRoomID) {
+		return common.NewError(http.StatusForbidden, "채팅방에 참여중인 사용자가 아닙니다", nil)
+	}
+	return nil
+}
+
+// TODO 메시지 읽음 처리 - lastMessageID까지 읽은 것으로 처리하고 새로 읽은 메시지 수 반환
+func (uc *chatUsecase) MarkMessagesAsRead(userId uint, chatRoomID uint, lastMessageID string) (int64, error) {
+	if !primitive.IsValidObjectID(lastMessageID) {
+		return 0, common.NewError(http.StatusBadRequest, "메시지 ID 형식이 올바르지 않습니다", nil)
+	}
+
+	if err := uc.CheckChatRoomMember(userId, chatRoomID); err != nil {
+		return 0, err
+	}
+
+	readCount, err := uc.chatRepository.MarkMessagesAsRead(userId, chatRoomID, lastMessageID)
+	if err != nil {
+		log.Printf("메시지 읽음 처리 중 DB 오류: %v", err)
+		return 0, common.NewError(http.StatusInternalServerError, "메시지 읽음 처리에 실패했습니다", err)
+	}
+
+	return readCount, nil
+}
+
 func (uc *chatUsecase) SetChatRoomToRedis(roomId uint, chatRoomInfo map[string]interface{}) error {
 	if roomId == 0 || chatRoomInfo == nil {
 		return common.NewError(http.StatusBadRequest, "채팅방 또는 채팅방 ID가 유효하지 않습니다", nil)
@@ -519,10 +1530,11 @@ func (uc *chatUsecase) GetChatRoomByIdFromRedis(roomId uint) (*res.ChatRoomInfoR
 	}
 
 	chatRoomResponse := &res.ChatRoomInfoResponse{
-		ID:        chatRoom.ID,
-		Name:      chatRoom.Name,
-		IsPrivate: &chatRoom.IsPrivate,
-		Users:     userResponse,
+		ID:             chatRoom.ID,
+		Name:           chatRoom.Name,
+		IsPrivate:      &chatRoom.IsPrivate,
+		Users:          userResponse,
+		IsAnnouncement: chatRoom.IsAnnouncement,
 	}
 
 	fmt.Println(chatRoomResponse)
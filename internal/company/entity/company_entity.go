@@ -2,6 +2,12 @@ package entity
 
 import "time"
 
+// 회사 등급 (model.CompanyGrade와 같은 값)
+const (
+	CompanyGradeBasic = iota + 1
+	CompanyGradePro
+)
+
 type Company struct {
 	ID                        uint                      `json:"id"`
 	CpName                    string                    `json:"cp_name" binding:"required"`
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"time"
 
+	_chatRepo "link/internal/chat/repository"
 	"link/internal/company/entity"
 	_companyRepo "link/internal/company/repository"
 	_userEntity "link/internal/user/entity"
@@ -35,10 +36,11 @@ type CompanyUsecase interface {
 type companyUsecase struct {
 	companyRepository _companyRepo.CompanyRepository
 	userRepository    _userRepo.UserRepository
+	chatRepository    _chatRepo.ChatRepository
 }
 
-func NewCompanyUsecase(companyRepository _companyRepo.CompanyRepository, userRepository _userRepo.UserRepository) CompanyUsecase {
-	return &companyUsecase{companyRepository: companyRepository, userRepository: userRepository}
+func NewCompanyUsecase(companyRepository _companyRepo.CompanyRepository, userRepository _userRepo.UserRepository, chatRepository _chatRepo.ChatRepository) CompanyUsecase {
+	return &companyUsecase{companyRepository: companyRepository, userRepository: userRepository, chatRepository: chatRepository}
 }
 
 // TODO 회사 전체 목록 조회
@@ -146,6 +148,11 @@ func (u *companyUsecase) AddUserToCompany(requestUserId uint, userId uint, compa
 		return common.NewError(http.StatusInternalServerError, "서버 에러", err)
 	}
 
+	//TODO 회사 공지 채팅방 참여 - 실패해도 회사 추가는 유지
+	if err := u.chatRepository.SyncCompanyAnnouncementChatRooms(userId, &companyId); err != nil {
+		log.Printf("공지 채팅방 참여 처리 중 DB 오류: %v", err)
+	}
+
 	return nil
 }
 
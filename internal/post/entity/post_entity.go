@@ -32,3 +32,13 @@ type PostMeta struct {
 	PrevPage   int    `json:"prev_page"`             // 이전 페이지 번호 커서, 오프셋 둘다 사용
 	NextPage   int    `json:"next_page"`             // 다음 페이지 번호 커서, 오프셋 둘다 사용
 }
+
+// PostViewFlushStat 조회수 diff -> DB 반영 워커 실행 상태
+type PostViewFlushStat struct {
+	LastRunAt       time.Time `json:"last_run_at"`
+	LastSuccessAt   time.Time `json:"last_success_at"`
+	LastDurationMs  int64     `json:"last_duration_ms"`
+	LastFlushedPost int       `json:"last_flushed_post"`
+	LastFlushedView int       `json:"last_flushed_view"`
+	LastError       string    `json:"last_error,omitempty"`
+}
@@ -14,4 +14,11 @@ type PostRepository interface {
 	GetPostByCommentID(commentId uint) (*entity.Post, error)
 	IncreasePostViewCount(requestUserId uint, postId uint, ip string) error
 	GetPostViewCount(postId uint) (int, error)
+
+	//TODO 조회수 diff -> DB 반영
+	DrainPostViewDiffs() (map[uint]int, error)
+	ApplyPostViewDiffs(diffs map[uint]int) error
+	RestorePostViewDiffs(diffs map[uint]int) error
+	UpdatePostViewFlushStat(stat *entity.PostViewFlushStat) error
+	GetPostViewFlushStat() (*entity.PostViewFlushStat, error)
 }
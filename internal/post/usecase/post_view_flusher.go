@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"link/internal/post/entity"
+	_postRepository "link/internal/post/repository"
+	"link/pkg/logger"
+)
+
+const defaultPostViewFlushInterval = time.Minute
+
+// PostViewFlusher redis에 쌓인 조회수 diff(post:views:diff:<id>)를 주기적으로 posts.views에 반영
+// diff 키는 5분 TTL이므로 반영 주기는 그보다 짧아야 함
+type PostViewFlusher struct {
+	postRepo _postRepository.PostRepository
+	interval time.Duration
+}
+
+func NewPostViewFlusher(postRepo _postRepository.PostRepository) *PostViewFlusher {
+	interval := defaultPostViewFlushInterval
+	if value := os.Getenv("POST_VIEW_FLUSH_INTERVAL"); value != "" {
+		if parsed, err := time.ParseDuration(value); err == nil && parsed > 0 {
+			interval = parsed
+		}
+	}
+
+	return &PostViewFlusher{postRepo: postRepo, interval: interval}
+}
+
+// Run 워커 시작 (main에서 고루틴으로 실행) - ctx가 끝나면 남은 diff를 반영하고 종료
+func (f *PostViewFlusher) Run(ctx context.Context) {
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if err := f.Flush(); err != nil {
+				logger.LogError(fmt.Sprintf("종료 전 조회수 DB 반영 실패: %v", err))
+			}
+			return
+		case <-ticker.C:
+			if err := f.Flush(); err != nil {
+				logger.LogError(fmt.Sprintf("조회수 DB 반영 실패: %v", err))
+			}
+		}
+	}
+}
+
+// Flush diff를 가져와 DB에 반영하고, 실패 시 diff를 redis에 되돌림
+func (f *PostViewFlusher) Flush() error {
+	startedAt := time.Now()
+
+	stat, err := f.postRepo.GetPostViewFlushStat()
+	if err != nil {
+		stat = &entity.PostViewFlushStat{}
+	}
+	stat.LastRunAt = startedAt
+	stat.LastFlushedPost = 0
+	stat.LastFlushedView = 0
+	stat.LastError = ""
+
+	flushErr := f.flush(stat)
+	if flushErr != nil {
+		stat.LastError = flushErr.Error()
+	} else {
+		stat.LastSuccessAt = time.Now()
+	}
+	stat.LastDurationMs = time.Since(startedAt).Milliseconds()
+
+	if err := f.postRepo.UpdatePostViewFlushStat(stat); err != nil {
+		fmt.Printf("조회수 반영 상태 저장 실패: %v", err)
+	}
+
+	return flushErr
+}
+
+func (f *PostViewFlusher) flush(stat *entity.PostViewFlushStat) error {
+	diffs, err := f.postRepo.DrainPostViewDiffs()
+	if err != nil {
+		return err
+	}
+
+	if len(diffs) == 0 {
+		return nil
+	}
+
+	if err := f.postRepo.ApplyPostViewDiffs(diffs); err != nil {
+		if restoreErr := f.postRepo.RestorePostViewDiffs(diffs); restoreErr != nil {
+			return fmt.Errorf("%v (diff 복구 실패: %v)", err, restoreErr)
+		}
+		return err
+	}
+
+	for _, diff := range diffs {
+		stat.LastFlushedView += diff
+	}
+	stat.LastFlushedPost = len(diffs)
+
+	return nil
+}
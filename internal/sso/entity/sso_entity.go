@@ -0,0 +1,37 @@
+package entity
+
+import "time"
+
+// SSO 프로토콜
+const (
+	SsoProtocolOIDC = "oidc"
+	SsoProtocolSAML = "saml" // TODO SAML 2.0 지원 예정
+)
+
+// CompanySso 회사별 IdP 설정 (회사당 1개)
+type CompanySso struct {
+	ID              uint      `json:"id"`
+	CompanyID       uint      `json:"company_id"`
+	Protocol        string    `json:"protocol"`
+	Issuer          string    `json:"issuer"`
+	ClientID        string    `json:"client_id"`
+	ClientSecret    string    `json:"-"`
+	Scopes          []string  `json:"scopes"`
+	EmailClaim      string    `json:"email_claim"`
+	NameClaim       string    `json:"name_claim"`
+	DepartmentClaim string    `json:"department_claim,omitempty"`
+	PositionClaim   string    `json:"position_claim,omitempty"`
+	AutoProvision   bool      `json:"auto_provision"`  // 최초 로그인 시 계정 자동 생성 (JIT)
+	AllowedDomains  []string  `json:"allowed_domains"` // JIT 생성 허용 이메일 도메인
+	Enabled         bool      `json:"enabled"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// SsoState authorize 요청 ~ callback 사이 상태 (CSRF state + id_token nonce)
+type SsoState struct {
+	State     string    `json:"state"`
+	Nonce     string    `json:"nonce"`
+	CompanyID uint      `json:"company_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
@@ -0,0 +1,14 @@
+package repository
+
+import "link/internal/sso/entity"
+
+type SsoRepository interface {
+	//TODO 회사 SSO 설정
+	GetCompanySso(companyId uint) (*entity.CompanySso, error)
+	UpsertCompanySso(sso *entity.CompanySso) error
+	DeleteCompanySso(companyId uint) error
+
+	//TODO 로그인 요청 상태 (redis)
+	StoreSsoState(state *entity.SsoState) error
+	ConsumeSsoState(state string) (*entity.SsoState, error)
+}
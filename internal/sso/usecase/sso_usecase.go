@@ -0,0 +1,479 @@
+package usecase
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	_authEntity "link/internal/auth/entity"
+	_authUsecase "link/internal/auth/usecase"
+	_chatRepo "link/internal/chat/repository"
+	_companyRepo "link/internal/company/repository"
+	_departmentRepo "link/internal/department/repository"
+	"link/internal/sso/entity"
+	_ssoRepo "link/internal/sso/repository"
+	_userEntity "link/internal/user/entity"
+	_userRepo "link/internal/user/repository"
+	"link/pkg/common"
+	"link/pkg/dto/req"
+	"link/pkg/dto/res"
+	"link/pkg/sso"
+	_utils "link/pkg/util"
+)
+
+const ssoStateExp = time.Minute * 10
+
+var defaultSsoScopes = []string{"openid", "email", "profile"}
+
+type SsoUsecase interface {
+	//TODO 회사 관리자 - IdP 설정
+	GetCompanySso(requestUserId uint) (*res.GetCompanySsoResponse, error)
+	UpdateCompanySso(requestUserId uint, request *req.UpdateCompanySsoRequest) (*res.GetCompanySsoResponse, error)
+	DeleteCompanySso(requestUserId uint) error
+
+	//TODO SSO 로그인
+	StartSso(companyId uint) (string, error)
+	CompleteSso(code string, state string, ip string, userAgent string) (*res.LoginUserResponse, *_authEntity.Token, error)
+}
+
+type ssoUsecase struct {
+	ssoRepo        _ssoRepo.SsoRepository
+	userRepo       _userRepo.UserRepository
+	companyRepo    _companyRepo.CompanyRepository
+	departmentRepo _departmentRepo.DepartmentRepository
+	chatRepo       _chatRepo.ChatRepository
+	authUsecase    _authUsecase.AuthUsecase
+	oidcClient     *sso.OidcClient
+}
+
+func NewSsoUsecase(ssoRepo _ssoRepo.SsoRepository,
+	userRepo _userRepo.UserRepository,
+	companyRepo _companyRepo.CompanyRepository,
+	departmentRepo _departmentRepo.DepartmentRepository,
+	chatRepo _chatRepo.ChatRepository,
+	authUsecase _authUsecase.AuthUsecase,
+	oidcClient *sso.OidcClient) SsoUsecase {
+	return &ssoUsecase{
+		ssoRepo:        ssoRepo,
+		userRepo:       userRepo,
+		companyRepo:    companyRepo,
+		departmentRepo: departmentRepo,
+		chatRepo:       chatRepo,
+		authUsecase:    authUsecase,
+		oidcClient:     oidcClient,
+	}
+}
+
+// IdP에 등록할 콜백 주소 (SSO_REDIRECT_URI)
+func ssoRedirectURI() string {
+	if uri := os.Getenv("SSO_REDIRECT_URI"); uri != "" {
+		return uri
+	}
+	return "http://localhost:8080/api/auth/sso/callback"
+}
+
+// TODO 회사 SSO 설정 조회
+func (u *ssoUsecase) GetCompanySso(requestUserId uint) (*res.GetCompanySsoResponse, error) {
+	companyId, err := u.requestUserCompanyId(requestUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := u.ssoRepo.GetCompanySso(companyId)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "SSO 설정 조회에 실패했습니다", err)
+	}
+	if config == nil {
+		return nil, common.NewError(http.StatusNotFound, "SSO 설정이 없습니다", nil)
+	}
+
+	return toCompanySsoResponse(config), nil
+}
+
+// TODO 회사 SSO 설정 저장 - 저장 전에 discovery 조회로 issuer 검증
+func (u *ssoUsecase) UpdateCompanySso(requestUserId uint, request *req.UpdateCompanySsoRequest) (*res.GetCompanySsoResponse, error) {
+	companyId, err := u.requestUserCompanyId(requestUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Protocol == entity.SsoProtocolSAML {
+		return nil, common.NewError(http.StatusBadRequest, "SAML은 아직 지원하지 않습니다", nil)
+	}
+
+	existing, err := u.ssoRepo.GetCompanySso(companyId)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "SSO 설정 조회에 실패했습니다", err)
+	}
+
+	config := &entity.CompanySso{
+		CompanyID:       companyId,
+		Protocol:        request.Protocol,
+		Issuer:          strings.TrimRight(request.Issuer, "/"),
+		ClientID:        request.ClientID,
+		ClientSecret:    request.ClientSecret,
+		Scopes:          request.Scopes,
+		EmailClaim:      request.EmailClaim,
+		NameClaim:       request.NameClaim,
+		DepartmentClaim: request.DepartmentClaim,
+		PositionClaim:   request.PositionClaim,
+		AutoProvision:   request.AutoProvision,
+		AllowedDomains:  normalizeEmailDomains(request.AllowedDomains),
+		Enabled:         _utils.GetValueOrDefault(request.Enabled, true),
+	}
+	if config.ClientSecret == "" && existing != nil {
+		config.ClientSecret = existing.ClientSecret
+	}
+	if len(config.Scopes) == 0 {
+		config.Scopes = defaultSsoScopes
+	}
+	if config.EmailClaim == "" {
+		config.EmailClaim = "email"
+	}
+	if config.NameClaim == "" {
+		config.NameClaim = "name"
+	}
+	// IdP가 어떤 이메일이든 발급할 수 있으므로 로그인/JIT 생성 모두 허용 도메인을 지정해야 사용 가능
+	if len(config.AllowedDomains) == 0 {
+		return nil, common.NewError(http.StatusBadRequest, "허용 이메일 도메인을 지정해야 합니다", nil)
+	}
+
+	if _, err := u.oidcClient.Discover(config.Issuer); err != nil {
+		log.Printf("OIDC discovery 실패: %v", err)
+		return nil, common.NewError(http.StatusBadRequest, "IdP 정보를 확인할 수 없습니다. issuer를 확인해주세요", err)
+	}
+
+	if err := u.ssoRepo.UpsertCompanySso(config); err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "SSO 설정 저장에 실패했습니다", err)
+	}
+
+	saved, err := u.ssoRepo.GetCompanySso(companyId)
+	if err != nil || saved == nil {
+		return nil, common.NewError(http.StatusInternalServerError, "SSO 설정 조회에 실패했습니다", err)
+	}
+	return toCompanySsoResponse(saved), nil
+}
+
+// TODO 회사 SSO 설정 삭제
+func (u *ssoUsecase) DeleteCompanySso(requestUserId uint) error {
+	companyId, err := u.requestUserCompanyId(requestUserId)
+	if err != nil {
+		return err
+	}
+
+	if err := u.ssoRepo.DeleteCompanySso(companyId); err != nil {
+		return common.NewError(http.StatusInternalServerError, "SSO 설정 삭제에 실패했습니다", err)
+	}
+	return nil
+}
+
+// TODO SSO 로그인 시작 - IdP 로그인 페이지 주소 반환
+func (u *ssoUsecase) StartSso(companyId uint) (string, error) {
+	config, err := u.enabledCompanySso(companyId)
+	if err != nil {
+		return "", err
+	}
+
+	state, err := randomToken()
+	if err != nil {
+		return "", common.NewError(http.StatusInternalServerError, "SSO 요청 생성에 실패했습니다", err)
+	}
+	nonce, err := randomToken()
+	if err != nil {
+		return "", common.NewError(http.StatusInternalServerError, "SSO 요청 생성에 실패했습니다", err)
+	}
+
+	if err := u.ssoRepo.StoreSsoState(&entity.SsoState{
+		State:     state,
+		Nonce:     nonce,
+		CompanyID: companyId,
+		ExpiresAt: time.Now().Add(ssoStateExp),
+	}); err != nil {
+		return "", common.NewError(http.StatusInternalServerError, "SSO 요청 생성에 실패했습니다", err)
+	}
+
+	authUrl, err := u.oidcClient.AuthCodeURL(providerConfig(config), state, nonce)
+	if err != nil {
+		log.Printf("OIDC authorize 주소 생성 실패: %v", err)
+		return "", common.NewError(http.StatusBadGateway, "IdP에 연결할 수 없습니다", err)
+	}
+	return authUrl, nil
+}
+
+// TODO SSO 콜백 - code 교환, id_token 검증, 필요 시 계정 생성(JIT) 후 로그인
+func (u *ssoUsecase) CompleteSso(code string, state string, ip string, userAgent string) (*res.LoginUserResponse, *_authEntity.Token, error) {
+	if code == "" || state == "" {
+		return nil, nil, common.NewError(http.StatusBadRequest, "잘못된 SSO 응답입니다", nil)
+	}
+
+	ssoState, err := u.ssoRepo.ConsumeSsoState(state)
+	if err != nil {
+		return nil, nil, common.NewError(http.StatusInternalServerError, "SSO 요청 조회에 실패했습니다", err)
+	}
+	if ssoState == nil {
+		return nil, nil, common.NewError(http.StatusBadRequest, "만료되었거나 유효하지 않은 SSO 요청입니다", nil)
+	}
+
+	config, err := u.enabledCompanySso(ssoState.CompanyID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	provider := providerConfig(config)
+	idToken, err := u.oidcClient.Exchange(provider, code)
+	if err != nil {
+		log.Printf("OIDC 토큰 교환 실패: %v", err)
+		return nil, nil, common.NewError(http.StatusUnauthorized, "IdP 인증에 실패했습니다", err)
+	}
+
+	claims, err := u.oidcClient.VerifyIDToken(provider, idToken, ssoState.Nonce)
+	if err != nil {
+		log.Printf("OIDC id_token 검증 실패: %v", err)
+		return nil, nil, common.NewError(http.StatusUnauthorized, "IdP 인증에 실패했습니다", err)
+	}
+
+	// email_verified가 명시적으로 false면 거부
+	if verified, ok := claims["email_verified"].(bool); ok && !verified {
+		return nil, nil, common.NewError(http.StatusForbidden, "IdP에서 이메일 인증이 되지 않은 계정입니다", nil)
+	}
+
+	email := strings.ToLower(strings.TrimSpace(claimString(claims, config.EmailClaim)))
+	if email == "" {
+		return nil, nil, common.NewError(http.StatusBadRequest, "IdP 응답에 이메일이 없습니다", nil)
+	}
+
+	// 기존 사용자 로그인, JIT 생성 모두 허용 도메인만 (설정에 도메인이 없으면 SSO 로그인 불가)
+	if !isAllowedEmailDomain(config.AllowedDomains, email) {
+		log.Printf("허용되지 않은 도메인의 SSO 로그인 시도: %s (company %d)", email, config.CompanyID)
+		return nil, nil, common.NewError(http.StatusForbidden, "허용되지 않은 이메일 도메인입니다. 회사 관리자에게 문의하세요", nil)
+	}
+
+	user, err := u.userRepo.ValidateEmail(email)
+	if err != nil {
+		return nil, nil, common.NewError(http.StatusInternalServerError, "사용자 조회에 실패했습니다", err)
+	}
+
+	if user == nil {
+		if !config.AutoProvision {
+			return nil, nil, common.NewError(http.StatusForbidden, "등록되지 않은 사용자입니다. 회사 관리자에게 문의하세요", nil)
+		}
+		if err := u.provisionUser(config, email, claims); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		existing, err := u.userRepo.GetUserByEmail(email)
+		if err != nil {
+			return nil, nil, common.NewError(http.StatusInternalServerError, "사용자 조회에 실패했습니다", err)
+		}
+		if existing.UserProfile == nil || existing.UserProfile.CompanyID == nil || *existing.UserProfile.CompanyID != config.CompanyID {
+			log.Printf("다른 회사 소속 사용자의 SSO 로그인 시도: %s (company %d)", email, config.CompanyID)
+			return nil, nil, common.NewError(http.StatusForbidden, "해당 회사 소속 계정이 아닙니다", nil)
+		}
+		if !canSignInWithSso(existing.Role) {
+			log.Printf("관리자 계정의 SSO 로그인 시도: %s (company %d)", email, config.CompanyID)
+			return nil, nil, common.NewError(http.StatusForbidden, "관리자 계정은 SSO로 로그인할 수 없습니다", nil)
+		}
+	}
+
+	return u.authUsecase.SignInExternalUser(email, ip, userAgent)
+}
+
+// JIT 프로비저닝 - IdP 클레임으로 일반 사용자 계정 생성, 부서/직책은 이름이 같은 항목에 매핑
+func (u *ssoUsecase) provisionUser(config *entity.CompanySso, email string, claims map[string]interface{}) error {
+	name := claimString(claims, config.NameClaim)
+	if name == "" {
+		name = strings.Split(email, "@")[0]
+	}
+
+	// 비밀번호 로그인은 사용하지 않으므로 임의 값 (비밀번호 재설정으로 설정 가능)
+	randomPassword, err := randomToken()
+	if err != nil {
+		return common.NewError(http.StatusInternalServerError, "사용자 생성에 실패했습니다", err)
+	}
+	hashedPassword, err := _utils.HashPassword(randomPassword)
+	if err != nil {
+		return common.NewError(http.StatusInternalServerError, "비밀번호 해쉬화에 실패했습니다", err)
+	}
+
+	companyId := config.CompanyID
+	status := _userEntity.UserStatusActive
+	empty := ""
+	user := &_userEntity.User{
+		Name:     &name,
+		Email:    &email,
+		Password: &hashedPassword,
+		Nickname: &empty,
+		Phone:    &empty,
+		Status:   &status,
+		Role:     _userEntity.RoleUser,
+		UserProfile: &_userEntity.UserProfile{
+			CompanyID:    &companyId,
+			IsSubscribed: false,
+		},
+	}
+	if err := u.userRepo.CreateUser(user); err != nil {
+		log.Printf("SSO 사용자 생성 오류: %v", err)
+		return common.NewError(http.StatusInternalServerError, "사용자 생성에 실패했습니다", err)
+	}
+	log.Printf("SSO 사용자 자동 생성: %s (company %d)", email, companyId)
+
+	if user.ID == nil {
+		return nil
+	}
+
+	if err := u.chatRepo.SyncCompanyAnnouncementChatRooms(*user.ID, &companyId); err != nil {
+		log.Printf("SSO 공지 채팅방 참여 오류: %v", err)
+	}
+
+	//매핑 실패는 로그인 자체를 막지 않음
+	if departmentName := claimString(claims, config.DepartmentClaim); departmentName != "" {
+		departments, err := u.departmentRepo.GetDepartments(companyId)
+		if err != nil {
+			log.Printf("SSO 부서 매핑 - 부서 조회 오류: %v", err)
+		}
+		for _, department := range departments {
+			if strings.EqualFold(department.Name, departmentName) {
+				if err := u.userRepo.UpdateUserDepartments(*user.ID, []uint{department.ID}); err != nil {
+					log.Printf("SSO 부서 매핑 오류: %v", err)
+				}
+				break
+			}
+		}
+	}
+
+	if positionName := claimString(claims, config.PositionClaim); positionName != "" {
+		positions, err := u.companyRepo.GetCompanyPositionList(companyId)
+		if err != nil {
+			log.Printf("SSO 직책 매핑 - 직책 조회 오류: %v", err)
+		}
+		for _, position := range positions {
+			if strings.EqualFold(position.Name, positionName) {
+				if err := u.userRepo.UpdateUser(*user.ID, nil, map[string]interface{}{"position_id": position.ID}); err != nil {
+					log.Printf("SSO 직책 매핑 오류: %v", err)
+				}
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+func (u *ssoUsecase) enabledCompanySso(companyId uint) (*entity.CompanySso, error) {
+	config, err := u.ssoRepo.GetCompanySso(companyId)
+	if err != nil {
+		return nil, common.NewError(http.StatusInternalServerError, "SSO 설정 조회에 실패했습니다", err)
+	}
+	if config == nil || !config.Enabled {
+		return nil, common.NewError(http.StatusNotFound, "SSO를 사용하지 않는 회사입니다", nil)
+	}
+	if config.Protocol != entity.SsoProtocolOIDC {
+		return nil, common.NewError(http.StatusNotImplemented, "지원하지 않는 SSO 방식입니다", nil)
+	}
+	return config, nil
+}
+
+func (u *ssoUsecase) requestUserCompanyId(requestUserId uint) (uint, error) {
+	user, err := u.userRepo.GetUserByID(requestUserId)
+	if err != nil {
+		return 0, common.NewError(http.StatusBadRequest, "존재 하지 않는 사용자 입니다", err)
+	}
+	if user.UserProfile == nil || user.UserProfile.CompanyID == nil {
+		return 0, common.NewError(http.StatusBadRequest, "회사가 존재하지 않습니다", nil)
+	}
+	return *user.UserProfile.CompanyID, nil
+}
+
+func providerConfig(config *entity.CompanySso) *sso.ProviderConfig {
+	return &sso.ProviderConfig{
+		Issuer:       config.Issuer,
+		ClientID:     config.ClientID,
+		ClientSecret: config.ClientSecret,
+		RedirectURI:  ssoRedirectURI(),
+		Scopes:       config.Scopes,
+	}
+}
+
+func toCompanySsoResponse(config *entity.CompanySso) *res.GetCompanySsoResponse {
+	return &res.GetCompanySsoResponse{
+		CompanyID:       config.CompanyID,
+		Protocol:        config.Protocol,
+		Issuer:          config.Issuer,
+		ClientID:        config.ClientID,
+		HasClientSecret: config.ClientSecret != "",
+		Scopes:          config.Scopes,
+		EmailClaim:      config.EmailClaim,
+		NameClaim:       config.NameClaim,
+		DepartmentClaim: config.DepartmentClaim,
+		PositionClaim:   config.PositionClaim,
+		AutoProvision:   config.AutoProvision,
+		AllowedDomains:  config.AllowedDomains,
+		Enabled:         config.Enabled,
+		RedirectURI:     ssoRedirectURI(),
+		UpdatedAt:       config.UpdatedAt,
+	}
+}
+
+// 허용 도메인 정규화 - 소문자, 앞의 "@" 제거, 중복 제거
+func normalizeEmailDomains(domains []string) []string {
+	normalized := make([]string, 0, len(domains))
+	seen := make(map[string]bool)
+	for _, domain := range domains {
+		domain = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(domain), "@"))
+		if domain == "" || seen[domain] {
+			continue
+		}
+		seen[domain] = true
+		normalized = append(normalized, domain)
+	}
+	return normalized
+}
+
+// 회사 IdP로는 회사 범위 권한까지만 - 시스템 관리자 계정은 SSO 로그인 불가
+func canSignInWithSso(role _userEntity.UserRole) bool {
+	return role >= _userEntity.RoleCompanyManager
+}
+
+// 이메일 도메인이 허용 목록과 정확히 일치하는지 (서브도메인은 별도 등록 필요)
+func isAllowedEmailDomain(domains []string, email string) bool {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+	emailDomain := email[at+1:]
+	for _, domain := range domains {
+		if emailDomain == domain {
+			return true
+		}
+	}
+	return false
+}
+
+// 클레임 값 - 문자열 또는 배열(groups 등)이면 첫 번째 값
+func claimString(claims map[string]interface{}, claim string) string {
+	if claim == "" {
+		return ""
+	}
+	switch value := claims[claim].(type) {
+	case string:
+		return value
+	case []interface{}:
+		if len(value) > 0 {
+			return fmt.Sprint(value[0])
+		}
+	}
+	return ""
+}
+
+func randomToken() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"reflect"
+	"testing"
+
+	_userEntity "link/internal/user/entity"
+)
+
+func TestNormalizeEmailDomains(t *testing.T) {
+	got := normalizeEmailDomains([]string{" Example.com ", "@example.com", "", "  ", "corp.example.com", "EXAMPLE.COM"})
+	want := []string{"example.com", "corp.example.com"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("normalizeEmailDomains() = %v, want %v", got, want)
+	}
+
+	if got := normalizeEmailDomains(nil); len(got) != 0 {
+		t.Errorf("normalizeEmailDomains(nil) = %v, want 빈 목록", got)
+	}
+}
+
+func TestIsAllowedEmailDomain(t *testing.T) {
+	domains := normalizeEmailDomains([]string{"example.com", "@corp.example.com"})
+
+	tests := []struct {
+		name  string
+		email string
+		want  bool
+	}{
+		{"허용 도메인", "user@example.com", true},
+		{"등록한 서브도메인", "user@corp.example.com", true},
+		{"등록하지 않은 서브도메인", "user@dev.example.com", false},
+		{"도메인 뒤에 붙인 다른 도메인", "user@example.com.evil.com", false},
+		{"도메인 앞에 붙인 다른 도메인", "user@evilexample.com", false},
+		{"@가 여러 개면 마지막 기준", "user@example.com@evil.com", false},
+		{"@ 없음", "example.com", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isAllowedEmailDomain(domains, tt.email); got != tt.want {
+				t.Errorf("isAllowedEmailDomain(%q) = %v, want %v", tt.email, got, tt.want)
+			}
+		})
+	}
+
+	// 허용 도메인이 없으면 모두 거부
+	if isAllowedEmailDomain(nil, "user@example.com") {
+		t.Error("허용 도메인이 없으면 거부해야 합니다")
+	}
+}
+
+func TestCanSignInWithSso(t *testing.T) {
+	tests := []struct {
+		role _userEntity.UserRole
+		want bool
+	}{
+		{_userEntity.RoleAdmin, false},
+		{_userEntity.RoleSubAdmin, false},
+		{_userEntity.RoleCompanyManager, true},
+		{_userEntity.RoleCompanySubManager, true},
+		{_userEntity.RoleUser, true},
+	}
+
+	for _, tt := range tests {
+		if got := canSignInWithSso(tt.role); got != tt.want {
+			t.Errorf("canSignInWithSso(%d) = %v, want %v", tt.role, got, tt.want)
+		}
+	}
+}
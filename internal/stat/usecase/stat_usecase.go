@@ -12,6 +12,7 @@ import (
 	_postRepo "link/internal/post/repository"
 	_statRepo "link/internal/stat/repository"
 	_userRepo "link/internal/user/repository"
+	_util "link/pkg/util"
 )
 
 type StatUsecase interface {
@@ -22,6 +23,7 @@ type StatUsecase interface {
 	GetUserRoleStat(requestUserId uint) (*res.GetUserRoleStatResponse, error)
 
 	GetPopularPostStat(companyId uint, period string, visibility string) (*res.GetPopularPostStatResponse, error)
+	GetPostViewFlushStat(requestUserId uint) (*res.GetPostViewFlushStatResponse, error)
 }
 
 type statUsecase struct {
@@ -241,3 +243,41 @@ func (uc *statUsecase) GetPopularPostStat(requestUserId uint, period string, vis
 
 	return response, nil
 }
+
+// TODO 조회수 DB 반영 워커 상태 (관리자용)
+func (uc *statUsecase) GetPostViewFlushStat(requestUserId uint) (*res.GetPostViewFlushStatResponse, error) {
+	requestUser, err := uc.userRepo.GetUserByID(requestUserId)
+	if err != nil {
+		fmt.Printf("사용자 조회에 실패했습니다: %v", err)
+		return nil, common.NewError(http.StatusBadRequest, "사용자 조회에 실패했습니다", err)
+	}
+
+	if requestUser.Role != 1 && requestUser.Role != 2 {
+		fmt.Printf("권한이 없는 사용자가 조회수 반영 상태를 조회하려 했습니다: 요청자 ID %d", requestUserId)
+		return nil, common.NewError(http.StatusForbidden, "권한이 없습니다", err)
+	}
+
+	flushStat, err := uc.postRepo.GetPostViewFlushStat()
+	if err != nil {
+		fmt.Printf("조회수 반영 상태 조회 실패: %v", err)
+		return nil, common.NewError(http.StatusInternalServerError, "조회수 반영 상태 조회 실패", err)
+	}
+
+	response := &res.GetPostViewFlushStatResponse{
+		LastDurationMs:  flushStat.LastDurationMs,
+		LastFlushedPost: flushStat.LastFlushedPost,
+		LastFlushedView: flushStat.LastFlushedView,
+		LastError:       flushStat.LastError,
+	}
+
+	if !flushStat.LastRunAt.IsZero() {
+		response.LastRunAt = _util.ParseKst(flushStat.LastRunAt).Format(time.DateTime)
+	}
+	//TODO 마지막 성공 이후 경과 시간 = 반영 지연
+	if !flushStat.LastSuccessAt.IsZero() {
+		response.LastSuccessAt = _util.ParseKst(flushStat.LastSuccessAt).Format(time.DateTime)
+		response.LagSeconds = int64(time.Since(flushStat.LastSuccessAt).Seconds())
+	}
+
+	return response, nil
+}
@@ -14,6 +14,14 @@ const (
 	RoleUser                                  // 5: 일반 사용자
 )
 
+// 사용자 상태
+const (
+	UserStatusActive    = "active"
+	UserStatusSuspended = "suspended"
+	UserStatusPending   = "pending" // 이메일 인증 대기
+	UserStatusLocked    = "locked"  // 로그인 실패 반복으로 임시 잠금
+)
+
 type User struct {
 	ID            *uint                    `json:"id,omitempty"`
 	Name          *string                  `json:"name,omitempty" `
@@ -30,6 +38,15 @@ type User struct {
 	ChatRoomUsers []map[string]interface{} `json:"chat_room_users,omitempty"`
 }
 
+// UserTotp 2단계 인증 정보 (캐시하지 않고 DB에서 직접 조회)
+type UserTotp struct {
+	UserId        uint
+	Enabled       bool
+	Secret        string
+	RecoveryCodes []string // sha256 해시
+	EnabledAt     *time.Time
+}
+
 type UserProfile struct {
 	UserId       uint                      `json:"user_id"`
 	Image        *string                   `json:"image,omitempty"`
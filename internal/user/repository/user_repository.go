@@ -24,6 +24,10 @@ type UserRepository interface {
 	UpdateUserDepartments(userId uint, departmentIds []uint) error
 	// GetOrganizationByCompany(companyId uint) ([]entity.User, error)
 
+	//TODO 2단계 인증
+	GetUserTotp(userId uint) (*entity.UserTotp, error)
+	UpdateUserTotp(totp *entity.UserTotp) error
+
 	//관리자 관련
 	AdminSearchUser(searchTerm string) ([]entity.User, error)
 	//TODO 부서
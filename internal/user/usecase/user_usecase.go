@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"time"
 
+	_chatRepo "link/internal/chat/repository"
 	_companyRepo "link/internal/company/repository"
 	"link/internal/user/entity"
 	_userRepo "link/internal/user/repository"
@@ -42,11 +43,12 @@ type UserUsecase interface {
 type userUsecase struct {
 	userRepo    _userRepo.UserRepository
 	companyRepo _companyRepo.CompanyRepository
+	chatRepo    _chatRepo.ChatRepository
 }
 
 // NewUserUsecase 생성자
-func NewUserUsecase(repo _userRepo.UserRepository, companyRepo _companyRepo.CompanyRepository) UserUsecase {
-	return &userUsecase{userRepo: repo, companyRepo: companyRepo}
+func NewUserUsecase(repo _userRepo.UserRepository, companyRepo _companyRepo.CompanyRepository, chatRepo _chatRepo.ChatRepository) UserUsecase {
+	return &userUsecase{userRepo: repo, companyRepo: companyRepo, chatRepo: chatRepo}
 }
 
 // TODO 사용자 생성 - 무조건 일반 사용자
@@ -57,12 +59,16 @@ func (u *userUsecase) RegisterUser(request *req.RegisterUserRequest) (*res.Regis
 		fmt.Printf("비밀번호 해싱 오류: %v", err)
 		return nil, common.NewError(http.StatusInternalServerError, "비밀번호 해쉬화에 실패했습니다", err)
 	}
+
+	//TODO 이메일 인증 전까지는 로그인 불가
+	status := entity.UserStatusPending
 	user := &entity.User{
 		Name:     &request.Name,
 		Email:    &request.Email,
 		Password: &hashedPassword,
 		Nickname: &request.Nickname,
 		Phone:    &request.Phone,
+		Status:   &status,
 		Role:     entity.RoleUser,
 		UserProfile: &entity.UserProfile{
 			IsSubscribed: false,
@@ -276,6 +282,13 @@ func (u *userUsecase) UpdateUserInfo(targetUserId, requestUserId uint, request *
 		return common.NewError(http.StatusInternalServerError, "사용자 업데이트에 실패했습니다", err)
 	}
 
+	//TODO 회사가 바뀌면 회사 공지 채팅방 참여 - 실패해도 사용자 업데이트는 유지
+	if request.CompanyID != nil {
+		if err := u.chatRepo.SyncCompanyAnnouncementChatRooms(targetUserId, request.CompanyID); err != nil {
+			fmt.Printf("공지 채팅방 참여 처리 중 DB 오류: %v", err)
+		}
+	}
+
 	return nil
 }
 
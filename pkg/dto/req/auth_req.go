@@ -4,3 +4,50 @@ type LoginRequest struct {
 	Email    string `json:"email" binding:"required,email"`
 	Password string `json:"password" binding:"required"`
 }
+
+// 인증 메일 재발송 / 비밀번호 재설정 메일 요청
+type EmailRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required"`
+}
+
+// 로그인 2단계 인증 - code(OTP 6자리) 또는 recovery_code 중 하나
+type MfaVerifyRequest struct {
+	MfaToken     string `json:"mfa_token" binding:"required"`
+	Code         string `json:"code,omitempty"`
+	RecoveryCode string `json:"recovery_code,omitempty"`
+}
+
+type MfaTokenRequest struct {
+	MfaToken string `json:"mfa_token" binding:"required"`
+}
+
+type MfaEnrollConfirmRequest struct {
+	MfaToken string `json:"mfa_token" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+}
+
+type TotpCodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// 개인 액세스 토큰 발급 - expires_in_days가 0이면 만료 없음 (폐기 전까지 유효)
+type CreateAccessTokenRequest struct {
+	Name          string   `json:"name" binding:"required,max=100"`
+	Scopes        []string `json:"scopes" binding:"required,min=1"`
+	ExpiresInDays int      `json:"expires_in_days,omitempty" binding:"omitempty,min=0,max=3650"`
+}
+
+// 회사 관리자가 소속 사용자(봇 계정 등)에게 토큰 발급
+type CreateUserAccessTokenRequest struct {
+	UserID uint `json:"user_id" binding:"required"`
+	CreateAccessTokenRequest
+}
@@ -1,10 +1,55 @@
 package req
 
-import "github.com/google/uuid"
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
 
 type CreateBoardRequest struct {
-	Title     string `json:"title" binding:"required"`
-	ProjectID uint   `json:"project_id" binding:"required"`
+	Title      string `json:"title" binding:"required"`
+	ProjectID  uint   `json:"project_id" binding:"required"`
+	TemplateID *uint  `json:"template_id"` // 없으면 기본 컬럼 (To Do, In Progress, Done)
+}
+
+// 보드 템플릿 생성 - 카드의 column_index, label_indexes는 columns, labels 배열의 순서
+type CreateBoardTemplateRequest struct {
+	Name        string                       `json:"name" binding:"required"`
+	Description string                       `json:"description"`
+	Columns     []BoardTemplateColumnRequest `json:"columns" binding:"required"`
+	Labels      []BoardTemplateLabelRequest  `json:"labels"`
+	Cards       []BoardTemplateCardRequest   `json:"cards"`
+}
+
+type BoardTemplateColumnRequest struct {
+	Name     string `json:"name"`
+	WipLimit uint   `json:"wip_limit"` // 0이면 제한 없음
+}
+
+type BoardTemplateLabelRequest struct {
+	Name  string `json:"name"`
+	Color string `json:"color"` // #RRGGBB
+}
+
+type BoardTemplateCardRequest struct {
+	ColumnIndex  int    `json:"column_index"`
+	Name         string `json:"name"`
+	Content      string `json:"content"`
+	LabelIndexes []int  `json:"label_indexes"`
+}
+
+// 기존 보드를 템플릿으로 저장 - include_cards면 카드도 예시 카드로 저장 (담당자, 일정, 체크리스트는 제외)
+type SaveBoardTemplateRequest struct {
+	Name         string `json:"name" binding:"required"`
+	Description  string `json:"description"`
+	IncludeCards bool   `json:"include_cards"`
+}
+
+// 보드를 다른 프로젝트로 복사 - keep_assignees면 대상 프로젝트에 속한 담당자만 유지
+type CopyBoardRequest struct {
+	ProjectID     uint   `json:"project_id" binding:"required"`
+	Title         string `json:"title"` // 없으면 "<원본 제목> (복사본)"
+	KeepAssignees bool   `json:"keep_assignees"`
 }
 
 type UpdateBoardRequest struct {
@@ -30,4 +75,54 @@ type Change struct {
 	EndDate   *string    `json:"end_date"`
 	Version   *uint      `json:"version"`
 	Assignees []uint     `json:"assignees"`
+	WipLimit  *uint      `json:"wip_limit"` // 컬럼 진행 중 카드 수 제한 (0이면 제한 없음)
+
+	ContentVersion *uint `json:"content_version"` // 카드 내용(content) 수정 시 필수
+
+	// type이 label | comment | checklist | checklist_item | attachment 일 때 대상 ID
+	// 댓글, 체크리스트, 첨부 파일은 card_id, 체크리스트 항목은 checklist_id에 속함
+	LabelID      *uuid.UUID  `json:"label_id"`
+	CommentID    *uuid.UUID  `json:"comment_id"`
+	ParentID     *uuid.UUID  `json:"parent_id"` // 답글이면 원 댓글 ID
+	ChecklistID  *uuid.UUID  `json:"checklist_id"`
+	ItemID       *uuid.UUID  `json:"item_id"`
+	AttachmentID *uuid.UUID  `json:"attachment_id"`
+	Labels       []uuid.UUID `json:"labels"`   // 카드 라벨 (빈 배열이면 전체 해제, 생략하면 그대로)
+	Mentions     []uint      `json:"mentions"` // 댓글에서 @멘션한 사용자
+	Color        *string     `json:"color"`    // #RRGGBB
+	Checked      *bool       `json:"checked"`
+	URL          *string     `json:"url"` // 첨부 파일 업로드 API 응답의 url
+	Size         *int64      `json:"size"`
+	ContentType  *string     `json:"content_type"`
+}
+
+// CardDocumentRequest /ws/board 카드 협업 편집 메시지
+// type: card.doc.open | card.doc.op | card.doc.cursor | card.doc.close
+// operation은 ot.js TextOperation JSON, revision은 연산/커서가 기준으로 한 리비전
+type CardDocumentRequest struct {
+	Type      string          `json:"type"`
+	CardID    uuid.UUID       `json:"card_id"`
+	ClientID  string          `json:"client_id"`
+	Session   string          `json:"session"`
+	Revision  int             `json:"revision"`
+	Operation json.RawMessage `json:"operation,omitempty"`
+	Anchor    *int            `json:"anchor,omitempty"`
+	Head      *int            `json:"head,omitempty"`
+}
+
+// 카드 첨부 파일 업로드 결과 (자동 저장 attachment create로 카드에 연결)
+type BoardAttachmentRequest struct {
+	URL         string
+	Name        string
+	Size        int64
+	ContentType string
+}
+
+// 보드/카드 활동 조회 - cursor는 이전 페이지의 next_cursor
+type GetBoardActivitiesQueryParams struct {
+	UserID     uint   `query:"user_id,omitempty"`     // 작업자
+	Action     string `query:"action,omitempty"`      // create | update | delete | move
+	TargetType string `query:"target_type,omitempty"` // column | card
+	Cursor     string `query:"cursor,omitempty"`
+	Limit      int    `query:"limit" default:"20"`
 }
@@ -2,6 +2,7 @@ package req
 
 type ChatCursor struct {
 	CreatedAt string `json:"created_at,omitempty"`
+	Direction string `json:"direction,omitempty"` // 비어있으면 이전 메시지, newer: 이후 메시지
 }
 
 type CreateChatRoomRequest struct {
@@ -11,10 +12,42 @@ type CreateChatRoomRequest struct {
 }
 
 type SendMessageRequest struct {
-	SenderID uint   `json:"sender_id"`
-	Content  string `json:"content"`
-	RoomID   uint   `json:"chat_room_id"`
-	Type     string `json:"type"`
+	SenderID  uint   `json:"sender_id"`
+	Content   string `json:"content" form:"content"`
+	RoomID    uint   `json:"chat_room_id"`
+	Type      string `json:"type"`                               // 비어있으면 채팅, typing | read | delivered
+	MessageID string `json:"message_id,omitempty"`               // read: 여기까지 읽음, delivered: 수신한 메시지
+	Typing    *bool  `json:"typing,omitempty"`                   // typing: 입력 시작/종료
+	ReplyTo   string `json:"reply_to,omitempty" form:"reply_to"` // 답장 대상 메시지 ID
+	// 첨부 파일은 업로드 미들웨어를 거친 POST /api/chat/:chatroomid/messages 로만 보냄
+	Attachments []ChatAttachmentRequest `json:"-" form:"-"`
+}
+
+type ChatAttachmentRequest struct {
+	URL         string
+	Name        string
+	Size        int64
+	ContentType string
+}
+
+type EditChatMessageRequest struct {
+	ChatRoomID    uint   `json:"chat_room_id" binding:"required"`
+	ChatMessageID string `json:"chat_message_id" binding:"required"`
+	Content       string `json:"content" binding:"required"`
+}
+
+// 이모지 반응 추가/취소 (이모지는 게시글 좋아요와 같은 unified 코드)
+type ChatReactionRequest struct {
+	ChatRoomID    uint   `json:"chat_room_id" binding:"required"`
+	ChatMessageID string `json:"chat_message_id" binding:"required"`
+	Unified       string `json:"unified" binding:"required"`
+	Content       string `json:"content"`
+}
+
+// ChatSignalRequest /ws/v2 typing, read, delivered 프레임 payload (topic: chat:<roomId>)
+type ChatSignalRequest struct {
+	MessageID string `json:"message_id,omitempty"`
+	Typing    *bool  `json:"typing,omitempty"`
 }
 
 type DeleteChatMessageRequest struct {
@@ -26,4 +59,59 @@ type GetChatMessagesQueryParams struct {
 	Page   int         `query:"page" default:"1"`
 	Limit  int         `query:"limit" default:"10"`
 	Cursor *ChatCursor `query:"cursor,omitempty"`
+	Around string      `query:"around,omitempty"` // 이 메시지 주변 페이지 조회 (검색 결과에서 이동)
+}
+
+type SearchChatMessagesQueryParams struct {
+	Query      string `query:"q"`
+	ChatRoomID uint   `query:"chat_room_id,omitempty"`
+	SenderID   uint   `query:"sender_id,omitempty"`
+	From       string `query:"from,omitempty"` // 2006-01-02 또는 RFC3339
+	To         string `query:"to,omitempty"`   // 날짜만 주면 그날까지 포함
+	Page       int    `query:"page" default:"1"`
+	Limit      int    `query:"limit" default:"20"`
+}
+
+// 그룹 채팅방 이름 변경 (방장, 관리자)
+type RenameChatRoomRequest struct {
+	Name string `json:"name" binding:"required,max=50"`
+}
+
+// 사용자별 채팅방 별칭 변경 (본인에게만 보임)
+type UpdateChatRoomAliasRequest struct {
+	Alias string `json:"alias" binding:"required,max=50"`
+}
+
+type InviteChatRoomMembersRequest struct {
+	UserIDs []uint `json:"user_ids" binding:"required,min=1"`
+}
+
+type TransferChatRoomOwnerRequest struct {
+	UserID uint `json:"user_id" binding:"required"`
+}
+
+type UpdateChatRoomMemberRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=admin member"`
+}
+
+// 회사 채팅 보관 기간 (0 = 영구 보관, Pro 등급만 가능)
+type UpdateChatRetentionRequest struct {
+	RetentionDays *int `json:"retention_days" binding:"required,min=0,max=3650"`
+}
+
+// 법적 보존 조치 - chat_room_id가 없으면 회사 전체 채팅방
+type CreateChatLegalHoldRequest struct {
+	ChatRoomID *uint  `json:"chat_room_id,omitempty"`
+	Reason     string `json:"reason" binding:"required,max=255"`
+}
+
+// 회사 공지 채팅방 생성 (회사 관리자, 부관리자)
+type CreateAnnouncementChatRoomRequest struct {
+	Name string `json:"name" binding:"required,max=50"`
+}
+
+// 메시지 고정/해제
+type PinChatMessageRequest struct {
+	ChatRoomID    uint   `json:"chat_room_id" binding:"required"`
+	ChatMessageID string `json:"chat_message_id" binding:"required"`
 }
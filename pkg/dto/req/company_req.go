@@ -11,3 +11,19 @@ type CompanyPositionRequest struct {
 type UpdateCompanyPositionRequest struct {
 	Name string `json:"name"`
 }
+
+// 회사 SSO(IdP) 설정 - client_secret을 비우면 기존 값 유지
+type UpdateCompanySsoRequest struct {
+	Protocol        string   `json:"protocol" binding:"required,oneof=oidc saml"`
+	Issuer          string   `json:"issuer" binding:"required,url"`
+	ClientID        string   `json:"client_id" binding:"required"`
+	ClientSecret    string   `json:"client_secret,omitempty"`
+	Scopes          []string `json:"scopes,omitempty"`
+	EmailClaim      string   `json:"email_claim,omitempty"`
+	NameClaim       string   `json:"name_claim,omitempty"`
+	DepartmentClaim string   `json:"department_claim,omitempty"`
+	PositionClaim   string   `json:"position_claim,omitempty"`
+	AutoProvision   bool     `json:"auto_provision"`
+	AllowedDomains  []string `json:"allowed_domains,omitempty"` // auto_provision 시 필수
+	Enabled         *bool    `json:"enabled,omitempty"`
+}
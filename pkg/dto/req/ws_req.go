@@ -0,0 +1,11 @@
+package req
+
+import "encoding/json"
+
+// WsFrameRequest /ws/v2 클라이언트 -> 서버 프레임 (subscribe, unsubscribe, resume, ping)
+type WsFrameRequest struct {
+	Type    string          `json:"type"`
+	Topic   string          `json:"topic,omitempty"`
+	Seq     uint64          `json:"seq,omitempty"` // resume: 마지막으로 받은 seq
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
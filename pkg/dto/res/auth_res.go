@@ -1,5 +1,7 @@
 package res
 
+import _utils "link/pkg/util"
+
 type LoginUserResponse struct {
 	ID            uint   `json:"id" binding:"required"`
 	Name          string `json:"name" binding:"required"`
@@ -8,4 +10,67 @@ type LoginUserResponse struct {
 	CompanyID     uint   `json:"company_id,omitempty"`
 	ProfileImage  string `json:"profile_image,omitempty"`
 	DepartmentIds []uint `json:"department_ids,omitempty"`
+
+	// 2단계 인증 관련 - Mfa가 있으면 토큰이 발급되지 않은 상태
+	Mfa           *MfaChallengeResponse `json:"mfa,omitempty"`
+	RecoveryCodes []string              `json:"recovery_codes,omitempty"` // 로그인 중 최초 등록한 경우에만 1회 노출
+}
+
+// MfaChallengeResponse 비밀번호 확인 후 2단계 인증이 필요할 때 응답
+type MfaChallengeResponse struct {
+	MfaToken           string `json:"mfa_token"`
+	EnrollmentRequired bool   `json:"enrollment_required"`
+	ExpiresAt          string `json:"expires_at"`
+}
+
+type TotpEnrollmentResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"` // QR 코드로 변환해서 OTP 앱에 등록
+}
+
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type SessionResponse struct {
+	SessionId string `json:"session_id"`
+	IP        string `json:"ip,omitempty"`
+	UserAgent string `json:"user_agent,omitempty"`
+	IsCurrent bool   `json:"is_current"`
+	CreatedAt string `json:"created_at"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+// JWKSResponse /.well-known/jwks.json (RFC 7517 형식 그대로 응답)
+type JWKSResponse struct {
+	Keys []_utils.JWK `json:"keys"`
+}
+
+type AccessTokenResponse struct {
+	ID         uint     `json:"id"`
+	UserID     uint     `json:"user_id"`
+	CreatedBy  uint     `json:"created_by"`
+	Name       string   `json:"name"`
+	Prefix     string   `json:"prefix"`
+	Scopes     []string `json:"scopes"`
+	ExpiresAt  string   `json:"expires_at,omitempty"`
+	LastUsedAt string   `json:"last_used_at,omitempty"`
+	LastUsedIP string   `json:"last_used_ip,omitempty"`
+	RevokedAt  string   `json:"revoked_at,omitempty"`
+	CreatedAt  string   `json:"created_at"`
+}
+
+// 토큰 원문은 발급 응답에서 한 번만 제공
+type CreateAccessTokenResponse struct {
+	Token string `json:"token"`
+	AccessTokenResponse
+}
+
+type AccessTokenAuditResponse struct {
+	Action    string `json:"action"`
+	ActorID   uint   `json:"actor_id"`
+	IP        string `json:"ip,omitempty"`
+	UserAgent string `json:"user_agent,omitempty"`
+	Detail    string `json:"detail,omitempty"`
+	CreatedAt string `json:"created_at"`
 }
@@ -1,6 +1,7 @@
 package res
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -30,12 +31,15 @@ type GetKanbanBoardResponse struct {
 	UpdatedAt     time.Time                      `json:"updated_at"`
 	Columns       []GetKanbanBoardColumnResponse `json:"columns"`
 	BoardUsers    []GetKanbanBoardUserResponse   `json:"board_users"`
+	Labels        []BoardLabelResponse           `json:"labels"`
 }
 
 type GetKanbanBoardColumnResponse struct {
 	ID        uuid.UUID                    `json:"id"`
 	Name      string                       `json:"name"`
 	Position  uint                         `json:"position"`
+	WipLimit  uint                         `json:"wip_limit"` // 0이면 제한 없음
+	Version   int                          `json:"version"`
 	Cards     []GetKanbanBoardCardResponse `json:"cards"`
 	CreatedAt time.Time                    `json:"created_at"`
 	UpdatedAt time.Time                    `json:"updated_at"`
@@ -43,6 +47,7 @@ type GetKanbanBoardColumnResponse struct {
 
 type GetKanbanBoardCardResponse struct {
 	ID        uuid.UUID `json:"id"`
+	ColumnID  uuid.UUID `json:"column_id"`
 	Name      string    `json:"name"`
 	Content   string    `json:"content"`
 	Position  uint      `json:"position"`
@@ -52,6 +57,137 @@ type GetKanbanBoardCardResponse struct {
 	Version   int       `json:"version"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+
+	ContentVersion    int                           `json:"content_version"`
+	Labels            []uuid.UUID                   `json:"labels"`
+	Comments          []BoardCardCommentResponse    `json:"comments,omitempty"`
+	Checklists        []BoardCardChecklistResponse  `json:"checklists,omitempty"`
+	ChecklistProgress *ChecklistProgressResponse    `json:"checklist_progress,omitempty"`
+	Attachments       []BoardCardAttachmentResponse `json:"attachments,omitempty"`
+}
+
+type BoardLabelResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Color     string    `json:"color"`
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// BoardCardCommentResponse 카드 댓글 - 답글은 원 댓글의 replies에 작성 순서대로
+type BoardCardCommentResponse struct {
+	ID        uuid.UUID                  `json:"id"`
+	CardID    uuid.UUID                  `json:"card_id"`
+	ParentID  *uuid.UUID                 `json:"parent_id,omitempty"`
+	UserID    uint                       `json:"user_id"`
+	Content   string                     `json:"content"`
+	Mentions  []uint                     `json:"mentions"`
+	Version   int                        `json:"version"`
+	CreatedAt time.Time                  `json:"created_at"`
+	UpdatedAt time.Time                  `json:"updated_at"`
+	Replies   []BoardCardCommentResponse `json:"replies,omitempty"`
+}
+
+type BoardCardChecklistResponse struct {
+	ID        uuid.UUID                        `json:"id"`
+	CardID    uuid.UUID                        `json:"card_id"`
+	Name      string                           `json:"name"`
+	Position  uint                             `json:"position"`
+	Version   int                              `json:"version"`
+	Progress  ChecklistProgressResponse        `json:"progress"`
+	Items     []BoardCardChecklistItemResponse `json:"items"`
+	CreatedAt time.Time                        `json:"created_at"`
+	UpdatedAt time.Time                        `json:"updated_at"`
+}
+
+type BoardCardChecklistItemResponse struct {
+	ID          uuid.UUID `json:"id"`
+	ChecklistID uuid.UUID `json:"checklist_id"`
+	CardID      uuid.UUID `json:"card_id"`
+	Content     string    `json:"content"`
+	Checked     bool      `json:"checked"`
+	Position    uint      `json:"position"`
+	Version     int       `json:"version"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ChecklistProgressResponse 체크리스트 완료율 (percent는 0~100 정수, 내림)
+type ChecklistProgressResponse struct {
+	Completed int `json:"completed"`
+	Total     int `json:"total"`
+	Percent   int `json:"percent"`
+}
+
+type BoardCardAttachmentResponse struct {
+	ID          uuid.UUID `json:"id"`
+	CardID      uuid.UUID `json:"card_id"`
+	URL         string    `json:"url"`
+	Name        string    `json:"name"`
+	Size        int64     `json:"size"`
+	ContentType string    `json:"content_type,omitempty"`
+	UploadedBy  uint      `json:"uploaded_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// BoardAttachmentFileResponse 업로드된 파일 - 자동 저장 attachment create에 그대로 사용
+type BoardAttachmentFileResponse struct {
+	URL         string `json:"url"`
+	Name        string `json:"name"`
+	Size        int64  `json:"size"`
+	ContentType string `json:"content_type,omitempty"`
+}
+
+type UploadBoardAttachmentsResponse struct {
+	Files []BoardAttachmentFileResponse `json:"files"`
+}
+
+// BoardTemplateResponse 보드 템플릿 - cards의 column_id, labels는 템플릿 안의 컬럼/라벨 ID
+type BoardTemplateResponse struct {
+	ID          uint                          `json:"id"`
+	CompanyID   uint                          `json:"company_id"`
+	Name        string                        `json:"name"`
+	Description string                        `json:"description"`
+	CreatedBy   uint                          `json:"created_by"`
+	Columns     []BoardTemplateColumnResponse `json:"columns"`
+	Labels      []BoardTemplateLabelResponse  `json:"labels"`
+	Cards       []BoardTemplateCardResponse   `json:"cards"`
+	CreatedAt   time.Time                     `json:"created_at"`
+	UpdatedAt   time.Time                     `json:"updated_at"`
+}
+
+type BoardTemplateColumnResponse struct {
+	ID       uuid.UUID `json:"id"`
+	Name     string    `json:"name"`
+	Position uint      `json:"position"`
+	WipLimit uint      `json:"wip_limit"`
+}
+
+type BoardTemplateLabelResponse struct {
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name"`
+	Color string    `json:"color"`
+}
+
+type BoardTemplateCardResponse struct {
+	ID       uuid.UUID   `json:"id"`
+	ColumnID uuid.UUID   `json:"column_id"`
+	Name     string      `json:"name"`
+	Content  string      `json:"content"`
+	Position uint        `json:"position"`
+	Labels   []uuid.UUID `json:"labels"`
+}
+
+type GetBoardTemplatesResponse struct {
+	Templates []BoardTemplateResponse `json:"templates"`
+}
+
+type CopyBoardResponse struct {
+	BoardID   uint      `json:"board_id"`
+	Title     string    `json:"title"`
+	ProjectID uint      `json:"project_id"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type GetKanbanBoardUserResponse struct {
@@ -62,3 +198,105 @@ type GetKanbanBoardUserResponse struct {
 	BoardRole    int    `json:"board_role"`
 	Online       bool   `json:"online"`
 }
+
+// BoardStateUpdateResponse 자동 저장 결과 - 변경사항 순서대로 적용 결과와 최신 상태
+type BoardStateUpdateResponse struct {
+	Results       []BoardChangeResultResponse `json:"results"`
+	AppliedCount  int                         `json:"applied_count"`
+	ConflictCount int                         `json:"conflict_count"`
+}
+
+// BoardChangeResultResponse status: applied | merged | conflict
+// column/card/label/comment/checklist/checklist_item/attachment는 서버 기준 최신 상태 (삭제되었으면 생략)
+type BoardChangeResultResponse struct {
+	Index    int                           `json:"index"`
+	Type     string                        `json:"type"`
+	Action   string                        `json:"action"`
+	TargetID uuid.UUID                     `json:"target_id"`
+	Status   string                        `json:"status"`
+	Column   *GetKanbanBoardColumnResponse `json:"column,omitempty"`
+	Card     *GetKanbanBoardCardResponse   `json:"card,omitempty"`
+
+	Label         *BoardLabelResponse             `json:"label,omitempty"`
+	Comment       *BoardCardCommentResponse       `json:"comment,omitempty"`
+	Checklist     *BoardCardChecklistResponse     `json:"checklist,omitempty"`
+	ChecklistItem *BoardCardChecklistItemResponse `json:"checklist_item,omitempty"`
+	Attachment    *BoardCardAttachmentResponse    `json:"attachment,omitempty"`
+}
+
+// CardDocumentResponse 카드 협업 편집 문서 (type: card.doc.snapshot)
+// 연산을 보낼 때 session과 revision을 함께 보내야 함
+type CardDocumentResponse struct {
+	CardID   uuid.UUID           `json:"card_id"`
+	BoardID  uint                `json:"board_id"`
+	Session  string              `json:"session"`
+	Content  string              `json:"content"`
+	Revision int                 `json:"revision"`
+	Cursors  []CardCursorPayload `json:"cursors"`
+}
+
+// CardOperationPayload 적용된 편집 연산 (type: card.doc.op 전체 전파, card.doc.ack 보낸 연결에만)
+// revision은 이 연산 적용 후 리비전, client_id가 자신이면 이미 반영한 연산
+type CardOperationPayload struct {
+	CardID    uuid.UUID       `json:"card_id"`
+	BoardID   uint            `json:"board_id"`
+	Session   string          `json:"session"`
+	Revision  int             `json:"revision"`
+	ClientID  string          `json:"client_id"`
+	UserID    uint            `json:"user_id"`
+	Operation json.RawMessage `json:"operation"`
+}
+
+// CardCursorPayload 협업 편집 커서 (type: card.doc.cursor) - removed면 편집 종료
+type CardCursorPayload struct {
+	CardID   uuid.UUID `json:"card_id"`
+	BoardID  uint      `json:"board_id"`
+	ClientID string    `json:"client_id"`
+	UserID   uint      `json:"user_id"`
+	UserName string    `json:"user_name,omitempty"`
+	Revision int       `json:"revision"`
+	Anchor   int       `json:"anchor"`
+	Head     int       `json:"head"`
+	Removed  bool      `json:"removed,omitempty"`
+}
+
+// BoardActivityResponse 보드 활동 기록
+// move면 from/to에 이동 전후 컬럼과 위치, update면 changes에 바뀐 항목
+type BoardActivityResponse struct {
+	ID             string                        `json:"id"`
+	UserID         uint                          `json:"user_id"`
+	UserName       string                        `json:"user_name"`
+	ProjectID      uint                          `json:"project_id"`
+	BoardID        uint                          `json:"board_id"`
+	TargetType     string                        `json:"target_type"`
+	TargetID       *uuid.UUID                    `json:"target_id,omitempty"` // 라벨, 댓글, 체크리스트, 항목, 첨부 파일 ID
+	CardID         *uuid.UUID                    `json:"card_id,omitempty"`
+	ColumnID       *uuid.UUID                    `json:"column_id,omitempty"`
+	Name           string                        `json:"name"`
+	Action         string                        `json:"action"`
+	FromColumnID   *uuid.UUID                    `json:"from_column_id,omitempty"`
+	FromColumnName string                        `json:"from_column_name,omitempty"`
+	ToColumnID     *uuid.UUID                    `json:"to_column_id,omitempty"`
+	ToColumnName   string                        `json:"to_column_name,omitempty"`
+	FromPosition   *uint                         `json:"from_position,omitempty"`
+	ToPosition     *uint                         `json:"to_position,omitempty"`
+	Changes        []BoardActivityChangeResponse `json:"changes,omitempty"`
+	CreatedAt      time.Time                     `json:"created_at"`
+}
+
+type BoardActivityChangeResponse struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+type BoardActivityMeta struct {
+	NextCursor string `json:"next_cursor,omitempty"`
+	HasMore    bool   `json:"has_more"`
+	PageSize   int    `json:"page_size"`
+}
+
+type GetBoardActivitiesResponse struct {
+	Activities []BoardActivityResponse `json:"activities"`
+	Meta       *BoardActivityMeta      `json:"meta"`
+}
@@ -11,53 +11,201 @@ type UserInfoResponse struct {
 	Image     *string    `json:"image,omitempty"`
 	JoinedAt  *time.Time `json:"joined_at,omitempty"`
 	LeftAt    *time.Time `json:"left_at,omitempty"`
+	Role      *string    `json:"role,omitempty"`
 }
 
 type CreateChatRoomResponse struct {
-	Name      string             `json:"name,omitempty"`
-	IsPrivate bool               `json:"is_private,omitempty"`
-	Users     []UserInfoResponse `json:"users,omitempty"`
+	ID             uint               `json:"id,omitempty"`
+	Name           string             `json:"name,omitempty"`
+	IsPrivate      bool               `json:"is_private,omitempty"`
+	IsAnnouncement bool               `json:"is_announcement,omitempty"`
+	Users          []UserInfoResponse `json:"users,omitempty"`
 }
 
 type ChatRoomInfoResponse struct {
-	ID        uint               `json:"id,omitempty"`
-	Name      string             `json:"name,omitempty"`
-	IsPrivate *bool              `json:"is_private,omitempty"`
-	Users     []UserInfoResponse `json:"users,omitempty"`
+	ID          uint               `json:"id,omitempty"`
+	Name        string             `json:"name,omitempty"`
+	IsPrivate   *bool              `json:"is_private,omitempty"`
+	Users       []UserInfoResponse `json:"users,omitempty"`
+	UnreadCount *int64             `json:"unread_count,omitempty"`
+	OwnerID     *uint              `json:"owner_id,omitempty"`
+	// 회사 공지 채팅방은 회사 관리자만 작성 가능
+	IsAnnouncement bool `json:"is_announcement,omitempty"`
+}
+
+// ChatSystemPayload 채팅방 관리 시스템 메시지 (이름 변경, 초대, 내보내기, 역할 변경 등)
+type ChatSystemPayload struct {
+	ChatRoomID    uint   `json:"chat_room_id"`
+	Action        string `json:"action"` // rename | invite | kick | owner | role | leave
+	ActorID       uint   `json:"actor_id"`
+	ActorName     string `json:"actor_name,omitempty"`
+	TargetUserIDs []uint `json:"target_user_ids,omitempty"`
+	Name          string `json:"name,omitempty"`
+	Role          string `json:"role,omitempty"`
+	Content       string `json:"content"`
+	CreatedAt     string `json:"created_at"`
+}
+
+// ChatRoomAliasPayload 사용자별 채팅방 별칭 변경 (본인에게만 전송)
+type ChatRoomAliasPayload struct {
+	ChatRoomID uint   `json:"chat_room_id"`
+	Alias      string `json:"alias"`
+	UpdatedAt  string `json:"updated_at"`
+}
+
+// ChatSignalPayload 입력중(typing), 읽음(read), 수신 확인(delivered) 이벤트
+type ChatSignalPayload struct {
+	ChatRoomID uint   `json:"chat_room_id"`
+	UserID     uint   `json:"user_id"`
+	UserName   string `json:"user_name,omitempty"`
+	MessageID  string `json:"message_id,omitempty"`
+	Typing     *bool  `json:"typing,omitempty"`
+	ReadCount  int64  `json:"read_count,omitempty"`
 }
 
 type ChatPayload struct {
-	ChatRoomID  uint   `json:"chat_room_id,omitempty"`
-	SenderID    uint   `json:"sender_id,omitempty"`
-	SenderName  string `json:"sender_name,omitempty"`
-	SenderEmail string `json:"sender_email,omitempty"`
-	SenderImage string `json:"sender_image,omitempty"`
-	Content     string `json:"content,omitempty"`
-	CreatedAt   string `json:"created_at,omitempty"`
+	ChatMessageID string                   `json:"chat_message_id,omitempty"`
+	ChatRoomID    uint                     `json:"chat_room_id,omitempty"`
+	SenderID      uint                     `json:"sender_id,omitempty"`
+	SenderName    string                   `json:"sender_name,omitempty"`
+	SenderEmail   string                   `json:"sender_email,omitempty"`
+	SenderImage   string                   `json:"sender_image,omitempty"`
+	Content       string                   `json:"content,omitempty"`
+	ReplyTo       *ChatReplyResponse       `json:"reply_to,omitempty"`
+	Attachments   []ChatAttachmentResponse `json:"attachments,omitempty"`
+	CreatedAt     string                   `json:"created_at,omitempty"`
 }
 
 type ChatMessagesResponse struct {
+	ChatMessageID string                   `json:"chat_message_id"`
+	Content       string                   `json:"content"`
+	SenderID      uint                     `json:"sender_id"`
+	SenderName    string                   `json:"sender_name"`
+	SenderImage   string                   `json:"sender_image"`
+	ChatRoomID    uint                     `json:"chat_room_id"`
+	ReplyTo       *ChatReplyResponse       `json:"reply_to,omitempty"`
+	Attachments   []ChatAttachmentResponse `json:"attachments,omitempty"`
+	Reactions     []ChatReactionResponse   `json:"reactions,omitempty"`
+	IsEdited      bool                     `json:"is_edited"`
+	CreatedAt     string                   `json:"created_at"`
+	UpdatedAt     string                   `json:"updated_at,omitempty"`
+	PinnedBy      *uint                    `json:"pinned_by,omitempty"`
+	PinnedAt      string                   `json:"pinned_at,omitempty"`
+}
+
+// 답장 대상 메시지 미리보기
+type ChatReplyResponse struct {
+	ChatMessageID string `json:"chat_message_id"`
+	SenderID      uint   `json:"sender_id,omitempty"`
+	SenderName    string `json:"sender_name,omitempty"`
+	Content       string `json:"content,omitempty"`
+}
+
+type ChatAttachmentResponse struct {
+	URL         string `json:"url"`
+	Name        string `json:"name"`
+	Size        int64  `json:"size"`
+	ContentType string `json:"content_type,omitempty"`
+}
+
+type ChatReactionResponse struct {
+	EmojiID   uint   `json:"emoji_id"`
+	Unified   string `json:"unified"`
+	Content   string `json:"content"`
+	Count     int    `json:"count"`
+	UserIDs   []uint `json:"user_ids"`
+	IsClicked bool   `json:"is_clicked"`
+}
+
+// ChatEditPayload 메시지 수정 이벤트 (type: chat.edit)
+type ChatEditPayload struct {
 	ChatMessageID string `json:"chat_message_id"`
-	Content       string `json:"content"`
-	SenderID      uint   `json:"sender_id"`
-	SenderName    string `json:"sender_name"`
-	SenderImage   string `json:"sender_image"`
 	ChatRoomID    uint   `json:"chat_room_id"`
-	CreatedAt     string `json:"created_at"`
-	UpdatedAt     string `json:"updated_at,omitempty"`
+	Content       string `json:"content"`
+	UpdatedAt     string `json:"updated_at"`
+}
+
+// ChatReactionPayload 이모지 반응 이벤트 (type: chat.reaction) - 메시지의 전체 반응 목록
+type ChatReactionPayload struct {
+	ChatMessageID string                 `json:"chat_message_id"`
+	ChatRoomID    uint                   `json:"chat_room_id"`
+	UserID        uint                   `json:"user_id"`
+	Reactions     []ChatReactionResponse `json:"reactions"`
+}
+
+type ChatEditHistoryResponse struct {
+	Content  string `json:"content"`
+	EditedAt string `json:"edited_at"`
 }
 
 type ChatMeta struct {
-	NextCursor string `json:"next_cursor,omitempty"`
-	HasMore    *bool  `json:"has_more,omitempty"`
-	TotalCount int    `json:"total_count"`
-	TotalPages int    `json:"total_pages"`
-	PageSize   int    `json:"page_size"`
-	PrevPage   int    `json:"prev_page"`
-	NextPage   int    `json:"next_page"`
+	NextCursor  string `json:"next_cursor,omitempty"`
+	HasMore     *bool  `json:"has_more,omitempty"`
+	NewerCursor string `json:"newer_cursor,omitempty"`
+	HasNewer    *bool  `json:"has_newer,omitempty"`
+	TotalCount  int    `json:"total_count"`
+	TotalPages  int    `json:"total_pages"`
+	PageSize    int    `json:"page_size"`
+	PrevPage    int    `json:"prev_page"`
+	NextPage    int    `json:"next_page"`
 }
 
 type GetChatMessagesResponse struct {
 	ChatMessages []*ChatMessagesResponse `json:"chat_messages"`
 	Meta         *ChatMeta               `json:"meta"`
 }
+
+// ChatSearchResultResponse 검색 결과 - snippet은 검색어를 <mark>로 감싼 일부 내용 (HTML 이스케이프됨)
+type ChatSearchResultResponse struct {
+	ChatMessageID string  `json:"chat_message_id"`
+	ChatRoomID    uint    `json:"chat_room_id"`
+	ChatRoomName  string  `json:"chat_room_name,omitempty"`
+	SenderID      uint    `json:"sender_id"`
+	SenderName    string  `json:"sender_name"`
+	SenderImage   string  `json:"sender_image,omitempty"`
+	Content       string  `json:"content"`
+	Snippet       string  `json:"snippet"`
+	Score         float64 `json:"score"`
+	CreatedAt     string  `json:"created_at"`
+}
+
+type ChatSearchMeta struct {
+	TotalCount int  `json:"total_count"`
+	TotalPages int  `json:"total_pages"`
+	PageSize   int  `json:"page_size"`
+	Page       int  `json:"page"`
+	HasMore    bool `json:"has_more"`
+}
+
+type SearchChatMessagesResponse struct {
+	Results []*ChatSearchResultResponse `json:"results"`
+	Meta    *ChatSearchMeta             `json:"meta"`
+}
+
+type ChatRetentionResponse struct {
+	CompanyID     uint       `json:"company_id"`
+	Grade         int        `json:"grade"`
+	RetentionDays int        `json:"retention_days"` // 0이면 영구 보관
+	MaxDays       int        `json:"max_days"`       // 등급별 설정 가능한 최대 기간 (0이면 제한 없음)
+	IsDefault     bool       `json:"is_default"`     // 설정한 적 없어 등급 기본값 사용중
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+}
+
+type ChatLegalHoldResponse struct {
+	ID         uint      `json:"id"`
+	ChatRoomID *uint     `json:"chat_room_id,omitempty"`
+	Reason     string    `json:"reason"`
+	CreatedBy  uint      `json:"created_by"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// ChatPinPayload 메시지 고정/해제 이벤트 (고정 시 Message 포함)
+type ChatPinPayload struct {
+	ChatRoomID    uint                  `json:"chat_room_id"`
+	ChatMessageID string                `json:"chat_message_id"`
+	Pinned        bool                  `json:"pinned"`
+	UserID        uint                  `json:"user_id"`
+	UserName      string                `json:"user_name,omitempty"`
+	PinnedCount   int64                 `json:"pinned_count"`
+	Message       *ChatMessagesResponse `json:"message,omitempty"`
+}
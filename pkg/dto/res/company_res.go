@@ -1,5 +1,7 @@
 package res
 
+import "time"
+
 type GetCompanyInfoResponse struct {
 	ID                    uint   `json:"id"`
 	CpName                string `json:"cp_name"`
@@ -34,3 +36,21 @@ type GetCompanyPositionResponse struct {
 	CreatedAt string `json:"created_at"`
 	UpdatedAt string `json:"updated_at"`
 }
+
+type GetCompanySsoResponse struct {
+	CompanyID       uint      `json:"company_id"`
+	Protocol        string    `json:"protocol"`
+	Issuer          string    `json:"issuer"`
+	ClientID        string    `json:"client_id"`
+	HasClientSecret bool      `json:"has_client_secret"`
+	Scopes          []string  `json:"scopes"`
+	EmailClaim      string    `json:"email_claim"`
+	NameClaim       string    `json:"name_claim"`
+	DepartmentClaim string    `json:"department_claim,omitempty"`
+	PositionClaim   string    `json:"position_claim,omitempty"`
+	AutoProvision   bool      `json:"auto_provision"`
+	AllowedDomains  []string  `json:"allowed_domains"`
+	Enabled         bool      `json:"enabled"`
+	RedirectURI     string    `json:"redirect_uri"` // IdP에 등록해야 하는 콜백 주소
+	UpdatedAt       time.Time `json:"updated_at"`
+}
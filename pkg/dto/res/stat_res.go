@@ -55,3 +55,13 @@ type PostPayload struct {
 	TotalComments int    `json:"total_comments"`
 	Score         int    `json:"score"`
 }
+
+type GetPostViewFlushStatResponse struct {
+	LastRunAt       string `json:"last_run_at,omitempty"`
+	LastSuccessAt   string `json:"last_success_at,omitempty"`
+	LagSeconds      int64  `json:"lag_seconds"` // 마지막 성공 반영 이후 경과 시간
+	LastDurationMs  int64  `json:"last_duration_ms"`
+	LastFlushedPost int    `json:"last_flushed_post"`
+	LastFlushedView int    `json:"last_flushed_view"`
+	LastError       string `json:"last_error,omitempty"`
+}
@@ -11,3 +11,28 @@ type Ws_UserResponse struct {
 	UserID   uint `json:"user_id"`
 	IsOnline bool `json:"is_online"`
 }
+
+// WsEnvelope /ws/v2 서버 -> 클라이언트 메시지
+type WsEnvelope struct {
+	V       int         `json:"v"`
+	Type    string      `json:"type"`
+	Topic   string      `json:"topic,omitempty"`
+	Seq     uint64      `json:"seq"`
+	Payload interface{} `json:"payload,omitempty"`
+}
+
+// WsDropStatResponse 전송 큐 초과로 버려진 메시지 수 (노드 기준)
+type WsDropStatResponse struct {
+	NodeID         string        `json:"node_id,omitempty"`
+	OverflowPolicy string        `json:"overflow_policy"`
+	SendQueueSize  int           `json:"send_queue_size"`
+	Total          uint64        `json:"total"`
+	Users          []WsDropCount `json:"users"`
+	Rooms          []WsDropCount `json:"rooms"`
+	Boards         []WsDropCount `json:"boards"`
+}
+
+type WsDropCount struct {
+	ID      uint   `json:"id"`
+	Dropped uint64 `json:"dropped"`
+}
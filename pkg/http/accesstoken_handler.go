@@ -0,0 +1,152 @@
+package http
+
+import (
+	_accessTokenUsecase "link/internal/accesstoken/usecase"
+	"link/pkg/common"
+	"link/pkg/dto/req"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AccessTokenHandler struct {
+	accessTokenUsecase _accessTokenUsecase.AccessTokenUsecase
+}
+
+func NewAccessTokenHandler(accessTokenUsecase _accessTokenUsecase.AccessTokenUsecase) *AccessTokenHandler {
+	return &AccessTokenHandler{accessTokenUsecase: accessTokenUsecase}
+}
+
+// TODO 본인 액세스 토큰 발급 - 토큰 원문은 이 응답에서만 확인 가능
+func (h *AccessTokenHandler) CreateAccessToken(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	var request req.CreateAccessTokenRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.accessTokenUsecase.CreateAccessToken(userId.(uint), &request, c.ClientIP(), c.Request.UserAgent())
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "액세스 토큰 발급 성공", response))
+}
+
+// TODO 회사 관리자 - 소속 사용자(봇 계정 등) 액세스 토큰 발급
+func (h *AccessTokenHandler) CreateUserAccessToken(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	var request req.CreateUserAccessTokenRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.accessTokenUsecase.CreateUserAccessToken(userId.(uint), &request, c.ClientIP(), c.Request.UserAgent())
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "액세스 토큰 발급 성공", response))
+}
+
+// TODO 액세스 토큰 목록 (본인 토큰 + 본인이 발급해준 토큰)
+func (h *AccessTokenHandler) GetAccessTokens(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	response, err := h.accessTokenUsecase.GetAccessTokens(userId.(uint))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "액세스 토큰 목록 조회 성공", response))
+}
+
+// TODO 액세스 토큰 폐기
+func (h *AccessTokenHandler) RevokeAccessToken(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	tokenId, err := strconv.ParseUint(c.Param("tokenid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	if err := h.accessTokenUsecase.RevokeAccessToken(userId.(uint), uint(tokenId), c.ClientIP(), c.Request.UserAgent()); err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "액세스 토큰이 폐기되었습니다", nil))
+}
+
+// TODO 액세스 토큰 감사 로그 (생성/사용/거부/폐기)
+func (h *AccessTokenHandler) GetAccessTokenAudits(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	tokenId, err := strconv.ParseUint(c.Param("tokenid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.accessTokenUsecase.GetAccessTokenAudits(userId.(uint), uint(tokenId))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "감사 로그 조회 성공", response))
+}
+
+// TODO 발급 가능한 scope 목록
+func (h *AccessTokenHandler) GetScopes(c *gin.Context) {
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "scope 목록 조회 성공", h.accessTokenUsecase.GetScopes()))
+}
@@ -620,3 +620,30 @@ func (h *AdminHandler) AdminUpdateUserStatus(c *gin.Context) {
 
 	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "사용자 상태 수정에 성공하였습니다.", nil))
 }
+
+// TODO 사용자 강제 로그아웃
+func (h *AdminHandler) AdminForceLogoutUser(c *gin.Context) {
+	adminUserId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	targetUserId, err := strconv.Atoi(c.Param("userid"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다.", err))
+		return
+	}
+
+	err = h.adminUsecase.AdminForceLogoutUser(adminUserId.(uint), uint(targetUserId))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "사용자 강제 로그아웃에 성공하였습니다.", nil))
+}
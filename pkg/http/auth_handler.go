@@ -2,15 +2,14 @@ package http
 
 import (
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 
+	"link/internal/auth/entity"
 	"link/internal/auth/usecase"
 	"link/pkg/common"
 	"link/pkg/dto/req"
-	"link/pkg/util"
 )
 
 type AuthHandler struct {
@@ -29,7 +28,7 @@ func (h *AuthHandler) SignIn(c *gin.Context) {
 		return
 	}
 
-	response, token, err := h.authUsecase.SignIn(&request)
+	response, token, err := h.authUsecase.SignIn(&request, c.ClientIP(), c.Request.UserAgent())
 	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
@@ -39,11 +38,25 @@ func (h *AuthHandler) SignIn(c *gin.Context) {
 		return
 	}
 
+	//TODO 2단계 인증이 필요하면 토큰 없이 mfa_token만 응답
+	if token == nil {
+		message := "2단계 인증이 필요합니다"
+		if response.Mfa.EnrollmentRequired {
+			message = "관리자 계정은 2단계 인증 등록이 필요합니다"
+		}
+		c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, message, response.Mfa))
+		return
+	}
+
+	setSignInTokens(c, token)
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "로그인 성공", response))
+}
+
+func setSignInTokens(c *gin.Context, token *entity.Token) {
 	//! 도메인 다를 때 사용
 	authorization := fmt.Sprintf("Bearer %s", token.AccessToken)
 	c.Header("Authorization", authorization)
 	c.SetCookie("refreshToken", token.RefreshToken, 259200, "/", "", false, true) // 3일
-	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "로그인 성공", response))
 }
 
 func (h *AuthHandler) SignOut(c *gin.Context) {
@@ -54,15 +67,10 @@ func (h *AuthHandler) SignOut(c *gin.Context) {
 		return
 	}
 
-	// email을 가져옵니다.
-	email, exists := c.Get("email")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
-		return
-	}
+	sessionId := c.GetString("sessionId")
 
-	// 로그아웃 처리 로직 호출
-	err := h.authUsecase.SignOut(userId.(uint), email.(string))
+	// 로그아웃 처리 로직 호출 - 현재 기기의 세션만 폐기
+	err := h.authUsecase.SignOut(userId.(uint), sessionId)
 	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
@@ -73,63 +81,359 @@ func (h *AuthHandler) SignOut(c *gin.Context) {
 	}
 
 	// accessToken 쿠키 삭제
-	// TODO 리프레시 토큰은 레디스에 저장되어 있음
+	// TODO 리프레시 토큰 패밀리는 레디스 세션에 저장되어 있음
 	c.SetCookie("accessToken", "", -1, "/", "", false, true)
 	c.SetCookie("refreshToken", "", -1, "/", "", false, true)
 	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "로그아웃 되었습니다", nil))
 }
 
-// TODO accessToken 재발급 핸들러
+// TODO accessToken 재발급 핸들러 - 리프레시 토큰 회전 (매 요청마다 새 refreshToken 발급)
 func (h *AuthHandler) RefreshToken(c *gin.Context) {
+	refreshToken := c.GetString("refreshToken")
+	if refreshToken == "" {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	token, err := h.authUsecase.RotateRefreshToken(refreshToken)
+	if err != nil {
+		// 재사용 감지 또는 폐기된 세션이면 쿠키도 제거
+		c.SetCookie("refreshToken", "", -1, "/", "", false, true)
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		}
+		return
+	}
+
+	//TODO 재발급 된 accessToken은 헤더, 회전된 refreshToken은 헤더 + 쿠키로 전송
+	authorization := fmt.Sprintf("Bearer %s", token.AccessToken)
+	c.Header("Authorization", authorization)
+	c.Header("RefreshToken", token.RefreshToken)
+	c.SetCookie("refreshToken", token.RefreshToken, 259200, "/", "", false, true) // 3일
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "액세스 토큰 재발급 성공", nil))
+}
+
+// TODO 내 로그인 세션 목록 조회
+func (h *AuthHandler) GetSessions(c *gin.Context) {
 	userId, exists := c.Get("userId")
 	if !exists {
 		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
 		return
 	}
 
-	email, exists := c.Get("email")
+	response, err := h.authUsecase.GetSessions(userId.(uint), c.GetString("sessionId"))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "세션 목록 조회 성공", response))
+}
+
+// TODO 특정 세션 폐기 (다른 기기 로그아웃)
+func (h *AuthHandler) RevokeSession(c *gin.Context) {
+	userId, exists := c.Get("userId")
 	if !exists {
 		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
 		return
 	}
 
-	refreshToken, err := h.authUsecase.GetRefreshToken(userId.(uint), email.(string))
+	sessionId := c.Param("sessionid")
+	if sessionId == "" {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "세션 ID가 필요합니다", nil))
+		return
+	}
+
+	err := h.authUsecase.RevokeSession(userId.(uint), sessionId)
 	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
 		} else {
-			c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
 		}
 		return
 	}
 
-	//TODO refreshToken 으로 accessToken 재발급
-	claims, err := util.ValidateRefreshToken(refreshToken)
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "세션이 폐기되었습니다", nil))
+}
+
+// TODO 모든 세션 폐기 (모든 기기 로그아웃)
+func (h *AuthHandler) RevokeAllSessions(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	err := h.authUsecase.RevokeAllSessions(userId.(uint))
 	if err != nil {
-		log.Printf("리프레시 토큰 검증 중 오류가 발생했습니다: %v", err)
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
 		} else {
-			c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.SetCookie("refreshToken", "", -1, "/", "", false, true)
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "모든 세션이 폐기되었습니다", nil))
+}
+
+// TODO JWKS - 표준 형식이어야 하므로 common.Response로 감싸지 않음
+func (h *AuthHandler) GetJWKS(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, h.authUsecase.GetJWKS())
+}
+
+// TODO 이메일 인증 완료
+func (h *AuthHandler) VerifyEmail(c *gin.Context) {
+	var request req.VerifyEmailRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	if err := h.authUsecase.VerifyEmail(request.Token); err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "이메일 인증이 완료되었습니다", nil))
+}
+
+// TODO 인증 메일 재발송 - 계정 존재 여부와 관계없이 같은 응답
+func (h *AuthHandler) ResendVerificationEmail(c *gin.Context) {
+	var request req.EmailRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	if err := h.authUsecase.SendVerificationEmail(request.Email); err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "인증 대기 중인 계정이라면 인증 메일이 발송됩니다", nil))
+}
+
+// TODO 비밀번호 재설정 메일 요청 - 계정 존재 여부와 관계없이 같은 응답
+func (h *AuthHandler) ForgotPassword(c *gin.Context) {
+	var request req.EmailRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	if err := h.authUsecase.RequestPasswordReset(request.Email); err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "가입된 이메일이라면 비밀번호 재설정 메일이 발송됩니다", nil))
+}
+
+// TODO 비밀번호 재설정
+func (h *AuthHandler) ResetPassword(c *gin.Context) {
+	var request req.ResetPasswordRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	if err := h.authUsecase.ResetPassword(request.Token, request.NewPassword); err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.SetCookie("refreshToken", "", -1, "/", "", false, true)
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "비밀번호가 재설정되었습니다. 다시 로그인 해주세요", nil))
+}
+
+// TODO 로그인 2단계 - OTP 코드 또는 복구 코드 확인
+func (h *AuthHandler) VerifySignInMfa(c *gin.Context) {
+	var request req.MfaVerifyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, token, err := h.authUsecase.VerifySignInMfa(&request, c.ClientIP(), c.Request.UserAgent())
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
 		}
 		return
 	}
 
-	newAccessToken, err := util.GenerateAccessToken(claims.Name, claims.Email, claims.UserId)
+	setSignInTokens(c, token)
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "로그인 성공", response))
+}
+
+// TODO 로그인 중 필수 2단계 인증 등록 시작
+func (h *AuthHandler) StartSignInTotpEnrollment(c *gin.Context) {
+	var request req.MfaTokenRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.authUsecase.StartSignInTotpEnrollment(request.MfaToken)
 	if err != nil {
-		log.Printf("액세스 토큰 재발급 중 오류가 발생했습니다: %v", err)
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
 		} else {
 			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
 		}
-		c.Abort()
 		return
 	}
 
-	//TODO 재발급 된 accessToken을 헤더로 전송
-	authorization := fmt.Sprintf("Bearer %s", newAccessToken)
-	c.Header("Authorization", authorization)
-	// c.SetCookie("accessToken", newAccessToken, 1200, "/", "", false, true)
-	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "액세스 토큰 재발급 성공", nil))
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "OTP 앱에 등록 후 인증 코드를 입력해주세요", response))
+}
+
+// TODO 로그인 중 필수 2단계 인증 등록 완료 -> 로그인 성공
+func (h *AuthHandler) ConfirmSignInTotpEnrollment(c *gin.Context) {
+	var request req.MfaEnrollConfirmRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, token, err := h.authUsecase.ConfirmSignInTotpEnrollment(&request, c.ClientIP(), c.Request.UserAgent())
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	setSignInTokens(c, token)
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "2단계 인증 등록 및 로그인 성공 - 복구 코드를 안전한 곳에 보관해주세요", response))
+}
+
+// TODO 2단계 인증 등록 시작 (로그인 후)
+func (h *AuthHandler) StartTotpEnrollment(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	response, err := h.authUsecase.StartTotpEnrollment(userId.(uint))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "OTP 앱에 등록 후 인증 코드를 입력해주세요", response))
+}
+
+// TODO 2단계 인증 등록 완료 (로그인 후)
+func (h *AuthHandler) ConfirmTotpEnrollment(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	var request req.TotpCodeRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.authUsecase.ConfirmTotpEnrollment(userId.(uint), request.Code)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "2단계 인증 등록 완료 - 복구 코드를 안전한 곳에 보관해주세요", response))
+}
+
+// TODO 2단계 인증 해제
+func (h *AuthHandler) DisableTotp(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	var request req.TotpCodeRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	if err := h.authUsecase.DisableTotp(userId.(uint), request.Code); err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "2단계 인증이 해제되었습니다", nil))
+}
+
+// TODO 복구 코드 재발급
+func (h *AuthHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	var request req.TotpCodeRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.authUsecase.RegenerateRecoveryCodes(userId.(uint), request.Code)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "복구 코드가 재발급되었습니다", response))
 }
@@ -3,12 +3,14 @@ package http
 import (
 	"link/pkg/common"
 	"link/pkg/dto/req"
+	"link/pkg/middleware"
 	"net/http"
 	"strconv"
 
 	_boardUsecase "link/internal/board/usecase"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 type BoardHandler struct {
@@ -215,7 +217,8 @@ func (h *BoardHandler) AutoSaveBoard(c *gin.Context) {
 		return
 	}
 
-	if err := h.boardUsecase.AutoSaveBoard(userId.(uint), uint(projectIDUint), uint(boardIDUint), &request); err != nil {
+	response, err := h.boardUsecase.AutoSaveBoard(userId.(uint), uint(projectIDUint), uint(boardIDUint), &request)
+	if err != nil {
 		if appError, ok := err.(*common.AppError); ok {
 			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
 		} else {
@@ -224,9 +227,7 @@ func (h *BoardHandler) AutoSaveBoard(c *gin.Context) {
 		return
 	}
 
-	//WS 저장 (옵션)
-
-	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "보드 상태 자동 저장 성공", nil))
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "보드 상태 자동 저장 성공", response))
 }
 
 // 칸반보드 렌더링 조회
@@ -257,3 +258,320 @@ func (h *BoardHandler) GetKanbanBoard(c *gin.Context) {
 
 	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "칸반보드 렌더링 조회 성공", board))
 }
+
+// TODO 보드 활동 조회 (cursor 페이지네이션, 작업자/action 필터)
+func (h *BoardHandler) GetBoardActivities(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 사용자입니다.", nil))
+		return
+	}
+
+	boardID, err := strconv.ParseUint(c.Param("boardid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "보드 ID가 유효하지 않습니다.", err))
+		return
+	}
+
+	queryParams, err := bindBoardActivitiesQuery(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 사용자 ID입니다.", err))
+		return
+	}
+
+	response, err := h.boardUsecase.GetBoardActivities(userId.(uint), uint(boardID), queryParams)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "보드 활동 조회 성공", response))
+}
+
+// TODO 카드 활동 조회 (삭제된 카드 포함)
+func (h *BoardHandler) GetCardActivities(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 사용자입니다.", nil))
+		return
+	}
+
+	cardID, err := uuid.Parse(c.Param("cardid"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "카드 ID가 유효하지 않습니다.", err))
+		return
+	}
+
+	queryParams, err := bindBoardActivitiesQuery(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 사용자 ID입니다.", err))
+		return
+	}
+
+	response, err := h.boardUsecase.GetCardActivities(userId.(uint), cardID, queryParams)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "카드 활동 조회 성공", response))
+}
+
+func bindBoardActivitiesQuery(c *gin.Context) (*req.GetBoardActivitiesQueryParams, error) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	queryParams := &req.GetBoardActivitiesQueryParams{
+		Action:     c.Query("action"),
+		TargetType: c.Query("target_type"),
+		Cursor:     c.Query("cursor"),
+		Limit:      limit,
+	}
+
+	if userId := c.Query("user_id"); userId != "" {
+		userIdUint, err := strconv.ParseUint(userId, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		queryParams.UserID = uint(userIdUint)
+	}
+
+	return queryParams, nil
+}
+
+// TODO 카드 첨부 파일 업로드 (multipart files) - 응답의 파일 정보로 자동 저장 attachment create
+func (h *BoardHandler) UploadBoardAttachments(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 사용자입니다.", nil))
+		return
+	}
+
+	projectID, err := strconv.ParseUint(c.Param("projectid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "프로젝트 ID가 유효하지 않습니다.", err))
+		return
+	}
+
+	boardID, err := strconv.ParseUint(c.Param("boardid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "보드 ID가 유효하지 않습니다.", err))
+		return
+	}
+
+	var files []req.BoardAttachmentRequest
+	if boardAttachments, exists := c.Get("board_attachments"); exists {
+		uploadedFiles, ok := boardAttachments.([]middleware.UploadedFile)
+		if !ok {
+			c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "첨부 파일 처리 실패", nil))
+			return
+		}
+		for _, uploadedFile := range uploadedFiles {
+			files = append(files, req.BoardAttachmentRequest{
+				URL:         uploadedFile.URL,
+				Name:        uploadedFile.Name,
+				Size:        uploadedFile.Size,
+				ContentType: uploadedFile.ContentType,
+			})
+		}
+	}
+
+	response, err := h.boardUsecase.UploadBoardAttachments(userId.(uint), uint(projectID), uint(boardID), files)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "첨부 파일 업로드 성공", response))
+}
+
+// ! 보드 템플릿 관련
+// 회사 보드 템플릿 목록 조회
+func (h *BoardHandler) GetBoardTemplates(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 사용자입니다.", nil))
+		return
+	}
+
+	response, err := h.boardUsecase.GetBoardTemplates(userId.(uint))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "보드 템플릿 조회 성공", response))
+}
+
+func (h *BoardHandler) GetBoardTemplate(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 사용자입니다.", nil))
+		return
+	}
+
+	templateID, err := strconv.ParseUint(c.Param("templateid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "템플릿 ID가 유효하지 않습니다.", err))
+		return
+	}
+
+	response, err := h.boardUsecase.GetBoardTemplate(userId.(uint), uint(templateID))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "보드 템플릿 조회 성공", response))
+}
+
+func (h *BoardHandler) CreateBoardTemplate(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 사용자입니다.", nil))
+		return
+	}
+
+	var request req.CreateBoardTemplateRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.boardUsecase.CreateBoardTemplate(userId.(uint), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "보드 템플릿 생성 성공", response))
+}
+
+// 기존 보드를 템플릿으로 저장
+func (h *BoardHandler) SaveBoardAsTemplate(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 사용자입니다.", nil))
+		return
+	}
+
+	projectID, err := strconv.ParseUint(c.Param("projectid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "프로젝트 ID가 유효하지 않습니다.", err))
+		return
+	}
+
+	boardID, err := strconv.ParseUint(c.Param("boardid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "보드 ID가 유효하지 않습니다.", err))
+		return
+	}
+
+	var request req.SaveBoardTemplateRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.boardUsecase.SaveBoardAsTemplate(userId.(uint), uint(projectID), uint(boardID), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "보드 템플릿 저장 성공", response))
+}
+
+func (h *BoardHandler) DeleteBoardTemplate(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 사용자입니다.", nil))
+		return
+	}
+
+	templateID, err := strconv.ParseUint(c.Param("templateid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "템플릿 ID가 유효하지 않습니다.", err))
+		return
+	}
+
+	if err := h.boardUsecase.DeleteBoardTemplate(userId.(uint), uint(templateID)); err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "보드 템플릿 삭제 성공", nil))
+}
+
+// 보드를 다른 프로젝트로 복사
+func (h *BoardHandler) CopyBoard(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 사용자입니다.", nil))
+		return
+	}
+
+	projectID, err := strconv.ParseUint(c.Param("projectid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "프로젝트 ID가 유효하지 않습니다.", err))
+		return
+	}
+
+	boardID, err := strconv.ParseUint(c.Param("boardid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "보드 ID가 유효하지 않습니다.", err))
+		return
+	}
+
+	var request req.CopyBoardRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.boardUsecase.CopyBoard(userId.(uint), uint(projectID), uint(boardID), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "보드 복사 성공", response))
+}
@@ -11,6 +11,8 @@ import (
 	"link/internal/chat/usecase"
 	"link/pkg/common"
 	"link/pkg/dto/req"
+	"link/pkg/dto/res"
+	"link/pkg/middleware"
 	"link/pkg/ws"
 )
 
@@ -201,6 +203,7 @@ func (h *ChatHandler) GetChatMessages(c *gin.Context) {
 		Page:   page,
 		Limit:  limit,
 		Cursor: cursor,
+		Around: c.Query("around"),
 	}
 
 	responses, err := h.chatUsecase.GetChatMessages(userId.(uint), uint(targetChatRoomId), &queryParams)
@@ -216,6 +219,63 @@ func (h *ChatHandler) GetChatMessages(c *gin.Context) {
 	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅 내용 조회 성공", responses))
 }
 
+// TODO 채팅 메시지 검색 - q(필수), chat_room_id, sender_id, from, to, page, limit
+func (h *ChatHandler) SearchChatMessages(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	queryParams := req.SearchChatMessagesQueryParams{
+		Query: c.Query("q"),
+		From:  c.Query("from"),
+		To:    c.Query("to"),
+		Page:  page,
+		Limit: limit,
+	}
+
+	if chatRoomId := c.Query("chat_room_id"); chatRoomId != "" {
+		chatRoomIdUint, err := strconv.ParseUint(chatRoomId, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+			return
+		}
+		queryParams.ChatRoomID = uint(chatRoomIdUint)
+	}
+
+	if senderId := c.Query("sender_id"); senderId != "" {
+		senderIdUint, err := strconv.ParseUint(senderId, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 사용자 ID입니다", err))
+			return
+		}
+		queryParams.SenderID = uint(senderIdUint)
+	}
+
+	response, err := h.chatUsecase.SearchChatMessages(userId.(uint), &queryParams)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅 검색 성공", response))
+}
+
 // TODO 채팅 메시지 삭제
 func (h *ChatHandler) DeleteChatMessage(c *gin.Context) {
 	senderId, exists := c.Get("userId")
@@ -243,3 +303,547 @@ func (h *ChatHandler) DeleteChatMessage(c *gin.Context) {
 
 	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅 메시지 삭제 성공", nil))
 }
+
+// TODO 채팅 메시지 전송 (첨부 파일) - multipart: content, reply_to, files
+func (h *ChatHandler) SendChatMessage(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	var request req.SendMessageRequest
+	if err := c.ShouldBind(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	chatAttachments, exists := c.Get("chat_attachments")
+	if exists {
+		uploadedFiles, ok := chatAttachments.([]middleware.UploadedFile)
+		if !ok {
+			c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "첨부 파일 처리 실패", nil))
+			return
+		}
+		for _, uploadedFile := range uploadedFiles {
+			request.Attachments = append(request.Attachments, req.ChatAttachmentRequest{
+				URL:         uploadedFile.URL,
+				Name:        uploadedFile.Name,
+				Size:        uploadedFile.Size,
+				ContentType: uploadedFile.ContentType,
+			})
+		}
+	}
+
+	response, err := h.chatUsecase.SaveMessage(userId.(uint), uint(chatRoomId), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	h.hub.SendMessageToChatRoom(uint(chatRoomId), res.JsonResponse{
+		Success: true,
+		Type:    "chat",
+		Payload: response,
+	})
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅 메시지 전송 성공", response))
+}
+
+// TODO 채팅 메시지 수정
+func (h *ChatHandler) EditChatMessage(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	var request req.EditChatMessageRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.EditChatMessage(userId.(uint), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	h.hub.SendMessageToChatRoom(request.ChatRoomID, res.JsonResponse{
+		Success: true,
+		Type:    "chat.edit",
+		Payload: response,
+	})
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅 메시지 수정 성공", response))
+}
+
+// TODO 채팅 메시지 수정 이력
+func (h *ChatHandler) GetChatMessageHistory(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.GetChatMessageHistory(userId.(uint), uint(chatRoomId), c.Param("messageid"))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅 메시지 수정 이력 조회 성공", response))
+}
+
+// TODO 채팅 메시지 이모지 반응 추가
+func (h *ChatHandler) AddChatReaction(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	var request req.ChatReactionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.AddChatReaction(userId.(uint), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	h.hub.SendMessageToChatRoom(request.ChatRoomID, res.JsonResponse{
+		Success: true,
+		Type:    "chat.reaction",
+		Payload: response,
+	})
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "이모지 반응 추가 성공", response))
+}
+
+// TODO 채팅 메시지 이모지 반응 취소
+func (h *ChatHandler) RemoveChatReaction(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	var request req.ChatReactionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.RemoveChatReaction(userId.(uint), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	h.hub.SendMessageToChatRoom(request.ChatRoomID, res.JsonResponse{
+		Success: true,
+		Type:    "chat.reaction",
+		Payload: response,
+	})
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "이모지 반응 취소 성공", response))
+}
+
+// 채팅방 관리 시스템 메시지 전송
+func (h *ChatHandler) sendChatSystemMessage(payload *res.ChatSystemPayload) {
+	h.hub.SendMessageToChatRoom(payload.ChatRoomID, res.JsonResponse{
+		Success: true,
+		Type:    "system",
+		Payload: payload,
+	})
+}
+
+// TODO 채팅방 이름 변경
+func (h *ChatHandler) RenameChatRoom(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	var request req.RenameChatRoomRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.RenameChatRoom(userId.(uint), uint(chatRoomId), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	h.sendChatSystemMessage(response)
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅방 이름 변경 성공", response))
+}
+
+// TODO 채팅방 별칭 변경 - 본인에게만 적용되므로 본인 소켓에만 전송
+func (h *ChatHandler) UpdateChatRoomAlias(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	var request req.UpdateChatRoomAliasRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.UpdateChatRoomAlias(userId.(uint), uint(chatRoomId), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	h.hub.SendMessageToUser(userId.(uint), res.JsonResponse{
+		Success: true,
+		Type:    "chat.alias",
+		Payload: response,
+	})
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅방 별칭 변경 성공", response))
+}
+
+// TODO 채팅방 초대
+func (h *ChatHandler) InviteChatRoomMembers(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	var request req.InviteChatRoomMembersRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.InviteChatRoomMembers(userId.(uint), uint(chatRoomId), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	h.sendChatSystemMessage(response)
+	// 초대받은 사용자는 아직 채팅방 소켓에 없으므로 개인 소켓으로 알림
+	for _, invitedUserId := range response.TargetUserIDs {
+		h.hub.SendMessageToUser(invitedUserId, res.JsonResponse{
+			Success: true,
+			Type:    "system",
+			Payload: response,
+		})
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅방 초대 성공", response))
+}
+
+// TODO 채팅방 내보내기
+func (h *ChatHandler) RemoveChatRoomMember(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	targetUserId, err := strconv.ParseUint(c.Param("userid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 사용자 ID입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.RemoveChatRoomMember(userId.(uint), uint(chatRoomId), uint(targetUserId))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	h.sendChatSystemMessage(response)
+	h.hub.SendMessageToUser(uint(targetUserId), res.JsonResponse{
+		Success: true,
+		Type:    "system",
+		Payload: response,
+	})
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅방 내보내기 성공", response))
+}
+
+// TODO 방장 위임
+func (h *ChatHandler) TransferChatRoomOwner(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	var request req.TransferChatRoomOwnerRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.TransferChatRoomOwner(userId.(uint), uint(chatRoomId), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	h.sendChatSystemMessage(response)
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "방장 위임 성공", response))
+}
+
+// TODO 참여자 역할 변경 (관리자 지정/해제)
+func (h *ChatHandler) UpdateChatRoomMemberRole(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	targetUserId, err := strconv.ParseUint(c.Param("userid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 사용자 ID입니다", err))
+		return
+	}
+
+	var request req.UpdateChatRoomMemberRoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.UpdateChatRoomMemberRole(userId.(uint), uint(chatRoomId), uint(targetUserId), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	h.sendChatSystemMessage(response)
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "역할 변경 성공", response))
+}
+
+// TODO 회사 공지 채팅방 생성
+func (h *ChatHandler) CreateAnnouncementChatRoom(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	var request req.CreateAnnouncementChatRoomRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.CreateAnnouncementChatRoom(userId.(uint), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "공지 채팅방 생성 성공", response))
+}
+
+// TODO 채팅방 고정 메시지 목록
+func (h *ChatHandler) GetPinnedChatMessages(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.GetPinnedChatMessages(userId.(uint), uint(chatRoomId))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "고정 메시지 조회 성공", response))
+}
+
+// TODO 메시지 고정
+func (h *ChatHandler) PinChatMessage(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	var request req.PinChatMessageRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.PinChatMessage(userId.(uint), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	h.hub.SendMessageToChatRoom(request.ChatRoomID, res.JsonResponse{
+		Success: true,
+		Type:    "chat.pin",
+		Payload: response,
+	})
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "메시지 고정 성공", response))
+}
+
+// TODO 메시지 고정 해제
+func (h *ChatHandler) UnpinChatMessage(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	var request req.PinChatMessageRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.chatUsecase.UnpinChatMessage(userId.(uint), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	h.hub.SendMessageToChatRoom(request.ChatRoomID, res.JsonResponse{
+		Success: true,
+		Type:    "chat.pin",
+		Payload: response,
+	})
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "메시지 고정 해제 성공", response))
+}
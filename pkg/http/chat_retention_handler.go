@@ -0,0 +1,185 @@
+package http
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	_chatUsecase "link/internal/chat/usecase"
+	"link/pkg/common"
+	"link/pkg/dto/req"
+)
+
+type ChatRetentionHandler struct {
+	chatRetentionUsecase _chatUsecase.ChatRetentionUsecase
+}
+
+func NewChatRetentionHandler(chatRetentionUsecase _chatUsecase.ChatRetentionUsecase) *ChatRetentionHandler {
+	return &ChatRetentionHandler{chatRetentionUsecase: chatRetentionUsecase}
+}
+
+// TODO 회사 채팅 보관 정책 조회
+func (h *ChatRetentionHandler) GetChatRetention(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	response, err := h.chatRetentionUsecase.GetChatRetention(userId.(uint))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅 보관 정책 조회 성공", response))
+}
+
+// TODO 회사 채팅 보관 정책 저장
+func (h *ChatRetentionHandler) UpdateChatRetention(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	var request req.UpdateChatRetentionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.chatRetentionUsecase.UpdateChatRetention(userId.(uint), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "채팅 보관 정책 저장 성공", response))
+}
+
+// TODO 법적 보존 조치 목록
+func (h *ChatRetentionHandler) GetChatLegalHolds(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	response, err := h.chatRetentionUsecase.GetChatLegalHolds(userId.(uint))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "법적 보존 조치 조회 성공", response))
+}
+
+// TODO 법적 보존 조치
+func (h *ChatRetentionHandler) CreateChatLegalHold(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	var request req.CreateChatLegalHoldRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.chatRetentionUsecase.CreateChatLegalHold(userId.(uint), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "법적 보존 조치 성공", response))
+}
+
+// TODO 법적 보존 조치 해제
+func (h *ChatRetentionHandler) ReleaseChatLegalHold(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	holdId, err := strconv.ParseUint(c.Param("holdid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 보존 조치 ID입니다", err))
+		return
+	}
+
+	if err := h.chatRetentionUsecase.ReleaseChatLegalHold(userId.(uint), uint(holdId)); err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "법적 보존 조치 해제 성공", nil))
+}
+
+// TODO 채팅방 대화 내보내기 - ?format=jsonl(기본) | csv, 파일로 바로 내려줌
+func (h *ChatRetentionHandler) ExportChatMessages(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	chatRoomId, err := strconv.ParseUint(c.Param("chatroomid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "유효하지 않은 채팅방 ID입니다", err))
+		return
+	}
+
+	format := c.DefaultQuery("format", _chatUsecase.ChatExportFormatJSONL)
+	write, err := h.chatRetentionUsecase.ExportChatMessages(userId.(uint), uint(chatRoomId), format)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	contentType := "application/x-ndjson; charset=utf-8"
+	if format == _chatUsecase.ChatExportFormatCSV {
+		contentType = "text/csv; charset=utf-8"
+	}
+	fileName := fmt.Sprintf("chat_%d_%s.%s", chatRoomId, time.Now().Format("20060102"), format)
+	c.Header("Content-Type", contentType)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	c.Status(http.StatusOK)
+
+	// 이미 응답을 쓰기 시작했으므로 중간에 실패하면 로그만 남김
+	if err := write(c.Writer); err != nil {
+		log.Printf("채팅 내보내기 중 오류 (채팅방 %d): %v", chatRoomId, err)
+	}
+}
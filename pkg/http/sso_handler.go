@@ -0,0 +1,158 @@
+package http
+
+import (
+	_ssoUsecase "link/internal/sso/usecase"
+	"link/pkg/common"
+	"link/pkg/dto/req"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SsoHandler struct {
+	ssoUsecase _ssoUsecase.SsoUsecase
+}
+
+func NewSsoHandler(ssoUsecase _ssoUsecase.SsoUsecase) *SsoHandler {
+	return &SsoHandler{ssoUsecase: ssoUsecase}
+}
+
+// TODO SSO 로그인 시작 - IdP 로그인 페이지로 이동
+func (h *SsoHandler) StartSso(c *gin.Context) {
+	companyId, err := strconv.ParseUint(c.Param("companyid"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	authUrl, err := h.ssoUsecase.StartSso(uint(companyId))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.Redirect(http.StatusFound, authUrl)
+}
+
+// TODO SSO 콜백 - 브라우저 리다이렉트라 결과는 프론트 /sso/complete로 전달
+// 성공: refreshToken 쿠키 설정 (프론트에서 auth/refresh로 accessToken 발급)
+// 2단계 인증 필요: mfa_token, enrollment_required / 실패: error
+func (h *SsoHandler) SsoCallback(c *gin.Context) {
+	query := url.Values{}
+
+	if idpError := c.Query("error"); idpError != "" {
+		query.Set("error", idpError)
+		c.Redirect(http.StatusFound, ssoCompleteURL(query))
+		return
+	}
+
+	response, token, err := h.ssoUsecase.CompleteSso(c.Query("code"), c.Query("state"), c.ClientIP(), c.Request.UserAgent())
+	if err != nil {
+		message := "서버 에러"
+		if appError, ok := err.(*common.AppError); ok {
+			message = appError.Message
+		}
+		query.Set("error", message)
+		c.Redirect(http.StatusFound, ssoCompleteURL(query))
+		return
+	}
+
+	if token == nil {
+		query.Set("mfa_token", response.Mfa.MfaToken)
+		query.Set("enrollment_required", strconv.FormatBool(response.Mfa.EnrollmentRequired))
+		c.Redirect(http.StatusFound, ssoCompleteURL(query))
+		return
+	}
+
+	setSignInTokens(c, token)
+	c.Redirect(http.StatusFound, ssoCompleteURL(query))
+}
+
+// TODO 회사 SSO 설정 조회 - 회사 관리자
+func (h *SsoHandler) GetCompanySso(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	response, err := h.ssoUsecase.GetCompanySso(userId.(uint))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "SSO 설정 조회 성공", response))
+}
+
+// TODO 회사 SSO 설정 저장 - 회사 관리자
+func (h *SsoHandler) UpdateCompanySso(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	var request req.UpdateCompanySsoRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "잘못된 요청입니다", err))
+		return
+	}
+
+	response, err := h.ssoUsecase.UpdateCompanySso(userId.(uint), &request)
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "SSO 설정 저장 성공", response))
+}
+
+// TODO 회사 SSO 설정 삭제 - 회사 관리자
+func (h *SsoHandler) DeleteCompanySso(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", nil))
+		return
+	}
+
+	if err := h.ssoUsecase.DeleteCompanySso(userId.(uint)); err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "서버 에러", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "SSO 설정 삭제 성공", nil))
+}
+
+// 프론트 SSO 완료 페이지 (LINK_UI_URL이 여러 개면 첫 번째 사용)
+func ssoCompleteURL(query url.Values) string {
+	baseUrl := strings.TrimSpace(strings.Split(os.Getenv("LINK_UI_URL"), ",")[0])
+	if baseUrl == "" {
+		baseUrl = "http://localhost:3000"
+	}
+	completeUrl := strings.TrimRight(baseUrl, "/") + "/sso/complete"
+	if len(query) > 0 {
+		completeUrl += "?" + query.Encode()
+	}
+	return completeUrl
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	_statUsecase "link/internal/stat/usecase"
 	"link/pkg/common"
+	"link/pkg/ws"
 	"net/http"
 	"runtime"
 
@@ -14,12 +15,14 @@ import (
 
 type StatHandler struct {
 	statUsecase _statUsecase.StatUsecase
+	hub         *ws.WebSocketHub
 }
 
 func NewStatHandler(
 	statUsecase _statUsecase.StatUsecase,
+	hub *ws.WebSocketHub,
 ) *StatHandler {
-	return &StatHandler{statUsecase: statUsecase}
+	return &StatHandler{statUsecase: statUsecase, hub: hub}
 }
 
 // TODO 사용자 role 별 사용자 수 조회
@@ -172,6 +175,38 @@ func (h *StatHandler) GetPopularPostStat(c *gin.Context) {
 	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "월별 게시글 통계 조회 성공", response))
 }
 
+// TODO 조회수 DB 반영 워커 상태 조회
+func (h *StatHandler) GetPostViewFlushStat(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", fmt.Errorf("userId가 없습니다")))
+		return
+	}
+
+	response, err := h.statUsecase.GetPostViewFlushStat(userId.(uint))
+	if err != nil {
+		if appError, ok := err.(*common.AppError); ok {
+			c.JSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		} else {
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "조회수 반영 상태 조회 실패", err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "조회수 반영 상태 조회 성공", response))
+}
+
+// TODO 웹소켓 전송 큐 초과로 버려진 메시지 수 (요청을 받은 노드 기준)
+func (h *StatHandler) GetWebSocketDropStat(c *gin.Context) {
+	_, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", fmt.Errorf("userId가 없습니다")))
+		return
+	}
+
+	c.JSON(http.StatusOK, common.NewResponse(http.StatusOK, "웹소켓 메시지 유실 통계 조회 성공", h.hub.GetDropStat()))
+}
+
 //TODO 일자별 출근 통계
 
 //TODO 일자별 사용자 수 조회
@@ -8,6 +8,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	_authUsecase "link/internal/auth/usecase"
 	"link/internal/user/usecase"
 	"link/pkg/common"
 	"link/pkg/dto/req"
@@ -15,11 +16,12 @@ import (
 
 type UserHandler struct {
 	userUsecase usecase.UserUsecase
+	authUsecase _authUsecase.AuthUsecase
 }
 
 // RegisterUserHandler는 회원가입 핸들러를 생성합니다.
-func NewUserHandler(userUsecase usecase.UserUsecase) *UserHandler {
-	return &UserHandler{userUsecase: userUsecase}
+func NewUserHandler(userUsecase usecase.UserUsecase, authUsecase _authUsecase.AuthUsecase) *UserHandler {
+	return &UserHandler{userUsecase: userUsecase, authUsecase: authUsecase}
 }
 
 // ! 회원가입 핸들러
@@ -43,8 +45,14 @@ func (h *UserHandler) RegisterUser(c *gin.Context) {
 		}
 		return
 	}
+
+	//TODO 가입은 완료됐으므로 메일 발송 실패는 로그만 남기고 재발송으로 처리
+	if err := h.authUsecase.SendVerificationEmail(response.Email); err != nil {
+		fmt.Printf("인증 메일 발송 실패: %v", err)
+	}
+
 	// 성공 응답
-	c.JSON(http.StatusCreated, common.NewResponse(http.StatusCreated, "회원가입 완료", response))
+	c.JSON(http.StatusCreated, common.NewResponse(http.StatusCreated, "회원가입 완료 - 이메일 인증 후 로그인할 수 있습니다", response))
 }
 
 // ! 이메일 검증 핸들러
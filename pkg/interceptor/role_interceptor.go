@@ -0,0 +1,65 @@
+package interceptor
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	_userEntity "link/internal/user/entity"
+	"link/pkg/common"
+)
+
+// RequireRole 요청 사용자의 role이 requiredRole 이상(숫자가 작을수록 높은 권한)인지 확인
+// AccessTokenInterceptor 뒤에서 사용
+func (i *TokenInterceptor) RequireRole(requiredRole _userEntity.UserRole) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, ok := i.loadRequestUser(c)
+		if !ok {
+			return
+		}
+
+		if user.Role > requiredRole {
+			c.AbortWithStatusJSON(http.StatusForbidden, common.NewError(http.StatusForbidden, "권한이 없습니다", nil))
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RequireCompanyMember 회사에 소속된 사용자만 허용
+// AccessTokenInterceptor 뒤에서 사용
+func (i *TokenInterceptor) RequireCompanyMember() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, ok := i.loadRequestUser(c)
+		if !ok {
+			return
+		}
+
+		if user.UserProfile == nil || user.UserProfile.CompanyID == nil || *user.UserProfile.CompanyID == 0 {
+			c.AbortWithStatusJSON(http.StatusForbidden, common.NewError(http.StatusForbidden, "회사에 소속된 사용자만 이용할 수 있습니다", nil))
+			return
+		}
+
+		c.Set("companyId", *user.UserProfile.CompanyID)
+		c.Next()
+	}
+}
+
+// 요청 사용자 조회 후 role을 Context에 설정, 실패 시 요청 종료
+func (i *TokenInterceptor) loadRequestUser(c *gin.Context) (*_userEntity.User, bool) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		abortUnauthorized(c, nil)
+		return nil, false
+	}
+
+	user, err := i.authUsecase.GetRequestUser(userId.(uint))
+	if err != nil {
+		abortUnauthorized(c, err)
+		return nil, false
+	}
+
+	c.Set("role", user.Role)
+	return user, true
+}
@@ -0,0 +1,64 @@
+package interceptor
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"link/internal/accesstoken/entity"
+	"link/pkg/common"
+)
+
+// 개인 액세스 토큰으로 인증된 요청이면 토큰 반환
+func requestAccessToken(c *gin.Context) (*entity.PersonalAccessToken, bool) {
+	value, exists := c.Get("accessToken")
+	if !exists {
+		return nil, false
+	}
+	token, ok := value.(*entity.PersonalAccessToken)
+	return token, ok
+}
+
+// RequireScope 개인 액세스 토큰 요청이면 scope 확인 (GET은 readScope, 나머지는 writeScope)
+// 쓰기 scope는 읽기 포함, 로그인(JWT) 요청은 그대로 통과
+func (i *TokenInterceptor) RequireScope(readScope string, writeScope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token, ok := requestAccessToken(c)
+		if !ok {
+			c.Next()
+			return
+		}
+
+		method := c.Request.Method
+		if method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions {
+			if token.HasScope(readScope) || token.HasScope(writeScope) {
+				c.Next()
+				return
+			}
+			i.denyScope(c, token, readScope)
+			return
+		}
+
+		if token.HasScope(writeScope) {
+			c.Next()
+			return
+		}
+		i.denyScope(c, token, writeScope)
+	}
+}
+
+// RequireUserSession 로그인 세션(JWT)만 허용 - 세션/2단계 인증/토큰 관리는 액세스 토큰으로 호출 불가
+func (i *TokenInterceptor) RequireUserSession() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := requestAccessToken(c); ok {
+			c.AbortWithStatusJSON(http.StatusForbidden, common.NewError(http.StatusForbidden, "액세스 토큰으로는 사용할 수 없는 기능입니다", nil))
+			return
+		}
+		c.Next()
+	}
+}
+
+func (i *TokenInterceptor) denyScope(c *gin.Context, token *entity.PersonalAccessToken, scope string) {
+	i.accessTokenUsecase.RecordScopeDenied(token, scope, c.ClientIP(), c.Request.UserAgent(), c.Request.Method+" "+c.FullPath())
+	c.AbortWithStatusJSON(http.StatusForbidden, common.NewError(http.StatusForbidden, "토큰에 필요한 권한(scope)이 없습니다: "+scope, nil))
+}
@@ -6,65 +6,92 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	_accessTokenUsecase "link/internal/accesstoken/usecase"
 	"link/internal/auth/usecase"
+	"link/pkg/common"
 	"link/pkg/util"
 )
 
 type TokenInterceptor struct {
-	authUsecase usecase.AuthUsecase
+	authUsecase        usecase.AuthUsecase
+	accessTokenUsecase _accessTokenUsecase.AccessTokenUsecase
 }
 
-func NewTokenInterceptor(authUsecase usecase.AuthUsecase) *TokenInterceptor {
-	return &TokenInterceptor{authUsecase: authUsecase}
+func NewTokenInterceptor(authUsecase usecase.AuthUsecase, accessTokenUsecase _accessTokenUsecase.AccessTokenUsecase) *TokenInterceptor {
+	return &TokenInterceptor{authUsecase: authUsecase, accessTokenUsecase: accessTokenUsecase}
 }
 
+// 인증 실패 공통 응답 - 모든 보호 라우트에서 동일한 401 응답
+func abortUnauthorized(c *gin.Context, err error) {
+	if appError, ok := err.(*common.AppError); ok {
+		c.AbortWithStatusJSON(appError.StatusCode, common.NewError(appError.StatusCode, appError.Message, appError.Err))
+		return
+	}
+	c.AbortWithStatusJSON(http.StatusUnauthorized, common.NewError(http.StatusUnauthorized, "인증되지 않은 요청입니다", err))
+}
+
+// Access Token 검증 인터셉터 - 토큰이 없거나 유효하지 않으면 401로 요청 종료 (fail closed)
 func (i *TokenInterceptor) AccessTokenInterceptor() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// OPTIONS 요청은 인증 없이 바로 처리
 		// accessToken, _ := c.Cookie("accessToken")
 		authorization := c.GetHeader("Authorization")
 
 		//TODO Bearer 제거
 		token := strings.TrimPrefix(authorization, "Bearer ")
+		if token == "" {
+			abortUnauthorized(c, nil)
+			return
+		}
 
-		if token != "" {
-			// Access Token 검증
-			claims, err := util.ValidateAccessToken(token)
-			if err == nil {
-				// Access Token이 유효한 경우 email과 userId를 Context에 설정
-				c.Set("email", claims.Email)
-				c.Set("userId", claims.UserId)
-				c.Next() // Access Token이 유효하면 다음 핸들러로 진행
+		//TODO 개인 액세스 토큰 (link_pat_...) - 세션 없이 scope 범위 안에서만 허용
+		if _accessTokenUsecase.IsAccessToken(token) {
+			accessToken, err := i.accessTokenUsecase.ValidateAccessToken(token, c.ClientIP(), c.Request.UserAgent(), c.Request.Method+" "+c.FullPath())
+			if err != nil {
+				abortUnauthorized(c, err)
 				return
 			}
+
+			c.Set("email", accessToken.UserEmail)
+			c.Set("userId", accessToken.UserID)
+			c.Set("accessToken", accessToken)
+			c.Next()
+			return
 		}
-		// Access Token이 유효하지 않으면 다음 단계로 넘어감 (Refresh Token 검증)
+
+		// 서명/만료 + 폐기된 세션(로그아웃, 강제 로그아웃) 검증
+		claims, err := i.authUsecase.ValidateAccessToken(token)
+		if err != nil {
+			abortUnauthorized(c, err)
+			return
+		}
+
+		// Access Token이 유효한 경우 email과 userId를 Context에 설정
+		c.Set("email", claims.Email)
+		c.Set("userId", claims.UserId)
+		c.Set("sessionId", claims.SessionId)
 		c.Next()
 	}
 }
 
-// Refresh Token 검증 및 Access Token 재발급 인터셉터
+// Refresh Token 검증 인터셉터 - 서명/만료만 확인하고, 회전 및 재사용 검증은 핸들러(usecase)에서 처리
 func (i *TokenInterceptor) RefreshTokenInterceptor() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		email, _ := c.Get("email")
-		userId, _ := c.Get("userId")
-		// Access Token이 유효하다면 바로 다음 단계로
-		if email != nil && userId != nil {
-			c.Next()
-			return
+		refreshToken := c.GetHeader("RefreshToken")
+		if refreshToken == "" {
+			// 로그인 시 쿠키로 발급된 refreshToken도 허용
+			refreshToken, _ = c.Cookie("refreshToken")
 		}
-		//아니라면, 리프레시 토큰 검증
 
-		refreshToken := c.GetHeader("RefreshToken")
 		claims, err := util.ValidateRefreshToken(refreshToken)
 		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "유효하지 않은 Refresh Token입니다. 다시 로그인 해주세요."})
-			c.Abort()
+			abortUnauthorized(c, common.NewError(http.StatusUnauthorized, "유효하지 않은 Refresh Token입니다. 다시 로그인 해주세요.", err))
 			return
 		}
 
 		c.Set("email", claims.Email)
 		c.Set("userId", claims.UserId)
+		c.Set("sessionId", claims.SessionId)
+		c.Set("refreshToken", refreshToken)
 		c.Next()
 	}
 }
@@ -0,0 +1,35 @@
+package mailer
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// fileMailer 실제로 발송하지 않고 .eml 파일로 저장 + 로그 출력 (로컬 개발/테스트용)
+type fileMailer struct {
+	directory string
+}
+
+func NewFileMailer(directory string) Mailer {
+	return &fileMailer{directory: directory}
+}
+
+func (m *fileMailer) Send(mail *Mail) error {
+	if err := os.MkdirAll(m.directory, os.ModePerm); err != nil {
+		return fmt.Errorf("메일 저장 폴더 생성 실패: %w", err)
+	}
+
+	fileName := fmt.Sprintf("%s_%s.eml", time.Now().Format("20060102150405"), uuid.NewString())
+	path := filepath.Join(m.directory, fileName)
+	if err := os.WriteFile(path, buildMessage("no-reply@link.local", mail), 0644); err != nil {
+		return fmt.Errorf("메일 파일 저장 실패: %w", err)
+	}
+
+	log.Printf("메일 저장 완료 - 수신자: %s, 제목: %s, 파일: %s", mail.To, mail.Subject, path)
+	return nil
+}
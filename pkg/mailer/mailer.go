@@ -0,0 +1,42 @@
+package mailer
+
+import (
+	"log"
+	"os"
+)
+
+// Mail 발송할 메일
+type Mail struct {
+	To      string
+	Subject string
+	Body    string // text/plain
+}
+
+// Mailer 메일 발송 추상화 - 운영은 SMTP, 로컬/테스트는 파일로 떨굼
+type Mailer interface {
+	Send(mail *Mail) error
+}
+
+// NewMailer MAIL_DRIVER 환경변수로 구현체 선택 (smtp | file, 기본 file)
+func NewMailer() Mailer {
+	switch os.Getenv("MAIL_DRIVER") {
+	case "smtp":
+		return NewSMTPMailer(
+			os.Getenv("SMTP_HOST"),
+			getEnv("SMTP_PORT", "587"),
+			os.Getenv("SMTP_USERNAME"),
+			os.Getenv("SMTP_PASSWORD"),
+			getEnv("MAIL_FROM", "no-reply@link.com"),
+		)
+	default:
+		log.Println("MAIL_DRIVER가 smtp가 아니므로 메일을 파일로 저장합니다")
+		return NewFileMailer(getEnv("MAIL_FILE_DIR", "./static/mails"))
+	}
+}
+
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,48 @@
+package mailer
+
+import (
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+type smtpMailer struct {
+	host     string
+	port     string
+	username string
+	password string
+	from     string
+}
+
+func NewSMTPMailer(host, port, username, password, from string) Mailer {
+	return &smtpMailer{host: host, port: port, username: username, password: password, from: from}
+}
+
+func (m *smtpMailer) Send(mail *Mail) error {
+	var auth smtp.Auth
+	if m.username != "" {
+		auth = smtp.PlainAuth("", m.username, m.password, m.host)
+	}
+
+	if err := smtp.SendMail(net.JoinHostPort(m.host, m.port), auth, m.from, []string{mail.To}, buildMessage(m.from, mail)); err != nil {
+		return fmt.Errorf("SMTP 메일 발송 실패: %w", err)
+	}
+	return nil
+}
+
+// RFC 5322 메시지 생성 (제목은 한글이 들어가므로 B 인코딩)
+func buildMessage(from string, mail *Mail) []byte {
+	var builder strings.Builder
+	builder.WriteString("From: " + from + "\r\n")
+	builder.WriteString("To: " + mail.To + "\r\n")
+	builder.WriteString("Subject: " + mime.BEncoding.Encode("UTF-8", mail.Subject) + "\r\n")
+	builder.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	builder.WriteString("MIME-Version: 1.0\r\n")
+	builder.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	builder.WriteString("\r\n")
+	builder.WriteString(strings.ReplaceAll(mail.Body, "\n", "\r\n"))
+	return []byte(builder.String())
+}
@@ -0,0 +1,148 @@
+package middleware
+
+import (
+	"fmt"
+	"link/pkg/common"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	MaxAttachmentCount = 10
+	MaxAttachmentSize  = 20 << 20 // 파일당 20MB
+)
+
+// 채팅 첨부로 허용하는 확장자 (실행 파일, html 등은 업로드 불가)
+var allowedAttachmentExts = map[string]bool{
+	".jpg": true, ".jpeg": true, ".png": true, ".webp": true, ".gif": true,
+	".pdf": true, ".txt": true, ".csv": true, ".zip": true, ".hwp": true,
+	".doc": true, ".docx": true, ".xls": true, ".xlsx": true, ".ppt": true, ".pptx": true,
+}
+
+type FileUploadMiddleware struct {
+	directory    string
+	staticPrefix string // URL 경로의 Prefix
+}
+
+// 업로드된 파일 정보 (원본 파일명, 크기 포함)
+type UploadedFile struct {
+	URL         string
+	Name        string
+	Size        int64
+	ContentType string
+}
+
+// NewFileUploadMiddleware는 FileUploadMiddleware를 생성하는 함수입니다.
+func NewFileUploadMiddleware(directory, staticPrefix string) *FileUploadMiddleware {
+	return &FileUploadMiddleware{
+		directory:    directory,
+		staticPrefix: staticPrefix,
+	}
+}
+
+// TODO 채팅 첨부 파일 업로드 미들웨어 - 이미지 외 문서 파일도 허용
+func (f *FileUploadMiddleware) ChatAttachmentUploadMiddleware() gin.HandlerFunc {
+	return f.attachmentUploadMiddleware("chat_attachments")
+}
+
+// TODO 칸반보드 카드 첨부 파일 업로드 미들웨어 (채팅 첨부와 같은 제한)
+func (f *FileUploadMiddleware) BoardAttachmentUploadMiddleware() gin.HandlerFunc {
+	return f.attachmentUploadMiddleware("board_attachments")
+}
+
+// 업로드한 파일 정보는 contextKey에 []UploadedFile로 저장
+// 멤버 확인 등 핸들러가 실패 응답을 보내면 저장한 파일은 삭제
+func (f *FileUploadMiddleware) attachmentUploadMiddleware(contextKey string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		files, err := c.MultipartForm()
+		if err != nil {
+			c.Next()
+			return
+		}
+
+		formFiles := files.File["files"]
+		if len(formFiles) == 0 {
+			c.Next()
+			return
+		}
+
+		if len(formFiles) > MaxAttachmentCount {
+			c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, fmt.Sprintf("첨부 파일은 최대 %d개까지 가능합니다", MaxAttachmentCount), nil))
+			c.Abort()
+			return
+		}
+
+		now := time.Now().Format("2006-01-02")
+		folderPath := filepath.Join(f.directory, now)
+		if err := os.MkdirAll(folderPath, os.ModePerm); err != nil {
+			fmt.Printf("폴더 생성 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "폴더 생성 실패", err))
+			c.Abort()
+			return
+		}
+
+		//저장 전에 전체 파일 검사 (일부만 저장되고 남지 않도록)
+		for _, file := range formFiles {
+			ext := strings.ToLower(filepath.Ext(file.Filename))
+			if !allowedAttachmentExts[ext] {
+				fmt.Printf("허용되지 않는 파일 형식입니다: %s", ext)
+				c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "허용되지 않는 파일 형식입니다", nil))
+				c.Abort()
+				return
+			}
+
+			if file.Size > MaxAttachmentSize {
+				c.JSON(http.StatusBadRequest, common.NewError(http.StatusBadRequest, "파일 크기는 20MB를 넘을 수 없습니다", nil))
+				c.Abort()
+				return
+			}
+		}
+
+		uploadedFiles := make([]UploadedFile, 0, len(formFiles))
+		savedPaths := make([]string, 0, len(formFiles))
+
+		for _, file := range formFiles {
+			ext := strings.ToLower(filepath.Ext(file.Filename))
+			uniqueFileName := uuid.New().String()[:15]
+			fileName := uniqueFileName + ext
+			filePath := filepath.Join(folderPath, fileName)
+
+			if err := c.SaveUploadedFile(file, filePath); err != nil {
+				fmt.Printf("파일 저장 실패: %v", err)
+				removeFiles(savedPaths)
+				c.JSON(http.StatusInternalServerError, common.NewError(http.StatusInternalServerError, "파일 저장 실패", err))
+				c.Abort()
+				return
+			}
+			savedPaths = append(savedPaths, filePath)
+
+			uploadedFiles = append(uploadedFiles, UploadedFile{
+				URL:         fmt.Sprintf("%s/%s/%s", f.staticPrefix, now, fileName),
+				Name:        filepath.Base(file.Filename),
+				Size:        file.Size,
+				ContentType: file.Header.Get("Content-Type"),
+			})
+		}
+
+		c.Set(contextKey, uploadedFiles)
+		c.Next()
+
+		if c.Writer.Status() >= http.StatusBadRequest {
+			removeFiles(savedPaths)
+		}
+	}
+}
+
+func removeFiles(paths []string) {
+	for _, path := range paths {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			fmt.Printf("파일 삭제 실패: %v", err)
+		}
+	}
+}
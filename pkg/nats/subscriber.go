@@ -29,3 +29,18 @@ func (s *NatsSubscriber) SubscribeEvent(subject string, handler func(msg *nats.M
 
 	return nil
 }
+
+// QueueSubscribeEvent 같은 queue 그룹 중 한 구독자만 메시지를 받음 (여러 파드 중 한 곳에서만 처리)
+func (s *NatsSubscriber) QueueSubscribeEvent(subject string, queue string, handler func(msg *nats.Msg)) error {
+	_, err := s.conn.QueueSubscribe(subject, queue, func(msg *nats.Msg) {
+		fmt.Printf("NATS 이벤트 수신[TOPIC: %s, QUEUE: %s]: %v ", subject, queue, msg.Data)
+		handler(msg)
+	})
+
+	if err != nil {
+		fmt.Printf("NATS 이벤트 수신 오류[TOPIC: %s]: %v ", subject, err)
+		return common.NewError(500, "NATS 이벤트 수신 오류", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,338 @@
+package sso
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// OIDC Authorization Code Flow 클라이언트 (discovery + code 교환 + id_token 검증)
+const providerCacheTTL = time.Minute * 10
+
+// ProviderConfig 회사별 IdP 설정
+type ProviderConfig struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURI  string
+	Scopes       []string
+}
+
+// Discovery /.well-known/openid-configuration
+type Discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint,omitempty"`
+}
+
+type provider struct {
+	discovery *Discovery
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+type OidcClient struct {
+	httpClient    *http.Client
+	allowInsecure bool // 로컬 개발(SSO_STUB_IDP)에서만 http, 사설망 IdP 허용
+	mu            sync.Mutex
+	providers     map[string]*provider // issuer -> discovery, 공개키 캐시
+}
+
+// issuer는 회사 관리자가 입력하는 값이라 서버가 내부망으로 요청하지 않도록
+// https만 허용하고 사설/루프백/링크로컬 주소로의 연결을 막음 (SSRF)
+func NewOidcClient() *OidcClient {
+	allowInsecure := os.Getenv("SSO_STUB_IDP") == "true"
+
+	dialer := &net.Dialer{Timeout: 5 * time.Second}
+	if !allowInsecure {
+		dialer.Control = blockPrivateAddress
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil // 프록시를 거치면 실제 대상 주소를 검사할 수 없음
+	transport.DialContext = dialer.DialContext
+
+	client := &OidcClient{
+		allowInsecure: allowInsecure,
+		providers:     make(map[string]*provider),
+	}
+	client.httpClient = &http.Client{
+		Timeout:   10 * time.Second,
+		Transport: transport,
+		CheckRedirect: func(request *http.Request, via []*http.Request) error {
+			if len(via) >= 5 {
+				return errors.New("리다이렉트 횟수 초과")
+			}
+			return client.checkEndpoint(request.URL)
+		},
+	}
+	return client
+}
+
+// 운영에서는 https만 허용
+func (c *OidcClient) checkEndpoint(endpoint *url.URL) error {
+	if endpoint.Host == "" {
+		return fmt.Errorf("잘못된 주소: %s", endpoint.String())
+	}
+	if endpoint.Scheme != "https" && !(c.allowInsecure && endpoint.Scheme == "http") {
+		return fmt.Errorf("https 주소만 사용할 수 있습니다: %s", endpoint.String())
+	}
+	return nil
+}
+
+// 연결 직전 실제 IP 검사 (DNS rebinding 포함)
+func blockPrivateAddress(network string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return fmt.Errorf("허용되지 않은 IdP 주소: %s", host)
+	}
+	return nil
+}
+
+// Discover issuer의 discovery 문서 조회 (설정 저장 시 검증용으로도 사용)
+func (c *OidcClient) Discover(issuer string) (*Discovery, error) {
+	p, err := c.getProvider(issuer, false)
+	if err != nil {
+		return nil, err
+	}
+	return p.discovery, nil
+}
+
+// AuthCodeURL IdP 로그인 페이지 주소
+func (c *OidcClient) AuthCodeURL(config *ProviderConfig, state string, nonce string) (string, error) {
+	p, err := c.getProvider(config.Issuer, false)
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", config.ClientID)
+	query.Set("redirect_uri", config.RedirectURI)
+	query.Set("scope", strings.Join(config.Scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+
+	separator := "?"
+	if strings.Contains(p.discovery.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return p.discovery.AuthorizationEndpoint + separator + query.Encode(), nil
+}
+
+// Exchange authorization code -> id_token
+func (c *OidcClient) Exchange(config *ProviderConfig, code string) (string, error) {
+	p, err := c.getProvider(config.Issuer, false)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", config.RedirectURI)
+	form.Set("client_id", config.ClientID)
+
+	request, err := http.NewRequest(http.MethodPost, p.discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	if err := c.checkEndpoint(request.URL); err != nil {
+		return "", err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+	// client_secret_basic (RFC 6749 2.3.1)
+	request.SetBasicAuth(url.QueryEscape(config.ClientID), url.QueryEscape(config.ClientSecret))
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return "", fmt.Errorf("토큰 요청 실패: %w", err)
+	}
+	defer response.Body.Close()
+
+	var body struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("토큰 응답 파싱 실패: %w", err)
+	}
+	if response.StatusCode != http.StatusOK || body.IDToken == "" {
+		return "", fmt.Errorf("토큰 요청 거부 (%d): %s %s", response.StatusCode, body.Error, body.ErrorDescription)
+	}
+
+	return body.IDToken, nil
+}
+
+// VerifyIDToken 서명(jwks) + iss/aud/exp/nonce 검증 후 클레임 반환
+func (c *OidcClient) VerifyIDToken(config *ProviderConfig, idToken string, nonce string) (map[string]interface{}, error) {
+	p, err := c.getProvider(config.Issuer, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// 저장된 issuer는 끝의 "/"를 제거한 값이라 IdP가 실제 발급하는 discovery의 issuer로 비교 (Auth0 등)
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(idToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return c.publicKey(config.Issuer, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "EdDSA"}),
+		jwt.WithIssuer(p.discovery.Issuer),
+		jwt.WithAudience(config.ClientID),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("id_token 검증 실패: %w", err)
+	}
+
+	if tokenNonce, _ := claims["nonce"].(string); tokenNonce != nonce {
+		return nil, fmt.Errorf("id_token nonce 불일치")
+	}
+
+	return claims, nil
+}
+
+// kid에 해당하는 키가 없으면 IdP 키 교체로 보고 jwks 한 번 다시 조회
+func (c *OidcClient) publicKey(issuer string, kid string) (crypto.PublicKey, error) {
+	p, err := c.getProvider(issuer, false)
+	if err != nil {
+		return nil, err
+	}
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+
+	p, err = c.getProvider(issuer, true)
+	if err != nil {
+		return nil, err
+	}
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("알 수 없는 kid: %s", kid)
+}
+
+func (c *OidcClient) getProvider(issuer string, forceRefresh bool) (*provider, error) {
+	issuer = strings.TrimRight(issuer, "/")
+
+	c.mu.Lock()
+	cached, ok := c.providers[issuer]
+	c.mu.Unlock()
+	if ok && !forceRefresh && time.Since(cached.fetchedAt) < providerCacheTTL {
+		return cached, nil
+	}
+
+	var discovery Discovery
+	if err := c.getJSON(issuer+"/.well-known/openid-configuration", &discovery); err != nil {
+		return nil, fmt.Errorf("OIDC discovery 실패: %w", err)
+	}
+	if strings.TrimRight(discovery.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("discovery issuer 불일치: %s", discovery.Issuer)
+	}
+
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := c.getJSON(discovery.JwksURI, &jwks); err != nil {
+		return nil, fmt.Errorf("jwks 조회 실패: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey)
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+
+	p := &provider{discovery: &discovery, keys: keys, fetchedAt: time.Now()}
+	c.mu.Lock()
+	c.providers[issuer] = p
+	c.mu.Unlock()
+	return p, nil
+}
+
+func (c *OidcClient) getJSON(endpoint string, out interface{}) error {
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+	if err := c.checkEndpoint(parsed); err != nil {
+		return err
+	}
+
+	response, err := c.httpClient.Get(endpoint)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s 응답 코드 %d", endpoint, response.StatusCode)
+	}
+	return json.NewDecoder(response.Body).Decode(out)
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("지원하지 않는 curve: %s", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("잘못된 Ed25519 키")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("지원하지 않는 키 타입: %s", k.Kty)
+	}
+}
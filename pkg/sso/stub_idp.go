@@ -0,0 +1,184 @@
+package sso
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// StubIdP 로컬 개발/테스트용 OIDC IdP (SSO_STUB_IDP=true 일 때만 라우팅)
+// 로그인 화면 없이 authorize 요청의 login_hint(이메일), name, department, position을 그대로 클레임으로 발급
+//
+//	issuer: SSO_STUB_ISSUER (예: http://localhost:8080/dev/sso/stub)
+//	client_secret은 SSO_STUB_CLIENT_SECRET이 설정되어 있을 때만 확인
+type StubIdP struct {
+	issuer       string
+	clientSecret string
+	kid          string
+	privateKey   ed25519.PrivateKey
+	publicKey    ed25519.PublicKey
+
+	mu    sync.Mutex
+	codes map[string]*stubAuthorization
+}
+
+type stubAuthorization struct {
+	clientId    string
+	redirectUri string
+	nonce       string
+	claims      map[string]interface{}
+	expiresAt   time.Time
+}
+
+func NewStubIdP(issuer string, clientSecret string) (*StubIdP, error) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StubIdP{
+		issuer:       strings.TrimRight(issuer, "/"),
+		clientSecret: clientSecret,
+		kid:          "stub-" + uuid.NewString()[:8],
+		privateKey:   privateKey,
+		publicKey:    publicKey,
+		codes:        make(map[string]*stubAuthorization),
+	}, nil
+}
+
+// RegisterRoutes issuer 경로에 맞는 그룹에 등록
+func (s *StubIdP) RegisterRoutes(group gin.IRouter) {
+	group.GET("/.well-known/openid-configuration", s.discovery)
+	group.GET("/jwks", s.jwks)
+	group.GET("/authorize", s.authorize)
+	group.POST("/token", s.token)
+}
+
+func (s *StubIdP) discovery(c *gin.Context) {
+	c.JSON(http.StatusOK, Discovery{
+		Issuer:                s.issuer,
+		AuthorizationEndpoint: s.issuer + "/authorize",
+		TokenEndpoint:         s.issuer + "/token",
+		JwksURI:               s.issuer + "/jwks",
+	})
+}
+
+func (s *StubIdP) jwks(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"keys": []jsonWebKey{{
+		Kty: "OKP",
+		Kid: s.kid,
+		Use: "sig",
+		Crv: "Ed25519",
+		X:   base64.RawURLEncoding.EncodeToString(s.publicKey),
+	}}})
+}
+
+func (s *StubIdP) authorize(c *gin.Context) {
+	email := c.Query("login_hint")
+	redirectUri := c.Query("redirect_uri")
+	if email == "" || redirectUri == "" || c.Query("client_id") == "" {
+		c.String(http.StatusBadRequest, "client_id, redirect_uri, login_hint(이메일)가 필요합니다")
+		return
+	}
+
+	name := c.Query("name")
+	if name == "" {
+		name = strings.Split(email, "@")[0]
+	}
+	claims := map[string]interface{}{
+		"sub":            "stub|" + email,
+		"email":          email,
+		"email_verified": true,
+		"name":           name,
+	}
+	if department := c.Query("department"); department != "" {
+		claims["department"] = department
+	}
+	if position := c.Query("position"); position != "" {
+		claims["position"] = position
+	}
+
+	code := uuid.NewString()
+	s.mu.Lock()
+	s.codes[code] = &stubAuthorization{
+		clientId:    c.Query("client_id"),
+		redirectUri: redirectUri,
+		nonce:       c.Query("nonce"),
+		claims:      claims,
+		expiresAt:   time.Now().Add(time.Minute),
+	}
+	s.mu.Unlock()
+
+	target, err := url.Parse(redirectUri)
+	if err != nil {
+		c.String(http.StatusBadRequest, "잘못된 redirect_uri")
+		return
+	}
+	query := target.Query()
+	query.Set("code", code)
+	query.Set("state", c.Query("state"))
+	target.RawQuery = query.Encode()
+	c.Redirect(http.StatusFound, target.String())
+}
+
+func (s *StubIdP) token(c *gin.Context) {
+	clientId, clientSecret, ok := c.Request.BasicAuth()
+	if !ok {
+		clientId, clientSecret = c.PostForm("client_id"), c.PostForm("client_secret")
+	}
+	clientId, _ = url.QueryUnescape(clientId)
+	clientSecret, _ = url.QueryUnescape(clientSecret)
+
+	if s.clientSecret != "" && clientSecret != s.clientSecret {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid_client"})
+		return
+	}
+
+	code := c.PostForm("code")
+	s.mu.Lock()
+	authorization, exists := s.codes[code]
+	delete(s.codes, code)
+	s.mu.Unlock()
+
+	if !exists || time.Now().After(authorization.expiresAt) ||
+		authorization.clientId != clientId || authorization.redirectUri != c.PostForm("redirect_uri") {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid_grant"})
+		return
+	}
+
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":   s.issuer,
+		"aud":   clientId,
+		"iat":   now.Unix(),
+		"exp":   now.Add(5 * time.Minute).Unix(),
+		"nonce": authorization.nonce,
+	}
+	for key, value := range authorization.claims {
+		claims[key] = value
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	token.Header["kid"] = s.kid
+	idToken, err := token.SignedString(s.privateKey)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "server_error"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"access_token": uuid.NewString(),
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     idToken,
+	})
+}
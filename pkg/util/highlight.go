@@ -0,0 +1,100 @@
+package util
+
+import (
+	"html"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// SearchTerms 검색어에서 강조할 단어 추출 ("구문"은 하나로, -제외어는 제외)
+func SearchTerms(query string) []string {
+	terms := make([]string, 0)
+
+	for i, part := range strings.Split(query, `"`) {
+		if i%2 == 1 {
+			// 따옴표 안은 구문 그대로
+			if phrase := strings.TrimSpace(part); phrase != "" {
+				terms = append(terms, strings.ToLower(phrase))
+			}
+			continue
+		}
+		for _, word := range strings.Fields(part) {
+			if strings.HasPrefix(word, "-") {
+				continue
+			}
+			terms = append(terms, strings.ToLower(word))
+		}
+	}
+
+	// 긴 단어부터 비교해야 겹치는 단어 중 긴 쪽이 강조됨
+	sort.Slice(terms, func(i, j int) bool { return len([]rune(terms[i])) > len([]rune(terms[j])) })
+	return terms
+}
+
+// HighlightSnippet 처음 일치한 위치 주변 length 글자를 잘라 검색어를 <mark>로 감쌈
+// 내용은 HTML 이스케이프하므로 클라이언트에서 그대로 렌더링 가능
+func HighlightSnippet(content string, terms []string, length int) string {
+	runes := []rune(content)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	termRunes := make([][]rune, 0, len(terms))
+	for _, term := range terms {
+		if term != "" {
+			termRunes = append(termRunes, []rune(term))
+		}
+	}
+
+	matchAt := func(pos int) int {
+		for _, term := range termRunes {
+			if pos+len(term) <= len(lower) && string(lower[pos:pos+len(term)]) == string(term) {
+				return len(term)
+			}
+		}
+		return 0
+	}
+
+	// 처음 일치한 위치 앞쪽 1/3부터 자름
+	start := 0
+	for pos := range lower {
+		if matchAt(pos) > 0 {
+			start = pos - length/3
+			break
+		}
+	}
+	if start < 0 {
+		start = 0
+	}
+	end := start + length
+	if end > len(runes) {
+		end = len(runes)
+	}
+
+	var builder strings.Builder
+	if start > 0 {
+		builder.WriteString("…")
+	}
+	for pos := start; pos < end; {
+		if size := matchAt(pos); size > 0 {
+			matchEnd := pos + size
+			if matchEnd > end {
+				matchEnd = end
+			}
+			builder.WriteString("<mark>")
+			builder.WriteString(html.EscapeString(string(runes[pos:matchEnd])))
+			builder.WriteString("</mark>")
+			pos = matchEnd
+			continue
+		}
+		builder.WriteString(html.EscapeString(string(runes[pos])))
+		pos++
+	}
+	if end < len(runes) {
+		builder.WriteString("…")
+	}
+
+	return builder.String()
+}
@@ -7,65 +7,202 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 const accessTokenExp = time.Hour * 24
 const refreshTokenExp = time.Hour * 24 * 5
 
-var accessTokenSecret = []byte(os.Getenv("ACCESS_TOKEN_SECRET"))
-var refreshTokenSecret = []byte(os.Getenv("REFRESH_TOKEN_SECRET"))
+// 토큰 종류 - 비대칭 키는 access/refresh가 같은 키로 서명되므로 typ 클레임으로 구분
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+)
+
+// HS256 시크릿은 JWT_KEY_DIR이 없을 때 서명, 그리고 전환 기간 동안 기존 토큰 검증에 사용
+// (.env는 패키지 초기화 이후에 로드되므로 사용 시점에 읽음)
+func accessTokenSecret() []byte {
+	return []byte(os.Getenv("ACCESS_TOKEN_SECRET"))
+}
 
-// Claims 구조체 - 사용자 정보를 토큰에 담음
+func refreshTokenSecret() []byte {
+	return []byte(os.Getenv("REFRESH_TOKEN_SECRET"))
+}
+
+// Claims 구조체 - 사용자 정보를 토큰에 담음 (RegisteredClaims.ID = jti)
 type Claims struct {
 	Name   string `json:"name"`
 	Email  string `json:"email"`
 	UserId uint   `json:"userId"`
+	// SessionId 로그인 세션 ID - 세션이 폐기되면 해당 세션으로 발급된 토큰은 모두 거부됨
+	SessionId string `json:"sid,omitempty"`
+	TokenType string `json:"typ,omitempty"`
 	jwt.RegisteredClaims
 }
 
-func GenerateAccessToken(name string, email string, userId uint) (string, error) {
-	return generateToken(name, email, userId, accessTokenExp, accessTokenSecret)
+func GenerateAccessToken(name string, email string, userId uint, sessionId string) (string, error) {
+	token, _, err := generateToken(name, email, userId, sessionId, TokenTypeAccess, accessTokenExp, accessTokenSecret())
+	return token, err
 }
 
-func GenerateRefreshToken(name string, email string, userId uint) (string, error) {
-	return generateToken(name, email, userId, refreshTokenExp, refreshTokenSecret)
+// GenerateRefreshToken 리프레시 토큰과 jti 반환 (jti는 토큰 회전 시 재사용 검증에 사용)
+func GenerateRefreshToken(name string, email string, userId uint, sessionId string) (string, string, error) {
+	return generateToken(name, email, userId, sessionId, TokenTypeRefresh, refreshTokenExp, refreshTokenSecret())
 }
 
-func generateToken(name string, email string, userId uint, expiration time.Duration, secret []byte) (string, error) {
+func generateToken(name string, email string, userId uint, sessionId string, tokenType string, expiration time.Duration, secret []byte) (string, string, error) {
 	expirationTime := time.Now().Add(expiration) // 토큰 생성 시 유효 기간을 계산
+	tokenId := uuid.NewString()
 
 	claims := &Claims{
-		Name:   name,
-		Email:  email,
-		UserId: userId,
+		Name:      name,
+		Email:     email,
+		UserId:    userId,
+		SessionId: sessionId,
+		TokenType: tokenType,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenId,
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			ExpiresAt: jwt.NewNumericDate(expirationTime), // JWT 표준 형식으로 변환
 		},
 	}
 
+	//TODO 키 디렉토리가 있으면 비대칭 키(RS256/EdDSA)로 서명하고 kid 헤더 추가
+	if ks := getKeySet(); ks != nil {
+		key, err := ks.signingKey()
+		if err != nil {
+			return "", "", err
+		}
+
+		token := jwt.NewWithClaims(key.method, claims)
+		token.Header["kid"] = key.kid
+		signed, err := token.SignedString(key.privateKey)
+		if err != nil {
+			return "", "", err
+		}
+		return signed, tokenId, nil
+	}
+
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(secret)
+	signed, err := token.SignedString(secret)
+	if err != nil {
+		return "", "", err
+	}
+	return signed, tokenId, nil
 }
 
 // TODO 토큰 검증
 func ValidateAccessToken(tokenString string) (*Claims, error) {
-	return validateToken(tokenString, accessTokenSecret)
+	return validateToken(tokenString, TokenTypeAccess, accessTokenSecret())
 }
 
 func ValidateRefreshToken(tokenString string) (*Claims, error) {
-	return validateToken(tokenString, refreshTokenSecret)
+	return validateToken(tokenString, TokenTypeRefresh, refreshTokenSecret())
 }
 
-func validateToken(tokenString string, secret []byte) (*Claims, error) {
+// kid가 있으면 키 디렉토리의 공개키로, 없으면 HS256 시크릿으로 검증
+// (시크릿을 비워두면 HS256 토큰은 더 이상 받지 않음)
+func validateToken(tokenString string, tokenType string, secret []byte) (*Claims, error) {
 	claims := &Claims{}
 	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		return secret, nil
-	})
+		if token.Method.Alg() == jwt.SigningMethodHS256.Alg() {
+			if len(secret) == 0 {
+				return nil, fmt.Errorf("HS256 토큰은 허용되지 않습니다")
+			}
+			return secret, nil
+		}
+
+		ks := getKeySet()
+		if ks == nil {
+			return nil, fmt.Errorf("JWT 키 디렉토리가 설정되지 않았습니다")
+		}
+		kid, _ := token.Header["kid"].(string)
+		key, ok := ks.verificationKey(kid)
+		if !ok {
+			return nil, fmt.Errorf("알 수 없는 kid: %s", kid)
+		}
+		if key.method.Alg() != token.Method.Alg() {
+			return nil, fmt.Errorf("kid와 alg가 일치하지 않습니다")
+		}
+		return key.publicKey, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg(), jwt.SigningMethodHS256.Alg()}))
 
 	if err != nil || !token.Valid {
 		log.Printf("유효하지 않은 토큰:  %v", err)
 		return nil, fmt.Errorf("유효하지 않은 토큰입니다")
 	}
 
+	// 비대칭 키 토큰은 access/refresh를 typ로만 구분할 수 있음
+	if claims.TokenType != tokenType && (claims.TokenType != "" || token.Method.Alg() != jwt.SigningMethodHS256.Alg()) {
+		log.Printf("토큰 종류 불일치: %s", claims.TokenType)
+		return nil, fmt.Errorf("유효하지 않은 토큰입니다")
+	}
+
+	return claims, nil
+}
+
+// EmailTokenClaims 이메일 인증/비밀번호 재설정 링크용 토큰 (purpose로 용도 구분)
+type EmailTokenClaims struct {
+	UserId  uint   `json:"userId"`
+	Email   string `json:"email"`
+	Purpose string `json:"purpose"`
+	jwt.RegisteredClaims
+}
+
+// 이메일 토큰은 로그인 토큰과 다른 시크릿으로 서명 (로그인 토큰으로 재사용 불가)
+// 시크릿이 없으면 빈 키로 서명되어 누구나 토큰을 위조할 수 있으므로 발급/검증 모두 거부
+func emailTokenSecret() ([]byte, error) {
+	secret := os.Getenv("EMAIL_TOKEN_SECRET")
+	if secret == "" {
+		log.Printf("EMAIL_TOKEN_SECRET이 설정되지 않았습니다")
+		return nil, fmt.Errorf("이메일 토큰 시크릿이 설정되지 않았습니다")
+	}
+	return []byte(secret), nil
+}
+
+// GenerateEmailToken 이메일 토큰과 jti 반환 (jti는 redis에 저장해 1회용으로 사용)
+func GenerateEmailToken(userId uint, email string, purpose string, expiration time.Duration) (string, string, error) {
+	secret, err := emailTokenSecret()
+	if err != nil {
+		return "", "", err
+	}
+
+	tokenId := uuid.NewString()
+
+	claims := &EmailTokenClaims{
+		UserId:  userId,
+		Email:   email,
+		Purpose: purpose,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenId,
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiration)),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString(secret)
+	if err != nil {
+		return "", "", err
+	}
+	return signed, tokenId, nil
+}
+
+func ValidateEmailToken(tokenString string, purpose string) (*EmailTokenClaims, error) {
+	secret, err := emailTokenSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	claims := &EmailTokenClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+
+	if err != nil || !token.Valid || claims.Purpose != purpose {
+		log.Printf("유효하지 않은 이메일 토큰:  %v", err)
+		return nil, fmt.Errorf("유효하지 않은 토큰입니다")
+	}
+
 	return claims, nil
 }
@@ -0,0 +1,242 @@
+package util
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWT 서명 키 디렉토리 (JWT_KEY_DIR)
+//
+//	<kid>.pem      개인키 (RSA PKCS1/PKCS8, Ed25519 PKCS8) - 서명 + 검증
+//	<kid>.pub.pem  공개키 - 검증만 (교체된 이전 키를 남겨두는 용도)
+//
+// 서명 키는 JWT_ACTIVE_KID, 없으면 개인키 중 kid 사전순 마지막 (날짜로 kid를 지으면 최신 키)
+// 디렉토리를 지정하지 않으면 기존 HS256 시크릿으로 동작
+const defaultKeyReloadInterval = time.Minute * 5
+
+type jwtKey struct {
+	kid        string
+	method     jwt.SigningMethod
+	privateKey crypto.Signer // 공개키만 있는 경우 nil
+	publicKey  crypto.PublicKey
+}
+
+type jwtKeySet struct {
+	mu        sync.RWMutex
+	keys      map[string]*jwtKey
+	activeKid string
+	loadedAt  time.Time
+}
+
+var keySet = &jwtKeySet{}
+
+// 키 교체 시 재시작 없이 반영되도록 주기적으로 디렉토리를 다시 읽음
+func getKeySet() *jwtKeySet {
+	dir := os.Getenv("JWT_KEY_DIR")
+	if dir == "" {
+		return nil
+	}
+
+	interval := defaultKeyReloadInterval
+	if value := os.Getenv("JWT_KEY_RELOAD_INTERVAL"); value != "" {
+		if parsed, err := time.ParseDuration(value); err == nil && parsed > 0 {
+			interval = parsed
+		}
+	}
+
+	keySet.mu.RLock()
+	fresh := keySet.keys != nil && time.Since(keySet.loadedAt) < interval
+	keySet.mu.RUnlock()
+	if fresh {
+		return keySet
+	}
+
+	keys, activeKid, err := loadJwtKeys(dir, os.Getenv("JWT_ACTIVE_KID"))
+
+	keySet.mu.Lock()
+	defer keySet.mu.Unlock()
+	if err != nil {
+		// 다시 읽기에 실패하면 기존 키 유지
+		log.Printf("JWT 키 로드 실패: %v", err)
+		keySet.loadedAt = time.Now()
+		if keySet.keys == nil {
+			keySet.keys = map[string]*jwtKey{}
+		}
+		return keySet
+	}
+	keySet.keys = keys
+	keySet.activeKid = activeKid
+	keySet.loadedAt = time.Now()
+	return keySet
+}
+
+func (s *jwtKeySet) signingKey() (*jwtKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, ok := s.keys[s.activeKid]
+	if !ok || key.privateKey == nil {
+		return nil, fmt.Errorf("서명에 사용할 JWT 키가 없습니다")
+	}
+	return key, nil
+}
+
+func (s *jwtKeySet) verificationKey(kid string) (*jwtKey, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, ok := s.keys[kid]
+	return key, ok
+}
+
+func loadJwtKeys(dir string, activeKid string) (map[string]*jwtKey, string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, "", fmt.Errorf("JWT 키 디렉토리 읽기 실패: %w", err)
+	}
+
+	keys := make(map[string]*jwtKey)
+	privateKids := make([]string, 0)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".pem") {
+			continue
+		}
+
+		kid := strings.TrimSuffix(strings.TrimSuffix(name, ".pem"), ".pub")
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, "", fmt.Errorf("JWT 키 파일 읽기 실패 (%s): %w", name, err)
+		}
+
+		key, err := parseJwtKey(kid, data)
+		if err != nil {
+			return nil, "", fmt.Errorf("JWT 키 파싱 실패 (%s): %w", name, err)
+		}
+
+		// 같은 kid로 개인키와 공개키가 모두 있으면 개인키 우선
+		if existing, ok := keys[kid]; ok && existing.privateKey != nil {
+			continue
+		}
+		keys[kid] = key
+		if key.privateKey != nil {
+			privateKids = append(privateKids, kid)
+		}
+	}
+
+	if activeKid == "" && len(privateKids) > 0 {
+		sort.Strings(privateKids)
+		activeKid = privateKids[len(privateKids)-1]
+	}
+	if key, ok := keys[activeKid]; !ok || key.privateKey == nil {
+		return nil, "", fmt.Errorf("서명 키(%s)의 개인키가 없습니다", activeKid)
+	}
+
+	return keys, activeKid, nil
+}
+
+func parseJwtKey(kid string, data []byte) (*jwtKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("PEM 형식이 아닙니다")
+	}
+
+	var parsed interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("지원하지 않는 PEM 타입: %s", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch key := parsed.(type) {
+	case *rsa.PrivateKey:
+		return &jwtKey{kid: kid, method: jwt.SigningMethodRS256, privateKey: key, publicKey: &key.PublicKey}, nil
+	case *rsa.PublicKey:
+		return &jwtKey{kid: kid, method: jwt.SigningMethodRS256, publicKey: key}, nil
+	case ed25519.PrivateKey:
+		return &jwtKey{kid: kid, method: jwt.SigningMethodEdDSA, privateKey: key, publicKey: key.Public()}, nil
+	case ed25519.PublicKey:
+		return &jwtKey{kid: kid, method: jwt.SigningMethodEdDSA, publicKey: key}, nil
+	default:
+		return nil, fmt.Errorf("지원하지 않는 키 타입: %T", parsed)
+	}
+}
+
+// JWK 공개키 (RFC 7517)
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`   // RSA
+	E   string `json:"e,omitempty"`   // RSA
+	Crv string `json:"crv,omitempty"` // OKP
+	X   string `json:"x,omitempty"`   // OKP
+}
+
+// PublicJWKs 검증에 쓰이는 모든 공개키 (서명 키 + 교체된 이전 키)
+func PublicJWKs() []JWK {
+	ks := getKeySet()
+	if ks == nil {
+		return []JWK{}
+	}
+
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	kids := make([]string, 0, len(ks.keys))
+	for kid := range ks.keys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+
+	jwks := make([]JWK, 0, len(kids))
+	for _, kid := range kids {
+		key := ks.keys[kid]
+		switch publicKey := key.publicKey.(type) {
+		case *rsa.PublicKey:
+			jwks = append(jwks, JWK{
+				Kty: "RSA",
+				Kid: kid,
+				Use: "sig",
+				Alg: key.method.Alg(),
+				N:   base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+			})
+		case ed25519.PublicKey:
+			jwks = append(jwks, JWK{
+				Kty: "OKP",
+				Kid: kid,
+				Use: "sig",
+				Alg: key.method.Alg(),
+				Crv: "Ed25519",
+				X:   base64.RawURLEncoding.EncodeToString(publicKey),
+			})
+		}
+	}
+	return jwks
+}
@@ -0,0 +1,268 @@
+package util
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"unicode/utf16"
+)
+
+// TextOperation ot.js 호환 텍스트 편집 연산 (협업 편집용 OT)
+// JSON은 ot.js와 같은 배열 - 양수: retain, 음수: delete, 문자열: insert
+// 길이와 위치는 UTF-16 코드 단위 (브라우저 문자열 인덱스와 동일)
+type TextOperation struct {
+	ops          []textComponent
+	BaseLength   int
+	TargetLength int
+}
+
+type textComponent struct {
+	retain int
+	delete int
+	insert []uint16
+}
+
+func (c textComponent) isRetain() bool { return c.retain > 0 }
+func (c textComponent) isDelete() bool { return c.delete > 0 }
+func (c textComponent) isInsert() bool { return len(c.insert) > 0 }
+
+func (o *TextOperation) Retain(n int) *TextOperation {
+	if n <= 0 {
+		return o
+	}
+	o.BaseLength += n
+	o.TargetLength += n
+	if last := len(o.ops) - 1; last >= 0 && o.ops[last].isRetain() {
+		o.ops[last].retain += n
+	} else {
+		o.ops = append(o.ops, textComponent{retain: n})
+	}
+	return o
+}
+
+func (o *TextOperation) Insert(s string) *TextOperation {
+	return o.insertUnits(utf16.Encode([]rune(s)))
+}
+
+func (o *TextOperation) insertUnits(units []uint16) *TextOperation {
+	if len(units) == 0 {
+		return o
+	}
+	o.TargetLength += len(units)
+	last := len(o.ops) - 1
+	switch {
+	case last >= 0 && o.ops[last].isInsert():
+		o.ops[last].insert = append(append([]uint16{}, o.ops[last].insert...), units...)
+	case last >= 0 && o.ops[last].isDelete():
+		// 같은 위치의 삭제/삽입은 항상 삽입을 먼저 둠 (ot.js와 동일한 정규형)
+		if last > 0 && o.ops[last-1].isInsert() {
+			o.ops[last-1].insert = append(append([]uint16{}, o.ops[last-1].insert...), units...)
+		} else {
+			o.ops = append(o.ops, o.ops[last])
+			o.ops[last] = textComponent{insert: units}
+		}
+	default:
+		o.ops = append(o.ops, textComponent{insert: units})
+	}
+	return o
+}
+
+func (o *TextOperation) Delete(n int) *TextOperation {
+	if n <= 0 {
+		return o
+	}
+	o.BaseLength += n
+	if last := len(o.ops) - 1; last >= 0 && o.ops[last].isDelete() {
+		o.ops[last].delete += n
+	} else {
+		o.ops = append(o.ops, textComponent{delete: n})
+	}
+	return o
+}
+
+// IsNoop 문서를 바꾸지 않는 연산
+func (o *TextOperation) IsNoop() bool {
+	return len(o.ops) == 0 || (len(o.ops) == 1 && o.ops[0].isRetain())
+}
+
+// Apply 문서에 연산 적용 - 연산의 BaseLength와 문서 길이가 같아야 함
+func (o *TextOperation) Apply(doc string) (string, error) {
+	units := utf16.Encode([]rune(doc))
+	if len(units) != o.BaseLength {
+		return "", fmt.Errorf("연산 기준 길이(%d)와 문서 길이(%d)가 다릅니다", o.BaseLength, len(units))
+	}
+
+	result := make([]uint16, 0, o.TargetLength)
+	index := 0
+	for _, c := range o.ops {
+		switch {
+		case c.isRetain():
+			result = append(result, units[index:index+c.retain]...)
+			index += c.retain
+		case c.isInsert():
+			result = append(result, c.insert...)
+		case c.isDelete():
+			index += c.delete
+		}
+	}
+
+	return string(utf16.Decode(result)), nil
+}
+
+// TransformTextOperations 같은 문서에 동시에 만들어진 두 연산을 서로 기준으로 변환
+// a를 먼저 적용한 뒤 b'를, b를 먼저 적용한 뒤 a'를 적용하면 같은 결과가 됨 (같은 위치 삽입은 a가 앞)
+func TransformTextOperations(a, b *TextOperation) (*TextOperation, *TextOperation, error) {
+	if a.BaseLength != b.BaseLength {
+		return nil, nil, errors.New("두 연산의 기준 길이가 다릅니다")
+	}
+
+	aPrime, bPrime := &TextOperation{}, &TextOperation{}
+	opsA, opsB := a.ops, b.ops
+	i, j := 0, 0
+	var opA, opB *textComponent
+	next := func(ops []textComponent, index *int) *textComponent {
+		if *index >= len(ops) {
+			return nil
+		}
+		c := ops[*index]
+		*index++
+		return &c
+	}
+	opA, opB = next(opsA, &i), next(opsB, &j)
+
+	for opA != nil || opB != nil {
+		if opA != nil && opA.isInsert() {
+			aPrime.insertUnits(opA.insert)
+			bPrime.Retain(len(opA.insert))
+			opA = next(opsA, &i)
+			continue
+		}
+		if opB != nil && opB.isInsert() {
+			aPrime.Retain(len(opB.insert))
+			bPrime.insertUnits(opB.insert)
+			opB = next(opsB, &j)
+			continue
+		}
+		if opA == nil {
+			return nil, nil, errors.New("첫 번째 연산이 너무 짧습니다")
+		}
+		if opB == nil {
+			return nil, nil, errors.New("첫 번째 연산이 너무 깁니다")
+		}
+
+		lengthA, lengthB := opA.retain+opA.delete, opB.retain+opB.delete
+		minLength := lengthA
+		if lengthB < minLength {
+			minLength = lengthB
+		}
+
+		switch {
+		case opA.isRetain() && opB.isRetain():
+			aPrime.Retain(minLength)
+			bPrime.Retain(minLength)
+		case opA.isDelete() && opB.isRetain():
+			aPrime.Delete(minLength)
+		case opA.isRetain() && opB.isDelete():
+			bPrime.Delete(minLength)
+		}
+		// 둘 다 삭제면 이미 지워진 부분이므로 양쪽 모두 생략
+
+		if lengthA == minLength {
+			opA = next(opsA, &i)
+		} else if opA.isRetain() {
+			opA.retain -= minLength
+		} else {
+			opA.delete -= minLength
+		}
+		if lengthB == minLength {
+			opB = next(opsB, &j)
+		} else if opB.isRetain() {
+			opB.retain -= minLength
+		} else {
+			opB.delete -= minLength
+		}
+	}
+
+	return aPrime, bPrime, nil
+}
+
+// TransformIndex 연산 적용 전 위치(커서)를 적용 후 위치로 변환
+func (o *TextOperation) TransformIndex(index int) int {
+	newIndex := index
+	for _, c := range o.ops {
+		switch {
+		case c.isRetain():
+			index -= c.retain
+		case c.isInsert():
+			newIndex += len(c.insert)
+		case c.isDelete():
+			if index < c.delete {
+				newIndex -= index
+			} else {
+				newIndex -= c.delete
+			}
+			index -= c.delete
+		}
+		if index < 0 {
+			break
+		}
+	}
+	return newIndex
+}
+
+// ReplaceTextOperation 문서 전체를 새 내용으로 바꾸는 연산 (자동 저장 등으로 내용이 통째로 바뀐 경우)
+func ReplaceTextOperation(from string, to string) *TextOperation {
+	operation := &TextOperation{}
+	return operation.Delete(len(utf16.Encode([]rune(from)))).Insert(to)
+}
+
+// Utf16Length 문서 길이 (UTF-16 코드 단위)
+func Utf16Length(s string) int {
+	return len(utf16.Encode([]rune(s)))
+}
+
+func (o TextOperation) MarshalJSON() ([]byte, error) {
+	values := make([]interface{}, len(o.ops))
+	for i, c := range o.ops {
+		switch {
+		case c.isRetain():
+			values[i] = c.retain
+		case c.isDelete():
+			values[i] = -c.delete
+		default:
+			values[i] = string(utf16.Decode(c.insert))
+		}
+	}
+	return json.Marshal(values)
+}
+
+func (o *TextOperation) UnmarshalJSON(data []byte) error {
+	var values []interface{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	*o = TextOperation{}
+	for _, value := range values {
+		switch v := value.(type) {
+		case float64:
+			n := int(v)
+			if float64(n) != v || n == 0 {
+				return fmt.Errorf("잘못된 연산 값: %v", v)
+			}
+			if n > 0 {
+				o.Retain(n)
+			} else {
+				o.Delete(-n)
+			}
+		case string:
+			if v == "" {
+				return errors.New("빈 문자열은 삽입할 수 없습니다")
+			}
+			o.Insert(v)
+		default:
+			return fmt.Errorf("잘못된 연산 값: %v", v)
+		}
+	}
+	return nil
+}
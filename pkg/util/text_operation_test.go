@@ -0,0 +1,160 @@
+package util
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func mustTextOperation(t *testing.T, raw string) *TextOperation {
+	t.Helper()
+	operation := &TextOperation{}
+	if err := json.Unmarshal([]byte(raw), operation); err != nil {
+		t.Fatalf("연산 파싱 실패 %s: %v", raw, err)
+	}
+	return operation
+}
+
+func TestTextOperationApply(t *testing.T) {
+	tests := []struct {
+		name      string
+		doc       string
+		operation string
+		want      string
+	}{
+		{"끝에 삽입", "hello", `[5," world"]`, "hello world"},
+		{"가운데 삭제", "hello world", `[5,-6]`, "hello"},
+		{"바꾸기", "회의는 내일", `[4,-2,"오늘"]`, "회의는 오늘"},
+		{"서로게이트 문자는 2칸", "a😀b", `[3,"!",1]`, "a😀!b"},
+		{"빈 연산", "", `[]`, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mustTextOperation(t, tt.operation).Apply(tt.doc)
+			if err != nil {
+				t.Fatalf("Apply 오류: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Apply() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTextOperationApplyBaseLengthMismatch(t *testing.T) {
+	if _, err := mustTextOperation(t, `[3,"x"]`).Apply("hello"); err == nil {
+		t.Fatal("문서 길이와 기준 길이가 다르면 오류여야 합니다")
+	}
+}
+
+func TestTransformTextOperationsConverge(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		a    string
+		b    string
+		want string
+	}{
+		{"같은 위치 삽입은 a가 앞", "ab", `[1,"X",1]`, `[1,"Y",1]`, "aXYb"},
+		{"다른 위치 삽입", "abc", `["<",3]`, `[3,">"]`, "<abc>"},
+		{"삭제와 삽입", "abcdef", `[1,-3,2]`, `[2,"X",4]`, "aXef"},
+		{"겹치는 삭제", "abcdef", `[1,-3,2]`, `[2,-3,1]`, "af"},
+		{"같은 범위 삭제", "abc", `[-3]`, `[-3]`, ""},
+		{"한쪽만 편집", "회의록", `[3,"입니다"]`, `[3]`, "회의록입니다"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := mustTextOperation(t, tt.a), mustTextOperation(t, tt.b)
+			aPrime, bPrime, err := TransformTextOperations(a, b)
+			if err != nil {
+				t.Fatalf("Transform 오류: %v", err)
+			}
+
+			afterA, err := a.Apply(tt.doc)
+			if err != nil {
+				t.Fatalf("a 적용 오류: %v", err)
+			}
+			left, err := bPrime.Apply(afterA)
+			if err != nil {
+				t.Fatalf("b' 적용 오류: %v", err)
+			}
+
+			afterB, err := b.Apply(tt.doc)
+			if err != nil {
+				t.Fatalf("b 적용 오류: %v", err)
+			}
+			right, err := aPrime.Apply(afterB)
+			if err != nil {
+				t.Fatalf("a' 적용 오류: %v", err)
+			}
+
+			if left != right {
+				t.Fatalf("적용 순서에 따라 결과가 다릅니다: %q != %q", left, right)
+			}
+			if left != tt.want {
+				t.Errorf("결과 = %q, want %q", left, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransformTextOperationsBaseLengthMismatch(t *testing.T) {
+	if _, _, err := TransformTextOperations(mustTextOperation(t, `[3]`), mustTextOperation(t, `[4]`)); err == nil {
+		t.Fatal("기준 길이가 다르면 오류여야 합니다")
+	}
+}
+
+func TestTextOperationTransformIndex(t *testing.T) {
+	operation := mustTextOperation(t, `[2,"XY",-2,2]`) // ab|cd|ef -> abXYef
+	tests := []struct {
+		index int
+		want  int
+	}{
+		{0, 0},
+		{2, 4}, // 삽입 위치의 커서는 삽입 뒤로
+		{3, 4}, // 삭제된 범위 안은 삭제 시작점으로
+		{5, 5},
+		{6, 6},
+	}
+	for _, tt := range tests {
+		if got := operation.TransformIndex(tt.index); got != tt.want {
+			t.Errorf("TransformIndex(%d) = %d, want %d", tt.index, got, tt.want)
+		}
+	}
+}
+
+func TestTextOperationJSON(t *testing.T) {
+	operation := &TextOperation{}
+	operation.Retain(2).Delete(1).Insert("한글").Retain(3)
+
+	data, err := json.Marshal(operation)
+	if err != nil {
+		t.Fatalf("Marshal 오류: %v", err)
+	}
+	// 같은 위치의 삭제/삽입은 삽입이 먼저인 정규형
+	if string(data) != `[2,"한글",-1,3]` {
+		t.Errorf("Marshal() = %s", data)
+	}
+
+	decoded := mustTextOperation(t, string(data))
+	if decoded.BaseLength != 6 || decoded.TargetLength != 7 {
+		t.Errorf("길이 = (%d, %d), want (6, 7)", decoded.BaseLength, decoded.TargetLength)
+	}
+
+	for _, raw := range []string{`[0]`, `[""]`, `[1.5]`, `[true]`, `{}`} {
+		if err := json.Unmarshal([]byte(raw), &TextOperation{}); err == nil {
+			t.Errorf("%s 는 잘못된 연산이어야 합니다", raw)
+		}
+	}
+}
+
+func TestReplaceTextOperation(t *testing.T) {
+	got, err := ReplaceTextOperation("이전 내용", "새 내용").Apply("이전 내용")
+	if err != nil {
+		t.Fatalf("Apply 오류: %v", err)
+	}
+	if got != "새 내용" {
+		t.Errorf("Apply() = %q", got)
+	}
+}
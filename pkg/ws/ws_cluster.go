@@ -0,0 +1,337 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+)
+
+// 클러스터 모드 (WS_CLUSTER_MODE=true)
+// 여러 파드에 나뉘어 연결된 소켓에 메시지를 전달하기 위해 대상별 NATS subject로 발행하고
+// 모든 노드가 구독해서 자기 노드에 연결된 소켓에만 전달
+//
+//	link.ws.room.<roomId>                 채팅방
+//	link.ws.user.<userId>                 사용자
+//	link.ws.company.<companyId>           회사
+//	link.ws.board.<boardId>               보드
+//	link.ws.board.<boardId>.user.<userId> 보드의 특정 사용자
+//	link.ws.broadcast                     전체 사용자
+//	link.ws.presence                      온라인 상태 변경 (각 노드의 OnlineClients 갱신)
+//
+// 온라인 상태는 redis에 노드별 사용자 SET으로 저장하고 heartbeat가 끊긴 노드는 다른 노드가 정리
+const (
+	clusterSubjectPrefix = "link.ws."
+	presenceSubject      = clusterSubjectPrefix + "presence"
+	broadcastSubject     = clusterSubjectPrefix + "broadcast"
+
+	presenceNodesKey          = "ws:presence:nodes" // ZSET nodeId -> 마지막 heartbeat(unix)
+	presenceHeartbeatInterval = 10 * time.Second
+	presenceNodeTimeout       = 30 * time.Second // heartbeat가 이 시간 동안 없으면 죽은 노드로 판단
+	presenceNodeKeyTTL        = 90 * time.Second
+)
+
+func presenceNodeKey(nodeID string) string {
+	return fmt.Sprintf("ws:presence:node:%s", nodeID)
+}
+
+type wsCluster struct {
+	nodeID      string
+	natsConn    *nats.Conn
+	redisClient *redis.Client
+}
+
+// 토픽 대상 메시지 - 발행한 노드에서 발급한 seq를 함께 전달
+type sequencedMessage struct {
+	Seq     uint64      `json:"seq"`
+	Message interface{} `json:"message"`
+}
+
+type presenceEvent struct {
+	UserID uint   `json:"user_id"`
+	Online bool   `json:"online"`
+	NodeID string `json:"node_id"`
+}
+
+// 클러스터 모드가 아니거나 NATS/redis가 없으면 nil (단일 노드 동작)
+func newWsCluster(natsConn *nats.Conn, redisClient *redis.Client) *wsCluster {
+	if os.Getenv("WS_CLUSTER_MODE") != "true" {
+		return nil
+	}
+	if natsConn == nil || redisClient == nil {
+		log.Printf("WS_CLUSTER_MODE가 설정되었지만 NATS/redis 연결이 없어 단일 노드로 동작합니다")
+		return nil
+	}
+
+	nodeID := os.Getenv("WS_NODE_ID")
+	if nodeID == "" {
+		// k8s에서는 파드 이름, 재시작해도 이전 노드와 겹치지 않도록 suffix 추가
+		hostname, _ := os.Hostname()
+		nodeID = fmt.Sprintf("%s-%s", hostname, uuid.NewString()[:8])
+	}
+
+	return &wsCluster{nodeID: nodeID, natsConn: natsConn, redisClient: redisClient}
+}
+
+// 클러스터 구독 + presence heartbeat 시작
+func (hub *WebSocketHub) startCluster() {
+	c := hub.cluster
+
+	subscriptions := map[string]nats.MsgHandler{
+		clusterSubjectPrefix + "room.*": func(msg *nats.Msg) {
+			if roomID, ok := subjectID(msg.Subject, 3); ok {
+				if message, ok := decodeSequenced(msg.Data); ok {
+					hub.deliverToChatRoom(roomID, message.Seq, message.Message)
+				}
+			}
+		},
+		clusterSubjectPrefix + "user.*": func(msg *nats.Msg) {
+			if userID, ok := subjectID(msg.Subject, 3); ok {
+				if message, ok := decodeSequenced(msg.Data); ok {
+					hub.deliverToUser(userID, message.Seq, message.Message)
+				}
+			}
+		},
+		clusterSubjectPrefix + "company.*": func(msg *nats.Msg) {
+			if companyID, ok := subjectID(msg.Subject, 3); ok {
+				if message, ok := decodeSequenced(msg.Data); ok {
+					hub.deliverToCompany(companyID, message.Seq, message.Message)
+				}
+			}
+		},
+		clusterSubjectPrefix + "board.*": func(msg *nats.Msg) {
+			if boardID, ok := subjectID(msg.Subject, 3); ok {
+				if message, ok := decodeSequenced(msg.Data); ok {
+					hub.deliverToBoard(boardID, message.Seq, message.Message)
+				}
+			}
+		},
+		clusterSubjectPrefix + "board.*.user.*": func(msg *nats.Msg) {
+			boardID, ok := subjectID(msg.Subject, 3)
+			userID, ok2 := subjectID(msg.Subject, 5)
+			if ok && ok2 {
+				hub.deliverToBoardUser(boardID, userID, json.RawMessage(msg.Data))
+			}
+		},
+		broadcastSubject: func(msg *nats.Msg) {
+			hub.deliverToAllUsers(json.RawMessage(msg.Data))
+		},
+		presenceSubject: func(msg *nats.Msg) {
+			var event presenceEvent
+			if err := json.Unmarshal(msg.Data, &event); err != nil {
+				log.Printf("presence 이벤트 파싱 오류: %v", err)
+				return
+			}
+			hub.OnlineClients.Store(event.UserID, event.Online)
+			hub.deliverToAllUsers(onlineStatusMessage(event.UserID, event.Online))
+		},
+	}
+
+	for subject, handler := range subscriptions {
+		if _, err := c.natsConn.Subscribe(subject, handler); err != nil {
+			log.Fatalf("웹소켓 클러스터 구독 실패[%s]: %v", subject, err)
+		}
+	}
+
+	c.heartbeat()
+	hub.loadClusterOnlineUsers()
+
+	go func() {
+		ticker := time.NewTicker(presenceHeartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				c.heartbeat()
+				hub.reapDeadNodes()
+			case <-hub.stopCleanup:
+				c.leave()
+				return
+			}
+		}
+	}()
+
+	log.Printf("웹소켓 클러스터 모드 시작 (node: %s)", c.nodeID)
+}
+
+// link.ws.<kind>.<id>... 에서 index 위치의 id
+func subjectID(subject string, index int) (uint, bool) {
+	parts := strings.Split(subject, ".")
+	if len(parts) <= index {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(parts[index], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return uint(id), true
+}
+
+func decodeSequenced(data []byte) (sequencedMessage, bool) {
+	var message struct {
+		Seq     uint64          `json:"seq"`
+		Message json.RawMessage `json:"message"`
+	}
+	if err := json.Unmarshal(data, &message); err != nil {
+		log.Printf("웹소켓 클러스터 메시지 파싱 오류: %v", err)
+		return sequencedMessage{}, false
+	}
+	return sequencedMessage{Seq: message.Seq, Message: message.Message}, true
+}
+
+func (c *wsCluster) publish(subject string, message interface{}) {
+	data, err := json.Marshal(message)
+	if err != nil {
+		log.Printf("웹소켓 클러스터 메시지 직렬화 실패: %v", err)
+		return
+	}
+	if err := c.natsConn.Publish(subject, data); err != nil {
+		log.Printf("웹소켓 클러스터 메시지 발행 실패[%s]: %v", subject, err)
+	}
+}
+
+func (c *wsCluster) heartbeat() {
+	ctx := context.Background()
+	pipe := c.redisClient.TxPipeline()
+	pipe.ZAdd(ctx, presenceNodesKey, &redis.Z{Score: float64(time.Now().Unix()), Member: c.nodeID})
+	pipe.Expire(ctx, presenceNodeKey(c.nodeID), presenceNodeKeyTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Printf("웹소켓 presence heartbeat 실패: %v", err)
+	}
+}
+
+// 종료 시 자기 노드 정리 (사용자 오프라인 알림은 각 연결 해제에서 처리)
+func (c *wsCluster) leave() {
+	ctx := context.Background()
+	c.redisClient.ZRem(ctx, presenceNodesKey, c.nodeID)
+	c.redisClient.Del(ctx, presenceNodeKey(c.nodeID))
+}
+
+// heartbeat가 살아있는 노드 목록
+func (c *wsCluster) aliveNodes() ([]string, error) {
+	min := strconv.FormatInt(time.Now().Add(-presenceNodeTimeout).Unix(), 10)
+	return c.redisClient.ZRangeByScore(context.Background(), presenceNodesKey, &redis.ZRangeBy{Min: min, Max: "+inf"}).Result()
+}
+
+// exceptNode를 제외한 살아있는 노드 중 하나라도 사용자가 연결되어 있는지
+func (c *wsCluster) isOnlineElsewhere(userID uint, exceptNode string) bool {
+	nodes, err := c.aliveNodes()
+	if err != nil {
+		log.Printf("웹소켓 노드 목록 조회 실패: %v", err)
+		return false
+	}
+
+	ctx := context.Background()
+	for _, node := range nodes {
+		if node == exceptNode {
+			continue
+		}
+		if ok, _ := c.redisClient.SIsMember(ctx, presenceNodeKey(node), userID).Result(); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// 이 노드에 사용자의 첫 연결 - 다른 노드에도 연결이 없으면 true (온라인 알림 필요)
+func (c *wsCluster) addPresence(userID uint) bool {
+	ctx := context.Background()
+	pipe := c.redisClient.TxPipeline()
+	pipe.SAdd(ctx, presenceNodeKey(c.nodeID), userID)
+	pipe.Expire(ctx, presenceNodeKey(c.nodeID), presenceNodeKeyTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Printf("웹소켓 presence 등록 실패: %v", err)
+	}
+	return !c.isOnlineElsewhere(userID, c.nodeID)
+}
+
+// 이 노드에서 사용자의 마지막 연결 해제 - 다른 노드에도 연결이 없으면 true (오프라인 알림 필요)
+func (c *wsCluster) removePresence(userID uint) bool {
+	if err := c.redisClient.SRem(context.Background(), presenceNodeKey(c.nodeID), userID).Err(); err != nil {
+		log.Printf("웹소켓 presence 해제 실패: %v", err)
+	}
+	return !c.isOnlineElsewhere(userID, c.nodeID)
+}
+
+// 기동 시 다른 노드에 연결된 온라인 사용자 목록으로 OnlineClients 초기화
+func (hub *WebSocketHub) loadClusterOnlineUsers() {
+	c := hub.cluster
+	nodes, err := c.aliveNodes()
+	if err != nil {
+		log.Printf("웹소켓 노드 목록 조회 실패: %v", err)
+		return
+	}
+
+	ctx := context.Background()
+	for _, node := range nodes {
+		members, err := c.redisClient.SMembers(ctx, presenceNodeKey(node)).Result()
+		if err != nil {
+			continue
+		}
+		for _, member := range members {
+			if userID, err := strconv.ParseUint(member, 10, 64); err == nil {
+				hub.OnlineClients.Store(uint(userID), true)
+			}
+		}
+	}
+}
+
+// heartbeat가 끊긴 노드(비정상 종료된 파드)의 사용자들을 오프라인 처리
+// ZREM에 성공한 노드 하나만 정리하므로 알림이 중복되지 않음
+func (hub *WebSocketHub) reapDeadNodes() {
+	c := hub.cluster
+	ctx := context.Background()
+
+	max := strconv.FormatInt(time.Now().Add(-presenceNodeTimeout).Unix(), 10)
+	deadNodes, err := c.redisClient.ZRangeByScore(ctx, presenceNodesKey, &redis.ZRangeBy{Min: "-inf", Max: "(" + max}).Result()
+	if err != nil {
+		log.Printf("웹소켓 노드 목록 조회 실패: %v", err)
+		return
+	}
+
+	for _, node := range deadNodes {
+		if node == c.nodeID {
+			continue
+		}
+		removed, err := c.redisClient.ZRem(ctx, presenceNodesKey, node).Result()
+		if err != nil || removed == 0 {
+			continue
+		}
+
+		members, _ := c.redisClient.SMembers(ctx, presenceNodeKey(node)).Result()
+		c.redisClient.Del(ctx, presenceNodeKey(node))
+		log.Printf("응답 없는 웹소켓 노드 정리: %s (사용자 %d명)", node, len(members))
+
+		for _, member := range members {
+			userID, err := strconv.ParseUint(member, 10, 64)
+			if err != nil {
+				continue
+			}
+			if !c.isOnlineElsewhere(uint(userID), "") {
+				hub.BroadcastOnlineStatus(uint(userID), false)
+			}
+		}
+	}
+}
+
+// IsUserOnline 클러스터 모드에서는 모든 노드 기준
+func (hub *WebSocketHub) IsUserOnline(userID uint) bool {
+	if hub.cluster != nil {
+		return hub.cluster.isOnlineElsewhere(userID, "")
+	}
+	status, ok := hub.OnlineClients.Load(userID)
+	return ok && status.(bool)
+}
+
+// Clustered 클러스터 모드 여부 (NATS 이벤트를 queue group으로 한 노드만 처리해야 하는지)
+func (hub *WebSocketHub) Clustered() bool {
+	return hub.cluster != nil
+}
@@ -6,48 +6,61 @@ import (
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
 	"github.com/nats-io/nats.go"
 
+	_authUsecase "link/internal/auth/usecase"
+	_boardUsecase "link/internal/board/usecase"
 	_chatUsecase "link/internal/chat/usecase"
 	_companyUsecase "link/internal/company/usecase"
 	_notificationUsecase "link/internal/notification/usecase"
 	_userUsecase "link/internal/user/usecase"
+	"link/pkg/common"
 	"link/pkg/dto/req"
 	"link/pkg/dto/res"
 	"link/pkg/logger"
 	_nats "link/pkg/nats"
-	"link/pkg/util"
 )
 
 // WsHandler struct는 WebSocketHub와 연동합니다.
 type WsHandler struct {
 	hub                 *WebSocketHub
+	authUsecase         _authUsecase.AuthUsecase
 	chatUsecase         _chatUsecase.ChatUsecase
 	notificationUsecase _notificationUsecase.NotificationUsecase
 	userUsecase         _userUsecase.UserUsecase
 	companyUsecase      _companyUsecase.CompanyUsecase
+	boardUsecase        _boardUsecase.BoardUsecase
+	cardDocumentUsecase _boardUsecase.CardDocumentUsecase
 	natsPublisher       *_nats.NatsPublisher
 	natsSubscriber      *_nats.NatsSubscriber
 }
 
 // NewWsHandler는 WebSocketHub를 받아서 새로운 WsHandler를 반환합니다.
 func NewWsHandler(hub *WebSocketHub,
+	authUsecase _authUsecase.AuthUsecase,
 	chatUsecase _chatUsecase.ChatUsecase,
 	notificationUsecase _notificationUsecase.NotificationUsecase,
 	userUsecase _userUsecase.UserUsecase,
 	companyUsecase _companyUsecase.CompanyUsecase,
+	boardUsecase _boardUsecase.BoardUsecase,
+	cardDocumentUsecase _boardUsecase.CardDocumentUsecase,
 	natsPublisher *_nats.NatsPublisher,
 	natsSubscriber *_nats.NatsSubscriber) *WsHandler {
 	ws := &WsHandler{
 		hub:                 hub,
+		authUsecase:         authUsecase,
 		chatUsecase:         chatUsecase,
 		notificationUsecase: notificationUsecase,
 		userUsecase:         userUsecase,
 		companyUsecase:      companyUsecase,
+		boardUsecase:        boardUsecase,
+		cardDocumentUsecase: cardDocumentUsecase,
 		natsPublisher:       natsPublisher,
 		natsSubscriber:      natsSubscriber,
 	}
@@ -68,9 +81,18 @@ func (h *WsHandler) setUpNatsSubscriber() {
 	h.subscribeToBoard()
 }
 
+// 클러스터 모드에서는 이벤트를 한 노드만 받아 hub로 보내고, hub가 대상별 subject로 모든 노드에 전달
+// (모든 노드가 이벤트를 받으면 노드 수만큼 중복 전송됨)
+func (h *WsHandler) subscribeEvent(subject string, handler func(msg *nats.Msg)) error {
+	if h.hub.Clustered() {
+		return h.natsSubscriber.QueueSubscribeEvent(subject, "link.ws.events", handler)
+	}
+	return h.natsSubscriber.SubscribeEvent(subject, handler)
+}
+
 func (h *WsHandler) subscribeToChat() {
 	// 채팅 메시지 전송
-	h.natsSubscriber.SubscribeEvent("chat.message.sent", func(msg *nats.Msg) {
+	h.subscribeEvent("chat.message.sent", func(msg *nats.Msg) {
 		var message map[string]interface{}
 		if err := json.Unmarshal(msg.Data, &message); err != nil {
 			log.Printf("메시지 파싱 오류: %v", err)
@@ -84,21 +106,46 @@ func (h *WsHandler) subscribeToChat() {
 		})
 	})
 
-	// 채팅방 나가기
-	h.natsSubscriber.SubscribeEvent("chat.room.leave", func(msg *nats.Msg) {
+	// 채팅방 나가기 알림 (seq 발급을 위해 한 노드만 발송)
+	h.subscribeEvent("chat.room.leave", func(msg *nats.Msg) {
 		var message map[string]interface{}
 		if err := json.Unmarshal(msg.Data, &message); err != nil {
 			log.Printf("메시지 파싱 오류: %v", err)
 			return
 		}
-		// 채팅방 나가기 처리
 		h.handleChatRoomLeave(message)
 	})
+
+	// 나간 사용자의 소켓이 어느 노드에 있는지 모르므로 모든 노드가 받아서 각자 정리
+	h.natsSubscriber.SubscribeEvent("chat.room.leave", func(msg *nats.Msg) {
+		var message map[string]interface{}
+		if err := json.Unmarshal(msg.Data, &message); err != nil {
+			log.Printf("메시지 파싱 오류: %v", err)
+			return
+		}
+		roomId := uint(message["roomId"].(float64))
+		userId := uint(message["userId"].(float64))
+		h.hub.RemoveFromChatRoom(roomId, userId)
+		h.hub.UnsubscribeUser(chatTopic(roomId), userId)
+	})
+
+	// 내보내기 당한 사용자도 모든 노드에서 채팅방 구독 해제 (알림은 HTTP 핸들러에서 전송)
+	h.natsSubscriber.SubscribeEvent("chat.room.removed", func(msg *nats.Msg) {
+		var message map[string]interface{}
+		if err := json.Unmarshal(msg.Data, &message); err != nil {
+			log.Printf("메시지 파싱 오류: %v", err)
+			return
+		}
+		roomId := uint(message["roomId"].(float64))
+		userId := uint(message["userId"].(float64))
+		h.hub.RemoveFromChatRoom(roomId, userId)
+		h.hub.UnsubscribeUser(chatTopic(roomId), userId)
+	})
 }
 
 func (h *WsHandler) subscribeToLikes() {
 	// 게시글 좋아요
-	h.natsSubscriber.SubscribeEvent("like.post.created", func(msg *nats.Msg) {
+	h.subscribeEvent("like.post.created", func(msg *nats.Msg) {
 		var notification map[string]interface{}
 		if err := json.Unmarshal(msg.Data, &notification); err != nil {
 			log.Printf("알림 파싱 오류: %v", err)
@@ -117,7 +164,7 @@ func (h *WsHandler) subscribeToLikes() {
 
 func (h *WsHandler) subscribeToNotifications() {
 	// 일반 알림
-	h.natsSubscriber.SubscribeEvent("notification.created", func(msg *nats.Msg) {
+	h.subscribeEvent("notification.created", func(msg *nats.Msg) {
 		var notification map[string]interface{}
 		if err := json.Unmarshal(msg.Data, &notification); err != nil {
 			log.Printf("알림 파싱 오류: %v", err)
@@ -134,7 +181,7 @@ func (h *WsHandler) subscribeToNotifications() {
 }
 
 func (h *WsHandler) subscribeToBoard() {
-	h.natsSubscriber.SubscribeEvent("link.event.board.state.update", func(msg *nats.Msg) {
+	h.subscribeEvent("link.event.board.state.update", func(msg *nats.Msg) {
 		var event map[string]interface{}
 		if err := json.Unmarshal(msg.Data, &event); err != nil {
 			log.Printf("이벤트 파싱 오류: %v", err)
@@ -170,6 +217,23 @@ func (h *WsHandler) subscribeToBoard() {
 		// 해당 보드의 모든 클라이언트에게 이벤트 전달
 		h.hub.BroadcastToBoard(boardID, wsMessage)
 	})
+
+	// 자동 저장으로 바뀐 카드 내용을 협업 편집 중인 클라이언트에 연산으로 전달
+	h.subscribeEvent("link.event.board.card.doc", func(msg *nats.Msg) {
+		var event struct {
+			Payload res.CardOperationPayload `json:"payload"`
+		}
+		if err := json.Unmarshal(msg.Data, &event); err != nil {
+			log.Printf("이벤트 파싱 오류: %v", err)
+			return
+		}
+
+		h.hub.BroadcastToBoard(event.Payload.BoardID, res.JsonResponse{
+			Success: true,
+			Type:    CardDocOp,
+			Payload: event.Payload,
+		})
+	})
 }
 
 func (h *WsHandler) handleChatRoomLeave(message map[string]interface{}) {
@@ -188,7 +252,57 @@ func (h *WsHandler) handleChatRoomLeave(message map[string]interface{}) {
 		},
 		Type: "chat",
 	})
-	h.hub.RemoveFromChatRoom(roomId, userId)
+}
+
+// TODO 입력중/읽음/수신 확인 처리 (/ws/chat, /ws/v2 공통)
+// 입력중과 수신 확인은 일시적 이벤트라 재전송 버퍼에 남기지 않고, 읽음은 안읽음 수 갱신에 필요하므로 seq를 발급
+func (h *WsHandler) handleChatSignal(userId uint, userName string, roomId uint, signalType string, signal req.ChatSignalRequest) error {
+	payload := &res.ChatSignalPayload{
+		ChatRoomID: roomId,
+		UserID:     userId,
+		UserName:   userName,
+	}
+
+	switch signalType {
+	case FrameTyping:
+		if err := h.chatUsecase.CheckChatRoomMember(userId, roomId); err != nil {
+			return err
+		}
+		typing := signal.Typing == nil || *signal.Typing
+		payload.Typing = &typing
+		h.hub.SignalChatRoom(roomId, res.JsonResponse{Success: true, Type: FrameTyping, Payload: payload})
+
+	case FrameDelivered:
+		if signal.MessageID == "" {
+			return common.NewError(http.StatusBadRequest, "message_id가 필요합니다", nil)
+		}
+		if err := h.chatUsecase.CheckChatRoomMember(userId, roomId); err != nil {
+			return err
+		}
+		payload.MessageID = signal.MessageID
+		h.hub.SignalChatRoom(roomId, res.JsonResponse{Success: true, Type: FrameDelivered, Payload: payload})
+
+	case FrameRead:
+		readCount, err := h.chatUsecase.MarkMessagesAsRead(userId, roomId, signal.MessageID)
+		if err != nil {
+			return err
+		}
+		if readCount == 0 {
+			return nil
+		}
+		payload.MessageID = signal.MessageID
+		payload.ReadCount = readCount
+		h.hub.SendMessageToChatRoom(roomId, res.JsonResponse{Success: true, Type: FrameRead, Payload: payload})
+	}
+
+	return nil
+}
+
+func errorMessage(err error) string {
+	if appError, ok := err.(*common.AppError); ok {
+		return appError.Message
+	}
+	return "서버 에러"
 }
 
 // TODO 채팅 웹소켓 연결 핸들러
@@ -219,10 +333,10 @@ func (h *WsHandler) HandleWebSocketConnection(c *gin.Context) {
 	}
 
 	// 토큰 검증
-	claims, err := util.ValidateAccessToken(token)
+	claims, err := h.authUsecase.ValidateAccessToken(token)
 	if err != nil {
 		log.Printf("토큰 검증 실패: %v", err)
-		conn.WriteJSON(res.JsonResponse{
+		h.hub.SendToConn(conn, res.JsonResponse{
 			Success: false,
 			Message: "유효하지 않은 토큰입니다.",
 			Type:    "error",
@@ -234,7 +348,7 @@ func (h *WsHandler) HandleWebSocketConnection(c *gin.Context) {
 	roomIdUint, err := strconv.ParseUint(roomId, 10, 64)
 	if err != nil {
 		log.Printf("room_id 변환 실패: %v", err)
-		conn.WriteJSON(res.JsonResponse{
+		h.hub.SendToConn(conn, res.JsonResponse{
 			Success: false,
 			Message: "room_id 형식이 올바르지 않습니다",
 			Type:    "error",
@@ -245,13 +359,22 @@ func (h *WsHandler) HandleWebSocketConnection(c *gin.Context) {
 	userIdUint, err := strconv.ParseUint(senderId, 10, 64)
 	if err != nil {
 		log.Printf("sender_id 변환 실패: %v", err)
-		conn.WriteJSON(res.JsonResponse{
+		h.hub.SendToConn(conn, res.JsonResponse{
 			Success: false,
 			Message: "sender_id 형식이 올바르지 않습니다",
 			Type:    "error",
 		})
 		return
 	}
+	if uint(userIdUint) != claims.UserId {
+		h.hub.SendToConn(conn, res.JsonResponse{
+			Success: false,
+			Message: "sender_id가 토큰 사용자와 일치하지 않습니다",
+			Type:    "error",
+		})
+		conn.Close()
+		return
+	}
 
 	// 연결 종료 시 클라이언트와 채팅방에서 제거
 	defer func() {
@@ -271,7 +394,7 @@ func (h *WsHandler) HandleWebSocketConnection(c *gin.Context) {
 			chatRoomResponse, err := h.chatUsecase.GetChatRoomById(uint(roomIdUint))
 			if err != nil || chatRoomResponse == nil {
 				log.Printf("DB 채팅방 조회 실패: %v", err)
-				conn.WriteJSON(res.JsonResponse{
+				h.hub.SendToConn(conn, res.JsonResponse{
 					Success: false,
 					Message: "채팅방이 없습니다",
 					Type:    "error",
@@ -313,7 +436,7 @@ func (h *WsHandler) HandleWebSocketConnection(c *gin.Context) {
 	h.hub.RegisterClient(conn, uint(userIdUint), uint(roomIdUint))
 
 	// 연결 성공 메시지 전송
-	conn.WriteJSON(res.JsonResponse{
+	h.hub.SendToConn(conn, res.JsonResponse{
 		Success: true,
 		Message: "연결 성공",
 		Type:    "connection",
@@ -328,7 +451,7 @@ func (h *WsHandler) HandleWebSocketConnection(c *gin.Context) {
 				log.Printf("예기치 않은 WebSocket 종료: %v", err)
 			}
 			log.Printf("메시지 수신 실패: %v", err)
-			conn.WriteJSON(res.JsonResponse{
+			h.hub.SendToConn(conn, res.JsonResponse{
 				Success: false,
 				Message: "메시지 형식이 올바르지 않습니다",
 				Type:    "error",
@@ -340,13 +463,30 @@ func (h *WsHandler) HandleWebSocketConnection(c *gin.Context) {
 		var message req.SendMessageRequest
 		if err := json.Unmarshal(messageBytes, &message); err != nil {
 			log.Printf("메시지 디코딩 실패: %v", err)
-			conn.WriteJSON(res.JsonResponse{
+			h.hub.SendToConn(conn, res.JsonResponse{
 				Success: false,
 				Message: "메시지 디코딩 실패",
 				Type:    "error",
 			})
 			continue
 		}
+		// 발신자/채팅방은 인증된 연결 기준 (payload의 값은 무시)
+		message.SenderID = claims.UserId
+		message.RoomID = uint(roomIdUint)
+
+		//TODO 입력중/읽음/수신 확인 이벤트 - 연결한 채팅방 기준
+		switch message.Type {
+		case FrameTyping, FrameRead, FrameDelivered:
+			signal := req.ChatSignalRequest{MessageID: message.MessageID, Typing: message.Typing}
+			if err := h.handleChatSignal(claims.UserId, claims.Name, uint(roomIdUint), message.Type, signal); err != nil {
+				h.hub.SendToConn(conn, res.JsonResponse{
+					Success: false,
+					Message: errorMessage(err),
+					Type:    "error",
+				})
+			}
+			continue
+		}
 
 		chatRoomFromRedis, err := h.chatUsecase.GetChatRoomByIdFromRedis(message.RoomID)
 		if err != nil || chatRoomFromRedis == nil {
@@ -387,41 +527,23 @@ func (h *WsHandler) HandleWebSocketConnection(c *gin.Context) {
 			chatRoomFromRedis = chatRoomFromDB
 		}
 
-		// 메시지 저장 -> nats pub으로 발행 저장 로직 처리
-		if _, err := h.chatUsecase.SaveMessage(message.SenderID, message.RoomID, message.Content); err != nil {
+		// 메시지 저장 -> nats pub으로 발행 저장 로직 처리 (답장 포함, 첨부 파일은 REST로만 전송)
+		chatPayload, err := h.chatUsecase.SaveMessage(claims.UserId, uint(roomIdUint), &message)
+		if err != nil {
 			log.Printf("채팅 메시지 저장 실패: %v", err)
-			conn.WriteJSON(res.JsonResponse{
+			h.hub.SendToConn(conn, res.JsonResponse{
 				Success: false,
-				Message: "채팅 메시지 저장 실패",
+				Message: errorMessage(err),
 				Type:    "error",
 			})
 			continue
 		}
 
-		userInfo, err := h.userUsecase.GetUserMyInfo(message.SenderID)
-		if err != nil {
-			log.Printf("사용자 정보 조회 실패: %v", err)
-			continue
-		}
-
-		userImage := ""
-		if userInfo.UserProfile.Image != nil {
-			userImage = *userInfo.UserProfile.Image
-		}
-
 		// 메시지 전송 성공 및 브로드캐스트
 		h.hub.SendMessageToChatRoom(message.RoomID, res.JsonResponse{
 			Success: true,
 			Type:    "chat",
-			Payload: &res.ChatPayload{
-				ChatRoomID:  message.RoomID,
-				SenderID:    message.SenderID,
-				SenderName:  claims.Name,
-				SenderEmail: claims.Email,
-				SenderImage: userImage,
-				Content:     message.Content,
-				CreatedAt:   time.Now().Format(time.RFC3339),
-			},
+			Payload: chatPayload,
 		})
 	}
 }
@@ -456,10 +578,10 @@ func (h *WsHandler) HandleUserWebSocketConnection(c *gin.Context) {
 	}
 
 	// 토큰 검증
-	_, err = util.ValidateAccessToken(token)
+	_, err = h.authUsecase.ValidateAccessToken(token)
 	if err != nil {
 		log.Printf("토큰 검증 실패: %v", err)
-		conn.WriteJSON(res.JsonResponse{
+		h.hub.SendToConn(conn, res.JsonResponse{
 			Success: false,
 			Message: "Unauthorized",
 			Type:    "error",
@@ -473,7 +595,7 @@ func (h *WsHandler) HandleUserWebSocketConnection(c *gin.Context) {
 	userIdUint, err := strconv.ParseUint(userId, 10, 64)
 	if err != nil {
 		log.Printf("userId 변환 실패: %v", err)
-		conn.WriteJSON(res.JsonResponse{
+		h.hub.SendToConn(conn, res.JsonResponse{
 			Success: false,
 			Message: "userId 형식이 올바르지 않습니다",
 			Type:    "error",
@@ -490,7 +612,7 @@ func (h *WsHandler) HandleUserWebSocketConnection(c *gin.Context) {
 	user, err := h.userUsecase.GetUserMyInfo(uint(userIdUint))
 	if err != nil {
 		log.Printf("사용자 조회에 실패했습니다: %v", err)
-		conn.WriteJSON(res.JsonResponse{
+		h.hub.SendToConn(conn, res.JsonResponse{
 			Success: false,
 			Message: "사용자 조회에 실패했습니다",
 			Type:    "error",
@@ -510,13 +632,10 @@ func (h *WsHandler) HandleUserWebSocketConnection(c *gin.Context) {
 	}()
 
 	// 첫 연결인 경우에만 상태 업데이트
-	clientsMap := h.hub.Clients[userIDUint]
-	if clientsMap != nil {
-		if len(clientsMap) == 1 {
-			if err := h.userUsecase.UpdateUserOnlineStatus(*user.ID, true); err != nil {
-				log.Printf("온라인 상태 업데이트 실패: %v", err)
-				logger.LogError("온라인 상태 업데이트 실패")
-			}
+	if h.hub.ClientCount(userIDUint) == 1 {
+		if err := h.userUsecase.UpdateUserOnlineStatus(*user.ID, true); err != nil {
+			log.Printf("온라인 상태 업데이트 실패: %v", err)
+			logger.LogError("온라인 상태 업데이트 실패")
 		}
 	}
 
@@ -535,7 +654,7 @@ func (h *WsHandler) HandleUserWebSocketConnection(c *gin.Context) {
 		var message req.NotificationRequest
 		if err := json.Unmarshal(messageBytes, &message); err != nil {
 			log.Printf("메시지 디코딩 실패: %v", err)
-			conn.WriteJSON(res.JsonResponse{
+			h.hub.SendToConn(conn, res.JsonResponse{
 				Success: false,
 				Message: "메시지 형식이 올바르지 않습니다",
 				Type:    "notification",
@@ -577,7 +696,7 @@ func (h *WsHandler) HandleCompanyEvent(c *gin.Context) {
 	companyIdUint, err := strconv.ParseUint(companyId, 10, 64)
 	if err != nil {
 		log.Printf("companyId 변환 실패: %v", err)
-		conn.WriteJSON(res.JsonResponse{
+		h.hub.SendToConn(conn, res.JsonResponse{
 			Success: false,
 			Message: "companyId 형식이 올바르지 않습니다",
 			Type:    "error",
@@ -596,7 +715,7 @@ func (h *WsHandler) HandleCompanyEvent(c *gin.Context) {
 	_, err = h.companyUsecase.GetCompanyInfo(uint(companyIdUint))
 	if err != nil {
 		log.Printf("회사 조회 실패: %v", err)
-		conn.WriteJSON(res.JsonResponse{
+		h.hub.SendToConn(conn, res.JsonResponse{
 			Success: false,
 			Message: "회사 조회 실패",
 			Type:    "error",
@@ -610,7 +729,7 @@ func (h *WsHandler) HandleCompanyEvent(c *gin.Context) {
 	h.hub.RegisterCompanyClient(conn, uint(companyIdUint))
 
 	subject := "audit.>"
-	h.natsSubscriber.SubscribeEvent(subject, func(msg *nats.Msg) {
+	h.subscribeEvent(subject, func(msg *nats.Msg) {
 		var event map[string]interface{}
 		if err := json.Unmarshal(msg.Data, &event); err != nil {
 			log.Printf("회사 이벤트 파싱 오류: %v", err)
@@ -668,10 +787,10 @@ func (h *WsHandler) HandleBoardWebSocket(c *gin.Context) {
 	boardIDStr := c.Query("boardId")
 	userIdStr := c.Query("userId")
 
-	if token == "" || boardIDStr == "" || userIdStr == "" {
+	if token == "" || boardIDStr == "" {
 		c.JSON(http.StatusBadRequest, res.JsonResponse{
 			Success: false,
-			Message: "토큰과 보드 ID와 사용자 ID가 필요합니다",
+			Message: "토큰과 보드 ID가 필요합니다",
 		})
 		return
 	}
@@ -687,7 +806,7 @@ func (h *WsHandler) HandleBoardWebSocket(c *gin.Context) {
 	}
 
 	// 토큰 검증
-	_, err = util.ValidateAccessToken(token)
+	claims, err := h.authUsecase.ValidateAccessToken(token)
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, res.JsonResponse{
 			Success: false,
@@ -696,11 +815,21 @@ func (h *WsHandler) HandleBoardWebSocket(c *gin.Context) {
 		return
 	}
 
-	userID, err := strconv.ParseUint(userIdStr, 10, 64)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, res.JsonResponse{
+	// 사용자는 토큰 기준 (userId는 이전 클라이언트 호환용으로만 받고 토큰과 다르면 거부)
+	userID := claims.UserId
+	if userIdStr != "" && userIdStr != strconv.FormatUint(uint64(userID), 10) {
+		c.JSON(http.StatusForbidden, res.JsonResponse{
+			Success: false,
+			Message: "사용자 ID가 토큰과 일치하지 않습니다",
+		})
+		return
+	}
+
+	// 보드 참여자만 연결 가능 (카드 내용, 커서, 상태 변경이 모두 전파됨)
+	if _, err := h.boardUsecase.GetBoard(userID, uint(boardID)); err != nil {
+		c.JSON(http.StatusForbidden, res.JsonResponse{
 			Success: false,
-			Message: "유효하지 않은 사용자 ID입니다",
+			Message: "해당 보드에 접근할 수 없습니다",
 		})
 		return
 	}
@@ -734,11 +863,24 @@ func (h *WsHandler) HandleBoardWebSocket(c *gin.Context) {
 	}
 
 	h.natsPublisher.PublishEvent("link.event.board.user.joined", jsonData)
+
+	// 이 연결에서 협업 편집에 사용한 client_id (연결 종료 시 커서 제거)
+	var cardClientMutex sync.Mutex
+	cardClientIDs := make(map[string]struct{})
+
 	// 연결 종료 시 정리
 	defer func() {
 		log.Printf("보드 ID %d에서 사용자 ID %d 연결 종료", boardID, userID)
 		h.hub.UnregisterBoardClient(conn, uint(userID), uint(boardID))
 
+		cardClientMutex.Lock()
+		clientIDs := make([]string, 0, len(cardClientIDs))
+		for clientID := range cardClientIDs {
+			clientIDs = append(clientIDs, clientID)
+		}
+		cardClientMutex.Unlock()
+		h.removeCardCursors(claims.UserId, uint(boardID), clientIDs)
+
 		natsData := map[string]interface{}{
 			"topic": "link.event.board.user.left",
 			"payload": map[string]interface{}{
@@ -795,6 +937,33 @@ func (h *WsHandler) HandleBoardWebSocket(c *gin.Context) {
 
 			// 모든 메시지 수신 시 활동 시간 업데이트
 			h.hub.UpdateBoardUserActivity(uint(boardID), uint(userID))
+
+			//TODO 카드 협업 편집 메시지
+			if messageType, _ := clientMsg["type"].(string); strings.HasPrefix(messageType, "card.doc.") {
+				var request req.CardDocumentRequest
+				if err := json.Unmarshal(message, &request); err != nil {
+					log.Printf("메시지 파싱 오류: %v", err)
+					continue
+				}
+
+				if request.ClientID != "" {
+					cardClientMutex.Lock()
+					cardClientIDs[request.ClientID] = struct{}{}
+					cardClientMutex.Unlock()
+				}
+
+				if err := h.handleCardDocumentMessage(conn, claims.UserId, claims.Name, uint(boardID), request); err != nil {
+					h.hub.SendToConn(conn, res.JsonResponse{
+						Success: false,
+						Message: errorMessage(err),
+						Type:    "error",
+						Payload: map[string]interface{}{
+							"type":    request.Type,
+							"card_id": request.CardID,
+						},
+					})
+				}
+			}
 		}
 	}()
 
@@ -814,3 +983,109 @@ func (h *WsHandler) HandleBoardWebSocket(c *gin.Context) {
 		}
 	}
 }
+
+// 카드 협업 편집 메시지 (/ws/board)
+const (
+	CardDocOpen     = "card.doc.open"     // 편집 시작 -> card.doc.snapshot
+	CardDocOp       = "card.doc.op"       // 편집 연산 -> 보낸 연결에 card.doc.ack, 보드 전체에 card.doc.op
+	CardDocCursor   = "card.doc.cursor"   // 커서/선택 영역 -> 보드 전체에 card.doc.cursor
+	CardDocClose    = "card.doc.close"    // 편집 종료 -> 보드 전체에 card.doc.cursor (removed)
+	CardDocSnapshot = "card.doc.snapshot" // 현재 문서 (리비전이 맞지 않을 때도 다시 보냄)
+	CardDocAck      = "card.doc.ack"
+)
+
+func (h *WsHandler) handleCardDocumentMessage(conn *websocket.Conn, userId uint, userName string, boardID uint, request req.CardDocumentRequest) error {
+	switch request.Type {
+	case CardDocOpen:
+		return h.sendCardDocument(conn, userId, boardID, request)
+
+	case CardDocOp:
+		payload, err := h.cardDocumentUsecase.ApplyCardOperation(userId, boardID, &request)
+		if err != nil {
+			// 리비전/세션이 맞지 않으면 최신 문서를 다시 보내 클라이언트가 맞추도록 함
+			if appError, ok := err.(*common.AppError); ok && appError.StatusCode == http.StatusConflict {
+				if sendErr := h.sendCardDocument(conn, userId, boardID, request); sendErr != nil {
+					log.Printf("카드 문서 재전송 실패: %v", sendErr)
+				}
+			}
+			return err
+		}
+
+		h.hub.SendToConn(conn, res.JsonResponse{
+			Success: true,
+			Type:    CardDocAck,
+			Payload: payload,
+		})
+		h.hub.BroadcastToBoard(boardID, res.JsonResponse{
+			Success: true,
+			Type:    CardDocOp,
+			Payload: payload,
+		})
+
+	case CardDocCursor:
+		payload, err := h.cardDocumentUsecase.UpdateCardCursor(userId, userName, boardID, &request)
+		if err != nil {
+			return err
+		}
+		h.hub.BroadcastToBoard(boardID, res.JsonResponse{
+			Success: true,
+			Type:    CardDocCursor,
+			Payload: payload,
+		})
+
+	case CardDocClose:
+		removed, err := h.cardDocumentUsecase.CloseCardDocument(userId, boardID, request.CardID, request.ClientID)
+		if err != nil {
+			return err
+		}
+		for _, payload := range removed {
+			h.hub.BroadcastToBoard(boardID, res.JsonResponse{
+				Success: true,
+				Type:    CardDocCursor,
+				Payload: payload,
+			})
+		}
+
+	default:
+		return common.NewError(http.StatusBadRequest, "알 수 없는 메시지 타입입니다.", nil)
+	}
+
+	return nil
+}
+
+func (h *WsHandler) sendCardDocument(conn *websocket.Conn, userId uint, boardID uint, request req.CardDocumentRequest) error {
+	// 단일 노드에서는 보드 접속자(BoardOnlineUsers)의 커서만, 클러스터 모드에서는 최근 움직인 커서
+	var onlineUserIDs []uint
+	if !h.hub.Clustered() {
+		onlineUserIDs = h.hub.GetBoardOnlineUsers(boardID)
+	}
+
+	document, err := h.cardDocumentUsecase.OpenCardDocument(userId, boardID, request.CardID, onlineUserIDs)
+	if err != nil {
+		return err
+	}
+
+	h.hub.SendToConn(conn, res.JsonResponse{
+		Success: true,
+		Type:    CardDocSnapshot,
+		Payload: document,
+	})
+	return nil
+}
+
+// 연결이 끊긴 클라이언트의 커서를 지우고 보드에 알림
+func (h *WsHandler) removeCardCursors(userId uint, boardID uint, clientIDs []string) {
+	removed, err := h.cardDocumentUsecase.LeaveCardDocuments(userId, boardID, clientIDs)
+	if err != nil {
+		log.Printf("카드 커서 정리 실패: %v", err)
+		return
+	}
+
+	for _, payload := range removed {
+		h.hub.BroadcastToBoard(boardID, res.JsonResponse{
+			Success: true,
+			Type:    CardDocCursor,
+			Payload: payload,
+		})
+	}
+}
@@ -9,7 +9,9 @@ import (
 	"sync"
 	"time"
 
+	"github.com/go-redis/redis/v8"
 	"github.com/gorilla/websocket"
+	"github.com/nats-io/nats.go"
 
 	"link/pkg/dto/res"
 )
@@ -33,8 +35,22 @@ type WebSocketHub struct {
 	Register         chan ClientRegistration
 	Unregister       chan UnregisterInfo
 	boardMutexes     sync.Map // 보드 ID에 따라 뮤텍스를 관리 (key: boardId, value: sync.Mutex)
-	OnlineClients    sync.Map // 전체 온라인 유저 (key: userId, value: true/false)
+	OnlineClients    sync.Map // 전체 온라인 유저 (key: userId, value: true/false) - 클러스터 모드에서는 presence 이벤트로 모든 노드 기준 유지
 	stopCleanup      chan struct{}
+	cluster          *wsCluster // 클러스터 모드가 아니면 nil
+
+	// /ws/v2 토픽 구독 (ws_topic.go)
+	topicMutex     sync.RWMutex
+	topics         map[string]*topicSubscribers // 토픽 키 -> 구독 중인 연결
+	topicClients   map[*TopicClient]struct{}
+	topicUserConns map[uint]int // 사용자별 v2 연결 수 (온라인 상태 판단)
+	topicLog       topicLog     // 토픽별 seq + 재전송 버퍼 (ws_replay.go)
+
+	// 연결별 전송 큐 (ws_writer.go)
+	writers        sync.Map // *websocket.Conn -> *connWriter
+	sendQueueSize  int
+	overflowPolicy OverflowPolicy
+	drops          dropCounters
 }
 
 // ConnectionInfo는 연결 정보를 담는 구조체입니다.
@@ -44,6 +60,7 @@ type ConnectionInfo struct {
 	LastPing time.Time
 	IsActive bool
 	Cancel   context.CancelFunc
+	writer   *connWriter // 이 연결의 전송 큐
 }
 
 // ClientRegistration는 클라이언트와 관련된 정보를 담는 구조체입니다.
@@ -74,12 +91,25 @@ var Upgrader = websocket.Upgrader{
 }
 
 // NewWebSocketHub는 새로운 WebSocketHub를 생성합니다.
-func NewWebSocketHub() *WebSocketHub {
+// WS_CLUSTER_MODE=true면 NATS로 노드 간 메시지를 전달하고 redis로 온라인 상태를 공유합니다.
+func NewWebSocketHub(natsConn *nats.Conn, redisClient *redis.Client) *WebSocketHub {
 	hub := &WebSocketHub{
 		Register:    make(chan ClientRegistration),
 		Unregister:  make(chan UnregisterInfo),
 		Clients:     make(map[uint]map[*websocket.Conn]*ConnectionInfo),
 		stopCleanup: make(chan struct{}),
+		cluster:     newWsCluster(natsConn, redisClient),
+
+		topics:         make(map[string]*topicSubscribers),
+		topicClients:   make(map[*TopicClient]struct{}),
+		topicUserConns: make(map[uint]int),
+		topicLog:       newTopicLog(redisClient),
+		sendQueueSize:  sendQueueSizeFromEnv(),
+		overflowPolicy: overflowPolicyFromEnv(),
+	}
+
+	if hub.cluster != nil {
+		hub.startCluster()
 	}
 
 	go hub.Run()
@@ -125,14 +155,18 @@ func (hub *WebSocketHub) cleanupInactiveConnections() {
 		// 오래된 연결 제거
 		for _, conn := range connsToRemove {
 			delete(clientsMap, conn)
+			hub.releaseWriter(conn)
 			conn.Close()
 			log.Printf("사용자 %d의 비활성 연결 제거됨", userID)
 		}
 
 		if len(clientsMap) == 0 {
 			delete(hub.Clients, userID)
-			hub.OnlineClients.Store(userID, false)
-			hub.BroadcastOnlineStatus(userID, false)
+			go func(userID uint) {
+				if !hub.hasTopicClients(userID) {
+					hub.setUserOffline(userID)
+				}
+			}(userID)
 			log.Printf("사용자 %d의 모든 연결 제거됨, 오프라인 상태로 변경", userID)
 		} else {
 			hub.Clients[userID] = clientsMap
@@ -153,6 +187,7 @@ func (hub *WebSocketHub) cleanupInactiveConnections() {
 
 		for _, conn := range connsToRemove {
 			delete(clientsMap, conn)
+			hub.releaseWriter(conn)
 			conn.Close()
 			log.Printf("회사 %d의 비활성 연결 제거됨", companyID)
 		}
@@ -226,33 +261,25 @@ func (hub *WebSocketHub) RegisterClient(conn *websocket.Conn, userID uint, roomI
 		return
 	}
 
+	// NATS 구독 고루틴에서도 Clients를 읽으므로 맵 수정은 clientMutex 안에서
+	hub.clientMutex.Lock()
 	clientsMap := hub.Clients[userID]
 	if clientsMap == nil {
 		clientsMap = make(map[*websocket.Conn]*ConnectionInfo)
 		hub.Clients[userID] = clientsMap
 	}
 
+	var oldestConn *websocket.Conn
 	if len(clientsMap) >= MaxConnectionsPerUser {
-
-		var oldestConn *websocket.Conn
 		oldestTime := time.Now()
-
 		for conn, info := range clientsMap {
 			if info.LastPing.Before(oldestTime) {
 				oldestTime = info.LastPing
 				oldestConn = conn
 			}
 		}
-
 		if oldestConn != nil {
 			delete(clientsMap, oldestConn)
-			oldestConn.WriteJSON(res.JsonResponse{
-				Success: false,
-				Message: "다른 기기에서 새로운 연결이 감지되어 연결이 종료됩니다.",
-				Type:    "close",
-			})
-			oldestConn.Close()
-			log.Printf("사용자 %d의 최대 연결 수 초과로 오래된 연결 제거됨", userID)
 		}
 	}
 
@@ -264,31 +291,33 @@ func (hub *WebSocketHub) RegisterClient(conn *websocket.Conn, userID uint, roomI
 		LastPing: time.Now(),
 		IsActive: true,
 		Cancel:   cancel,
+		writer:   hub.attachWriter(conn),
+	}
+	connectionCount := len(clientsMap)
+	hub.clientMutex.Unlock()
+
+	if oldestConn != nil {
+		hub.closeAfterSend(oldestConn, res.JsonResponse{
+			Success: false,
+			Message: "다른 기기에서 새로운 연결이 감지되어 연결이 종료됩니다.",
+			Type:    "close",
+		})
+		log.Printf("사용자 %d의 최대 연결 수 초과로 오래된 연결 제거됨", userID)
 	}
 
 	conn.SetPingHandler(func(appData string) error {
-		if info, ok := clientsMap[conn]; ok {
-			info.LastPing = time.Now()
-		}
+		hub.touchClient(userID, conn)
 		return conn.WriteControl(websocket.PongMessage, []byte{}, time.Now().Add(WriteWait))
 	})
 
-	conn.SetPongHandler(func(appData string) error {
-		if info, ok := clientsMap[conn]; ok {
-			info.LastPing = time.Now()
-		}
-		return nil
-	})
-
 	conn.SetReadDeadline(time.Now().Add(PongWait))
 	conn.SetPongHandler(func(string) error {
+		hub.touchClient(userID, conn)
 		conn.SetReadDeadline(time.Now().Add(PongWait))
 		return nil
 	})
 
-	hub.Clients[userID] = clientsMap
-
-	log.Printf("사용자 %d 연결 성공, 현재 연결 수: %d", userID, len(clientsMap))
+	log.Printf("사용자 %d 연결 성공, 현재 연결 수: %d", userID, connectionCount)
 
 	// 추가: 기존 온라인 유저 정보 전송
 	if roomID == 0 {
@@ -302,26 +331,37 @@ func (hub *WebSocketHub) RegisterClient(conn *websocket.Conn, userID uint, roomI
 			return true
 		})
 
-		conn.WriteJSON(res.JsonResponse{
+		hub.enqueue(conn, res.JsonResponse{
 			Success: true,
 			Message: fmt.Sprintf("User %d 연결 성공", userID),
 			Type:    "connection",
-		})
+		}, dropScope{UserID: userID})
 	}
 
-	// 자신의 온라인 상태가 변경된 경우 다른 사용자에게 알림
-	if len(clientsMap) == 1 {
-		oldStatus, _ := hub.OnlineClients.Load(userID)
-		if oldStatus == nil || oldStatus == false {
-			hub.OnlineClients.Store(userID, true)
-			hub.BroadcastOnlineStatus(userID, true)
-			log.Printf("사용자 %d 온라인 상태로 변경", userID)
-		}
+	// 자신의 온라인 상태가 변경된 경우 다른 사용자에게 알림 (v2 연결이 있으면 이미 온라인)
+	if connectionCount == 1 && !hub.hasTopicClients(userID) {
+		hub.setUserOnline(userID)
 	}
 
 	go hub.startPingRoutine(ctx, conn, userID)
 }
 
+// ping/pong 수신 시각 갱신
+func (hub *WebSocketHub) touchClient(userID uint, conn *websocket.Conn) {
+	hub.clientMutex.Lock()
+	defer hub.clientMutex.Unlock()
+	if info, ok := hub.Clients[userID][conn]; ok {
+		info.LastPing = time.Now()
+	}
+}
+
+// 사용자의 legacy 연결 수
+func (hub *WebSocketHub) ClientCount(userID uint) int {
+	hub.clientMutex.Lock()
+	defer hub.clientMutex.Unlock()
+	return len(hub.Clients[userID])
+}
+
 // Ping 메시지 전송 루틴
 func (hub *WebSocketHub) startPingRoutine(ctx context.Context, conn *websocket.Conn, userID uint) {
 	ticker := time.NewTicker(PingInterval)
@@ -339,20 +379,20 @@ func (hub *WebSocketHub) startPingRoutine(ctx context.Context, conn *websocket.C
 				return
 			}
 
-			clientsMap := hub.Clients[userID]
-			if clientsMap == nil {
-				return
-			}
-
-			info, exists := clientsMap[conn]
-			if !exists || !info.IsActive {
+			hub.clientMutex.Lock()
+			info, exists := hub.Clients[userID][conn]
+			active := exists && info.IsActive
+			hub.clientMutex.Unlock()
+			if !active {
 				return
 			}
 
 			// Ping 메시지 전송
 			if err := conn.WriteControl(websocket.PingMessage, []byte{}, time.Now().Add(WriteWait)); err != nil {
 				log.Printf("Ping 메시지 전송 실패: %v", err)
+				hub.clientMutex.Lock()
 				info.IsActive = false
+				hub.clientMutex.Unlock()
 				hub.Unregister <- UnregisterInfo{
 					Conn:   conn,
 					UserID: userID,
@@ -371,6 +411,7 @@ func (hub *WebSocketHub) UnregisterClient(conn *websocket.Conn, userID uint, roo
 	// 채팅방에서 유저 제거
 	if roomID != 0 {
 		hub.RemoveFromChatRoom(roomID, userID)
+		hub.releaseWriter(conn)
 		return
 	}
 
@@ -401,18 +442,21 @@ func (hub *WebSocketHub) UnregisterClient(conn *websocket.Conn, userID uint, roo
 		delete(hub.Clients, userID)
 		hub.clientMutex.Unlock()
 
-		hub.OnlineClients.Store(userID, false)
-		hub.BroadcastOnlineStatus(userID, false)
-		log.Printf("사용자 %d의 모든 연결 해제됨, 오프라인 상태로 변경", userID)
+		if !hub.hasTopicClients(userID) {
+			hub.setUserOffline(userID)
+			log.Printf("사용자 %d의 모든 연결 해제됨, 오프라인 상태로 변경", userID)
+		}
 	}
 
 	if conn != nil {
+		hub.releaseWriter(conn)
 		conn.Close()
 	}
 }
 
 // 채팅방에 클라이언트 추가
 func (hub *WebSocketHub) AddToChatRoom(roomID uint, userID uint, conn *websocket.Conn) {
+	hub.attachWriter(conn)
 	room, _ := hub.ChatRooms.LoadOrStore(roomID, &ChatRoom{})
 	room.(*ChatRoom).Clients.Store(userID, conn)
 
@@ -444,10 +488,31 @@ func (hub *WebSocketHub) RemoveFromChatRoom(roomID uint, userID uint) {
 
 // 특정 채팅방에 메시지 전송
 func (hub *WebSocketHub) SendMessageToChatRoom(roomID uint, message res.JsonResponse) {
+	seq := hub.sequence(chatTopic(roomID), message)
+	if hub.cluster != nil {
+		hub.cluster.publish(fmt.Sprintf("%sroom.%d", clusterSubjectPrefix, roomID), sequencedMessage{Seq: seq, Message: message})
+		return
+	}
+	hub.deliverToChatRoom(roomID, seq, message)
+}
+
+// 재전송 버퍼에 남기지 않는 일시적 채팅방 이벤트 (입력중, 수신 확인)
+func (hub *WebSocketHub) SignalChatRoom(roomID uint, message res.JsonResponse) {
+	if hub.cluster != nil {
+		hub.cluster.publish(fmt.Sprintf("%sroom.%d", clusterSubjectPrefix, roomID), sequencedMessage{Message: message})
+		return
+	}
+	hub.deliverToChatRoom(roomID, 0, message)
+}
+
+// 이 노드에 연결된 채팅방 소켓에 전달
+func (hub *WebSocketHub) deliverToChatRoom(roomID uint, seq uint64, message interface{}) {
+	hub.deliverToTopic(chatTopic(roomID), seq, message)
+
 	if room, ok := hub.ChatRooms.Load(roomID); ok {
 		room.(*ChatRoom).Clients.Range(func(userID, clientConn interface{}) bool {
 			client := clientConn.(*websocket.Conn)
-			hub.sendMessageToClient(client, message)
+			hub.enqueue(client, message, dropScope{UserID: userID.(uint), RoomID: roomID})
 			return true
 		})
 	} else {
@@ -458,17 +523,27 @@ func (hub *WebSocketHub) SendMessageToChatRoom(roomID uint, message res.JsonResp
 // 특정 유저에게 메시지 전송 -> 특정 유저에게 알람을 보낼 때,
 // 알림 같은거 보낼 때 사용
 func (hub *WebSocketHub) SendMessageToUser(userID uint, message res.JsonResponse) {
-	clientsMap := hub.Clients[userID]
-	for client := range clientsMap {
-		hub.sendMessageToClient(client, message)
+	seq := hub.sequence(userTopic(userID), message)
+	if hub.cluster != nil {
+		hub.cluster.publish(fmt.Sprintf("%suser.%d", clusterSubjectPrefix, userID), sequencedMessage{Seq: seq, Message: message})
+		return
 	}
+	hub.deliverToUser(userID, seq, message)
 }
 
-// 개별 클라이언트에 메시지 전송
-func (hub *WebSocketHub) sendMessageToClient(client *websocket.Conn, message interface{}) {
-	if err := client.WriteJSON(message); err != nil {
-		log.Printf("클라이언트에게 메시지 전송 실패: %v\n", err)
-		client.Close()
+func (hub *WebSocketHub) deliverToUser(userID uint, seq uint64, message interface{}) {
+	hub.deliverToTopic(userTopic(userID), seq, message)
+
+	// 연결 목록만 잠금 안에서 복사하고 전송은 잠금 밖에서
+	hub.clientMutex.Lock()
+	conns := make([]*websocket.Conn, 0, len(hub.Clients[userID]))
+	for client := range hub.Clients[userID] {
+		conns = append(conns, client)
+	}
+	hub.clientMutex.Unlock()
+
+	for _, client := range conns {
+		hub.enqueue(client, message, dropScope{UserID: userID})
 	}
 }
 
@@ -486,15 +561,16 @@ func (hub *WebSocketHub) RegisterCompanyClient(conn *websocket.Conn, companyID u
 		UserID:   0, // 회사는 UserID가 없음
 		LastPing: time.Now(),
 		IsActive: true,
+		writer:   hub.attachWriter(conn),
 	}
 
 	hub.CompanyClients.Store(companyID, clientsMap)
 
-	conn.WriteJSON(res.JsonResponse{
+	hub.enqueue(conn, res.JsonResponse{
 		Success: true,
 		Message: fmt.Sprintf("Company %d 연결 성공", companyID),
 		Type:    "company_connection",
-	})
+	}, dropScope{})
 
 }
 
@@ -511,24 +587,63 @@ func (hub *WebSocketHub) UnregisterCompanyClient(conn *websocket.Conn, companyID
 			log.Printf("회사 %d 클라이언트 연결 해제, 남은 연결 수: %d", companyID, len(clientsMap))
 		}
 	}
+	hub.releaseWriter(conn)
 	conn.Close()
 }
 
 // 회사 클라이언트에게 메시지 전송
 func (h *WebSocketHub) SendMessageToCompany(companyId uint, msg res.JsonResponse) {
+	seq := h.sequence(companyTopic(companyId), msg)
+	if h.cluster != nil {
+		h.cluster.publish(fmt.Sprintf("%scompany.%d", clusterSubjectPrefix, companyId), sequencedMessage{Seq: seq, Message: msg})
+		return
+	}
+	h.deliverToCompany(companyId, seq, msg)
+}
+
+func (h *WebSocketHub) deliverToCompany(companyId uint, seq uint64, msg interface{}) {
+	h.deliverToTopic(companyTopic(companyId), seq, msg)
+
 	if clientsMapInterface, ok := h.CompanyClients.Load(companyId); ok {
 		clientsMap := clientsMapInterface.(map[*websocket.Conn]*ConnectionInfo)
 		for conn := range clientsMap {
-			if err := conn.WriteJSON(msg); err != nil {
-				log.Printf("웹소켓 메시지 전송 실패: %v", err)
-			}
+			h.enqueue(conn, msg, dropScope{})
 		}
 	}
 }
 
-// 온라인 상태 변경할 때
-func (hub *WebSocketHub) BroadcastOnlineStatus(userID uint, online bool) {
-	statusMessage := res.JsonResponse{
+// 이 노드에 사용자의 첫 연결 - 클러스터 모드면 다른 노드에 연결이 없을 때만 온라인 알림
+func (hub *WebSocketHub) setUserOnline(userID uint) {
+	if hub.cluster != nil {
+		if hub.cluster.addPresence(userID) {
+			hub.BroadcastOnlineStatus(userID, true)
+		}
+		return
+	}
+
+	oldStatus, _ := hub.OnlineClients.Load(userID)
+	if oldStatus == nil || oldStatus == false {
+		hub.OnlineClients.Store(userID, true)
+		hub.BroadcastOnlineStatus(userID, true)
+		log.Printf("사용자 %d 온라인 상태로 변경", userID)
+	}
+}
+
+// 이 노드에서 사용자의 마지막 연결 해제 - 클러스터 모드면 다른 노드에도 연결이 없을 때만 오프라인 알림
+func (hub *WebSocketHub) setUserOffline(userID uint) {
+	if hub.cluster != nil {
+		if hub.cluster.removePresence(userID) {
+			hub.BroadcastOnlineStatus(userID, false)
+		}
+		return
+	}
+
+	hub.OnlineClients.Store(userID, false)
+	hub.BroadcastOnlineStatus(userID, false)
+}
+
+func onlineStatusMessage(userID uint, online bool) res.JsonResponse {
+	return res.JsonResponse{
 		Success: true,
 		Message: fmt.Sprintf("User %d 연결상태 변경 알림: %v", userID, online),
 		Type:    "connection",
@@ -537,19 +652,38 @@ func (hub *WebSocketHub) BroadcastOnlineStatus(userID uint, online bool) {
 			IsOnline: online,
 		},
 	}
+}
+
+// 온라인 상태 변경할 때
+func (hub *WebSocketHub) BroadcastOnlineStatus(userID uint, online bool) {
+	// 클러스터 모드에서는 각 노드가 presence 이벤트를 받아 OnlineClients 갱신 후 전달
+	if hub.cluster != nil {
+		hub.cluster.publish(presenceSubject, presenceEvent{UserID: userID, Online: online, NodeID: hub.cluster.nodeID})
+		return
+	}
 
 	//TODO 온라인 상태 변경 시 모든 유저에게 전송 -> 추후 수정해야함
-	hub.BroadcastToAllUsers(statusMessage)
+	hub.BroadcastToAllUsers(onlineStatusMessage(userID, online))
 }
 
 // TODO 이건 RoomID와는 관계 없음
 func (hub *WebSocketHub) BroadcastToAllUsers(message interface{}) {
+	if hub.cluster != nil {
+		hub.cluster.publish(broadcastSubject, message)
+		return
+	}
+	hub.deliverToAllUsers(message)
+}
+
+func (hub *WebSocketHub) deliverToAllUsers(message interface{}) {
+	hub.deliverToAllTopicClients(message)
+
 	hub.clientMutex.Lock()
 	defer hub.clientMutex.Unlock()
 
-	for _, clientsMap := range hub.Clients {
+	for userID, clientsMap := range hub.Clients {
 		for conn := range clientsMap {
-			hub.sendMessageToClient(conn, message)
+			hub.enqueue(conn, message, dropScope{UserID: userID})
 		}
 	}
 }
@@ -582,6 +716,7 @@ func (hub *WebSocketHub) RegisterBoardClient(conn *websocket.Conn, userID uint,
 		LastPing: time.Now(),
 		IsActive: true,
 		Cancel:   cancel,
+		writer:   hub.attachWriter(conn),
 	}
 
 	userConns[conn] = connInfo
@@ -654,6 +789,7 @@ func (hub *WebSocketHub) UnregisterBoardClient(conn *websocket.Conn, userID uint
 
 	// 연결 제거
 	delete(userConns, conn)
+	hub.releaseWriter(conn)
 
 	if len(userConns) == 0 {
 
@@ -746,7 +882,16 @@ func (hub *WebSocketHub) notifyBoardUserLeft(boardID uint, userID uint) {
 
 // BroadcastToBoard 함수 수정 - 문제 해결 시도
 func (hub *WebSocketHub) BroadcastToBoard(boardID uint, msg interface{}) {
-	// 보드별 뮤텍스 획득 (읽기 전용)
+	seq := hub.sequence(boardTopic(boardID), msg)
+	if hub.cluster != nil {
+		hub.cluster.publish(fmt.Sprintf("%sboard.%d", clusterSubjectPrefix, boardID), sequencedMessage{Seq: seq, Message: msg})
+		return
+	}
+	hub.deliverToBoard(boardID, seq, msg)
+}
+
+func (hub *WebSocketHub) deliverToBoard(boardID uint, seq uint64, msg interface{}) {
+	hub.deliverToTopic(boardTopic(boardID), seq, msg)
 
 	log.Printf("BroadcastToBoard 호출: boardID=%d", boardID)
 
@@ -766,25 +911,40 @@ func (hub *WebSocketHub) BroadcastToBoard(boardID uint, msg interface{}) {
 		return
 	}
 
-	// 각 사용자의 모든 연결에 직접 메시지 전송
+	// 보드 뮤텍스 안에서 전송 대상만 복사 (등록/해제와 동시에 맵을 순회하지 않도록)
+	type boardTarget struct {
+		userID uint
+		writer *connWriter
+	}
+	mutex := hub.getBoardMutex(boardID)
+	mutex.RLock()
+	targets := make([]boardTarget, 0, len(boardClients))
 	for userID, userConns := range boardClients {
-		for conn, connInfo := range userConns {
-			if connInfo == nil || !connInfo.IsActive {
+		for _, connInfo := range userConns {
+			if connInfo == nil || !connInfo.IsActive || connInfo.writer == nil {
 				continue
 			}
-
-			err := conn.WriteJSON(msg)
-			if err != nil {
-				log.Printf("메시지 전송 실패 (사용자 %d): %v", userID, err)
-				connInfo.IsActive = false
-			} else {
-				log.Printf("메시지 전송 성공 (사용자 %d)", userID)
-			}
+			targets = append(targets, boardTarget{userID: userID, writer: connInfo.writer})
 		}
 	}
+	mutex.RUnlock()
+
+	// 각 사용자의 모든 연결의 전송 큐에 추가 (느린 연결이 다른 사용자 전송을 막지 않음)
+	for _, target := range targets {
+		target.writer.enqueue(outboundMessage{message: msg, scope: dropScope{UserID: target.userID, BoardID: boardID}})
+	}
 }
 
 func (hub *WebSocketHub) SendMessageToBoardUser(boardID uint, userID uint, msg interface{}) {
+	if hub.cluster != nil {
+		hub.cluster.publish(fmt.Sprintf("%sboard.%d.user.%d", clusterSubjectPrefix, boardID, userID), msg)
+		return
+	}
+	hub.deliverToBoardUser(boardID, userID, msg)
+}
+
+func (hub *WebSocketHub) deliverToBoardUser(boardID uint, userID uint, msg interface{}) {
+	hub.deliverToTopicUser(boardTopic(boardID), userID, 0, msg)
 
 	mutex := hub.getBoardMutex(boardID)
 	mutex.RLock()
@@ -795,9 +955,7 @@ func (hub *WebSocketHub) SendMessageToBoardUser(boardID uint, userID uint, msg i
 
 		if userConns, ok := boardClients[userID]; ok {
 			for conn := range userConns {
-				if err := conn.WriteJSON(msg); err != nil {
-					log.Printf("웹소켓 메시지 전송 실패: %v", err)
-				}
+				hub.enqueue(conn, msg, dropScope{UserID: userID, BoardID: boardID})
 			}
 		}
 	}
@@ -824,6 +982,17 @@ func (hub *WebSocketHub) Shutdown() {
 		return true
 	})
 
+	hub.writers.Range(func(_, writer interface{}) bool {
+		writer.(*connWriter).stop()
+		return true
+	})
+
+	hub.topicMutex.RLock()
+	for client := range hub.topicClients {
+		client.Conn.Close()
+	}
+	hub.topicMutex.RUnlock()
+
 	log.Println("WebSocketHub가 안전하게 종료되었습니다.")
 }
 
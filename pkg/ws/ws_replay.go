@@ -0,0 +1,193 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// 토픽별 seq와 재전송 버퍼
+// 메시지를 보낼 때(Send*/Broadcast*) 한 번만 seq를 발급하고 버퍼에 저장한 뒤 전달하므로
+// 클러스터 모드에서도 모든 노드가 같은 seq로 전달
+// 재연결한 클라이언트는 resume(마지막 seq)으로 놓친 메시지를 받고, 버퍼 범위를 벗어나면 reset
+//
+// seq 0은 버퍼에 남기지 않는 일시적 메시지 (전체 온라인 상태, 보드의 특정 사용자 전용 메시지 등)
+const (
+	ReplayBufferSize = 500            // 토픽별 보관 메시지 수
+	ReplayBufferTTL  = 24 * time.Hour // 마지막 메시지 이후 보관 기간
+	MaxReplayCount   = ReplayBufferSize
+)
+
+type topicEntry struct {
+	Seq  uint64
+	Data []byte
+}
+
+type topicLog interface {
+	// append 다음 seq를 발급하고 메시지 저장
+	append(key string, data []byte) (uint64, error)
+	// current 마지막으로 발급된 seq
+	current(key string) (uint64, error)
+	// since lastSeq 이후 메시지 - 버퍼에서 밀려났거나 limit을 넘으면 complete=false
+	since(key string, lastSeq uint64, limit int) (entries []topicEntry, current uint64, complete bool, err error)
+}
+
+func newTopicLog(redisClient *redis.Client) topicLog {
+	if redisClient == nil {
+		return &memoryTopicLog{topics: make(map[string]*memoryTopic)}
+	}
+	return &redisTopicLog{redisClient: redisClient}
+}
+
+// redis stream 버퍼 (stream ID = <seq>-0)
+type redisTopicLog struct {
+	redisClient *redis.Client
+}
+
+func redisTopicSeqKey(key string) string    { return fmt.Sprintf("ws:topic:{%s}:seq", key) }
+func redisTopicStreamKey(key string) string { return fmt.Sprintf("ws:topic:{%s}:stream", key) }
+
+// seq 발급과 저장을 원자적으로 처리해야 stream ID 순서가 뒤바뀌지 않음
+var appendTopicScript = redis.NewScript(`
+local seq = redis.call('INCR', KEYS[1])
+redis.call('XADD', KEYS[2], 'MAXLEN', '~', ARGV[2], seq .. '-0', 'm', ARGV[1])
+redis.call('EXPIRE', KEYS[1], ARGV[3])
+redis.call('EXPIRE', KEYS[2], ARGV[3])
+return seq
+`)
+
+func (l *redisTopicLog) append(key string, data []byte) (uint64, error) {
+	seq, err := appendTopicScript.Run(context.Background(), l.redisClient,
+		[]string{redisTopicSeqKey(key), redisTopicStreamKey(key)},
+		data, ReplayBufferSize, int(ReplayBufferTTL.Seconds())).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("토픽 메시지 저장 실패: %w", err)
+	}
+	return uint64(seq), nil
+}
+
+func (l *redisTopicLog) current(key string) (uint64, error) {
+	seq, err := l.redisClient.Get(context.Background(), redisTopicSeqKey(key)).Uint64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("토픽 seq 조회 실패: %w", err)
+	}
+	return seq, nil
+}
+
+func (l *redisTopicLog) since(key string, lastSeq uint64, limit int) ([]topicEntry, uint64, bool, error) {
+	current, err := l.current(key)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	if lastSeq >= current {
+		// 이미 최신이거나, 버퍼가 만료되어 seq가 다시 시작된 경우
+		return nil, current, lastSeq == current, nil
+	}
+	if current-lastSeq > uint64(limit) {
+		return nil, current, false, nil
+	}
+
+	messages, err := l.redisClient.XRange(context.Background(), redisTopicStreamKey(key), fmt.Sprintf("%d-0", lastSeq+1), "+").Result()
+	if err != nil {
+		return nil, current, false, fmt.Errorf("토픽 메시지 조회 실패: %w", err)
+	}
+
+	entries := make([]topicEntry, 0, len(messages))
+	for _, message := range messages {
+		seq, err := strconv.ParseUint(strings.TrimSuffix(message.ID, "-0"), 10, 64)
+		if err != nil {
+			continue
+		}
+		data, _ := message.Values["m"].(string)
+		entries = append(entries, topicEntry{Seq: seq, Data: []byte(data)})
+	}
+
+	// 첫 메시지가 lastSeq+1이 아니면 그 사이가 버퍼에서 밀려난 것
+	complete := len(entries) > 0 && entries[0].Seq == lastSeq+1
+	return entries, current, complete, nil
+}
+
+// redis가 없을 때(단일 노드 개발 환경) 메모리 버퍼
+type memoryTopicLog struct {
+	mutex  sync.Mutex
+	topics map[string]*memoryTopic
+}
+
+type memoryTopic struct {
+	seq       uint64
+	entries   []topicEntry
+	updatedAt time.Time
+}
+
+func (l *memoryTopicLog) append(key string, data []byte) (uint64, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	topic, ok := l.topics[key]
+	if !ok || time.Since(topic.updatedAt) > ReplayBufferTTL {
+		topic = &memoryTopic{}
+		l.topics[key] = topic
+	}
+	topic.seq++
+	topic.entries = append(topic.entries, topicEntry{Seq: topic.seq, Data: data})
+	if len(topic.entries) > ReplayBufferSize {
+		topic.entries = topic.entries[len(topic.entries)-ReplayBufferSize:]
+	}
+	topic.updatedAt = time.Now()
+	return topic.seq, nil
+}
+
+func (l *memoryTopicLog) current(key string) (uint64, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if topic, ok := l.topics[key]; ok {
+		return topic.seq, nil
+	}
+	return 0, nil
+}
+
+func (l *memoryTopicLog) since(key string, lastSeq uint64, limit int) ([]topicEntry, uint64, bool, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	topic, ok := l.topics[key]
+	if !ok {
+		return nil, 0, lastSeq == 0, nil
+	}
+	if lastSeq >= topic.seq {
+		return nil, topic.seq, lastSeq == topic.seq, nil
+	}
+	if topic.seq-lastSeq > uint64(limit) || len(topic.entries) == 0 || topic.entries[0].Seq > lastSeq+1 {
+		return nil, topic.seq, false, nil
+	}
+
+	start := int(lastSeq + 1 - topic.entries[0].Seq)
+	entries := make([]topicEntry, len(topic.entries)-start)
+	copy(entries, topic.entries[start:])
+	return entries, topic.seq, true, nil
+}
+
+// 메시지에 seq 발급 (실패하면 0 - 실시간 전달은 계속하고 재전송만 불가)
+func (hub *WebSocketHub) sequence(key string, message interface{}) uint64 {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return 0
+	}
+	seq, err := hub.topicLog.append(key, data)
+	if err != nil {
+		log.Printf("웹소켓 토픽 seq 발급 실패[%s]: %v", key, err)
+		return 0
+	}
+	return seq
+}
@@ -0,0 +1,452 @@
+package ws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"link/pkg/dto/res"
+)
+
+// /ws/v2 멀티플렉스 연결
+// 한 번 인증한 연결로 여러 토픽을 subscribe/unsubscribe 하고, 서버는 모든 메시지를 envelope로 감싸서 전달
+//
+//	chat:<roomId>       채팅방
+//	board:<boardId>     칸반보드
+//	company:<companyId> 회사 이벤트
+//	user                내 알림/온라인 상태 (내부 키는 user:<userId>)
+//
+// 기존 Send*/Broadcast* 전달 경로(deliverTo*)가 토픽 구독자에게도 전달하므로 클러스터 모드에서도 그대로 동작
+const (
+	EnvelopeVersion = 1
+
+	TopicChat    = "chat"
+	TopicBoard   = "board"
+	TopicCompany = "company"
+	TopicUser    = "user"
+
+	MaxTopicsPerClient = 200
+)
+
+// TopicClient /ws/v2 연결 하나
+type TopicClient struct {
+	Conn      *websocket.Conn
+	UserID    uint
+	Name      string
+	CompanyID uint
+
+	writer *connWriter
+	topics map[string]string // 내부 키 -> 클라이언트에게 보이는 토픽 이름 (hub.topicMutex로 보호)
+
+	// resume 처리 중인 토픽은 실시간 메시지를 pending에 모았다가 재전송이 끝나면 이어서 전달
+	replayMutex sync.Mutex
+	replaying   map[string][]res.WsEnvelope
+	lastSeq     map[string]uint64 // 토픽별 마지막으로 보낸 seq (중복 전송 방지)
+}
+
+type topicSubscribers struct {
+	mutex   sync.Mutex
+	clients map[*TopicClient]struct{}
+}
+
+// ParseTopic "chat:12" -> ("chat", 12), "user" -> ("user", 0)
+func ParseTopic(topic string) (string, uint, error) {
+	if topic == TopicUser {
+		return TopicUser, 0, nil
+	}
+
+	kind, idStr, found := strings.Cut(topic, ":")
+	if !found {
+		return "", 0, fmt.Errorf("잘못된 토픽 형식: %s", topic)
+	}
+	switch kind {
+	case TopicChat, TopicBoard, TopicCompany:
+	default:
+		return "", 0, fmt.Errorf("지원하지 않는 토픽: %s", topic)
+	}
+
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil || id == 0 {
+		return "", 0, fmt.Errorf("잘못된 토픽 ID: %s", topic)
+	}
+	return kind, uint(id), nil
+}
+
+func chatTopic(roomID uint) string       { return fmt.Sprintf("%s:%d", TopicChat, roomID) }
+func boardTopic(boardID uint) string     { return fmt.Sprintf("%s:%d", TopicBoard, boardID) }
+func companyTopic(companyID uint) string { return fmt.Sprintf("%s:%d", TopicCompany, companyID) }
+func userTopic(userID uint) string       { return fmt.Sprintf("%s:%d", TopicUser, userID) }
+
+// 클라이언트가 보낸 토픽 이름 -> 내부 키 (user는 연결한 사용자 기준)
+func (client *TopicClient) topicKey(topic string) string {
+	if topic == TopicUser {
+		return userTopic(client.UserID)
+	}
+	return topic
+}
+
+// 연결 등록 - user 토픽은 자동 구독, 사용자의 첫 연결이면 온라인 알림
+func (hub *WebSocketHub) RegisterTopicClient(conn *websocket.Conn, userID uint, companyID uint) *TopicClient {
+	client := &TopicClient{
+		Conn:      conn,
+		UserID:    userID,
+		CompanyID: companyID,
+		writer:    hub.attachWriter(conn),
+		topics:    make(map[string]string),
+		replaying: make(map[string][]res.WsEnvelope),
+		lastSeq:   make(map[string]uint64),
+	}
+
+	hub.topicMutex.Lock()
+	hub.topicClients[client] = struct{}{}
+	hub.topicUserConns[userID]++
+	first := hub.topicUserConns[userID] == 1
+	hub.topicMutex.Unlock()
+
+	hub.Subscribe(client, TopicUser)
+
+	if first && !hub.hasLegacyClients(userID) {
+		hub.setUserOnline(userID)
+	}
+	return client
+}
+
+// 연결 해제 - 모든 구독 해제 후 사용자의 마지막 연결이면 오프라인 알림
+func (hub *WebSocketHub) UnregisterTopicClient(client *TopicClient) {
+	hub.topicMutex.Lock()
+	if _, ok := hub.topicClients[client]; !ok {
+		hub.topicMutex.Unlock()
+		return
+	}
+	topics := make([]string, 0, len(client.topics))
+	for _, topic := range client.topics {
+		topics = append(topics, topic)
+	}
+	hub.topicMutex.Unlock()
+
+	for _, topic := range topics {
+		hub.Unsubscribe(client, topic)
+	}
+
+	hub.topicMutex.Lock()
+	delete(hub.topicClients, client)
+	hub.topicUserConns[client.UserID]--
+	last := hub.topicUserConns[client.UserID] <= 0
+	if last {
+		delete(hub.topicUserConns, client.UserID)
+	}
+	hub.topicMutex.Unlock()
+
+	client.writer.stop()
+	client.Conn.Close()
+
+	if last && !hub.hasLegacyClients(client.UserID) {
+		hub.setUserOffline(client.UserID)
+	}
+}
+
+// Subscribe 토픽 구독 (권한 확인은 핸들러에서) - 현재 seq 반환
+func (hub *WebSocketHub) Subscribe(client *TopicClient, topic string) (uint64, error) {
+	key := client.topicKey(topic)
+
+	hub.topicMutex.Lock()
+	if _, ok := client.topics[key]; !ok && len(client.topics) >= MaxTopicsPerClient {
+		hub.topicMutex.Unlock()
+		return 0, fmt.Errorf("구독 가능한 토픽 수(%d)를 초과했습니다", MaxTopicsPerClient)
+	}
+	subscribers, ok := hub.topics[key]
+	if !ok {
+		subscribers = &topicSubscribers{clients: make(map[*TopicClient]struct{})}
+		hub.topics[key] = subscribers
+	}
+	_, already := client.topics[key]
+	client.topics[key] = topic
+	subscribers.mutex.Lock()
+	subscribers.clients[client] = struct{}{}
+	subscribers.mutex.Unlock()
+	hub.topicMutex.Unlock()
+
+	seq, err := hub.topicLog.current(key)
+	if err != nil {
+		log.Printf("웹소켓 토픽 seq 조회 실패[%s]: %v", key, err)
+	}
+
+	if !already {
+		if kind, boardID, _ := ParseTopic(topic); kind == TopicBoard && !hub.isSubscribedToBoard(client.UserID, boardID, client) {
+			hub.notifyBoardUserJoined(boardID, client.UserID)
+		}
+	}
+	return seq, nil
+}
+
+// Resume 구독 후 lastSeq 이후 놓친 메시지 재전송
+// 버퍼에서 밀려났거나 너무 많이 놓친 경우 reset을 보내고 (클라이언트는 API로 다시 조회) 현재 seq부터 이어서 전달
+func (hub *WebSocketHub) Resume(client *TopicClient, topic string, lastSeq uint64) error {
+	key := client.topicKey(topic)
+
+	client.replayMutex.Lock()
+	client.replaying[key] = nil
+	client.replayMutex.Unlock()
+
+	if _, err := hub.Subscribe(client, topic); err != nil {
+		client.replayMutex.Lock()
+		delete(client.replaying, key)
+		client.replayMutex.Unlock()
+		return err
+	}
+
+	entries, current, complete, err := hub.topicLog.since(key, lastSeq, MaxReplayCount)
+	if err != nil {
+		log.Printf("웹소켓 토픽 재전송 조회 실패[%s]: %v", key, err)
+		complete = false
+	}
+
+	client.replayMutex.Lock()
+	defer client.replayMutex.Unlock()
+
+	scope := topicDropScope(key, client.UserID)
+	if complete {
+		for _, entry := range entries {
+			msgType, payload := envelopeBody(json.RawMessage(entry.Data))
+			client.send(res.WsEnvelope{V: EnvelopeVersion, Type: msgType, Topic: topic, Seq: entry.Seq, Payload: payload}, scope)
+		}
+		client.Send(res.WsEnvelope{V: EnvelopeVersion, Type: FrameResumed, Topic: topic, Seq: current})
+	} else {
+		client.Send(res.WsEnvelope{V: EnvelopeVersion, Type: FrameReset, Topic: topic, Seq: current})
+	}
+	if current > client.lastSeq[key] {
+		client.lastSeq[key] = current
+	}
+
+	// 재전송 중에 들어온 실시간 메시지 중 아직 보내지 않은 것만 전달
+	for _, envelope := range client.replaying[key] {
+		if envelope.Seq != 0 && envelope.Seq <= client.lastSeq[key] {
+			continue
+		}
+		client.send(envelope, scope)
+		if envelope.Seq > client.lastSeq[key] {
+			client.lastSeq[key] = envelope.Seq
+		}
+	}
+	delete(client.replaying, key)
+	return nil
+}
+
+// Unsubscribe 토픽 구독 해제 - 구독자가 없는 토픽은 제거
+func (hub *WebSocketHub) Unsubscribe(client *TopicClient, topic string) {
+	key := client.topicKey(topic)
+
+	hub.topicMutex.Lock()
+	if _, ok := client.topics[key]; !ok {
+		hub.topicMutex.Unlock()
+		return
+	}
+	delete(client.topics, key)
+	if subscribers, ok := hub.topics[key]; ok {
+		subscribers.mutex.Lock()
+		delete(subscribers.clients, client)
+		empty := len(subscribers.clients) == 0
+		subscribers.mutex.Unlock()
+		if empty {
+			delete(hub.topics, key)
+		}
+	}
+	hub.topicMutex.Unlock()
+
+	if kind, boardID, _ := ParseTopic(topic); kind == TopicBoard && !hub.isSubscribedToBoard(client.UserID, boardID, nil) {
+		hub.notifyBoardUserLeft(boardID, client.UserID)
+	}
+}
+
+// 사용자의 이 노드 연결을 토픽에서 제거 (채팅방 나가기 등 권한이 없어진 경우)
+func (hub *WebSocketHub) UnsubscribeUser(topic string, userID uint) {
+	hub.topicMutex.RLock()
+	var clients []*TopicClient
+	if subscribers, ok := hub.topics[topic]; ok {
+		subscribers.mutex.Lock()
+		for client := range subscribers.clients {
+			if client.UserID == userID {
+				clients = append(clients, client)
+			}
+		}
+		subscribers.mutex.Unlock()
+	}
+	hub.topicMutex.RUnlock()
+
+	for _, client := range clients {
+		hub.Unsubscribe(client, topic)
+		client.Send(res.WsEnvelope{V: EnvelopeVersion, Type: FrameUnsubscribed, Topic: topic})
+	}
+}
+
+// except를 제외한 사용자의 다른 연결(v1 보드 소켓 포함)이 보드에 붙어 있는지
+func (hub *WebSocketHub) isSubscribedToBoard(userID uint, boardID uint, except *TopicClient) bool {
+	hub.topicMutex.RLock()
+	if subscribers, ok := hub.topics[boardTopic(boardID)]; ok {
+		subscribers.mutex.Lock()
+		for client := range subscribers.clients {
+			if client != except && client.UserID == userID {
+				subscribers.mutex.Unlock()
+				hub.topicMutex.RUnlock()
+				return true
+			}
+		}
+		subscribers.mutex.Unlock()
+	}
+	hub.topicMutex.RUnlock()
+
+	mutex := hub.getBoardMutex(boardID)
+	mutex.RLock()
+	defer mutex.RUnlock()
+	if boardClientsInterface, ok := hub.BoardClients.Load(boardID); ok {
+		boardClients := boardClientsInterface.(map[uint]map[*websocket.Conn]*ConnectionInfo)
+		return len(boardClients[userID]) > 0
+	}
+	return false
+}
+
+func (hub *WebSocketHub) hasLegacyClients(userID uint) bool {
+	hub.clientMutex.Lock()
+	defer hub.clientMutex.Unlock()
+	return len(hub.Clients[userID]) > 0
+}
+
+func (hub *WebSocketHub) hasTopicClients(userID uint) bool {
+	hub.topicMutex.RLock()
+	defer hub.topicMutex.RUnlock()
+	return hub.topicUserConns[userID] > 0
+}
+
+// 이 노드에서 토픽을 구독 중인 연결에 envelope로 전달 (seq는 발행 시 sequence로 발급)
+func (hub *WebSocketHub) deliverToTopic(key string, seq uint64, message interface{}) {
+	hub.deliverToTopicUser(key, 0, seq, message)
+}
+
+// userID가 0이 아니면 토픽을 구독 중인 해당 사용자에게만 전달
+func (hub *WebSocketHub) deliverToTopicUser(key string, userID uint, seq uint64, message interface{}) {
+	hub.topicMutex.RLock()
+	subscribers, ok := hub.topics[key]
+	hub.topicMutex.RUnlock()
+	if !ok {
+		return
+	}
+
+	msgType, payload := envelopeBody(message)
+
+	subscribers.mutex.Lock()
+	clients := make([]*TopicClient, 0, len(subscribers.clients))
+	for client := range subscribers.clients {
+		if userID == 0 || client.UserID == userID {
+			clients = append(clients, client)
+		}
+	}
+	subscribers.mutex.Unlock()
+
+	for _, client := range clients {
+		hub.topicMutex.RLock()
+		topic := client.topics[key]
+		hub.topicMutex.RUnlock()
+		if topic == "" {
+			continue
+		}
+
+		client.deliver(key, res.WsEnvelope{
+			V:       EnvelopeVersion,
+			Type:    msgType,
+			Topic:   topic,
+			Seq:     seq,
+			Payload: payload,
+		})
+	}
+}
+
+// 토픽 메시지 전달 - resume 중이면 보류, 이미 보낸 seq는 건너뜀
+func (client *TopicClient) deliver(key string, envelope res.WsEnvelope) {
+	client.replayMutex.Lock()
+	if pending, ok := client.replaying[key]; ok {
+		client.replaying[key] = append(pending, envelope)
+		client.replayMutex.Unlock()
+		return
+	}
+	if envelope.Seq != 0 {
+		if envelope.Seq <= client.lastSeq[key] {
+			client.replayMutex.Unlock()
+			return
+		}
+		client.lastSeq[key] = envelope.Seq
+	}
+	client.replayMutex.Unlock()
+
+	client.send(envelope, topicDropScope(key, client.UserID))
+}
+
+// 토픽 키 -> 버린 메시지 집계 대상
+func topicDropScope(key string, userID uint) dropScope {
+	scope := dropScope{UserID: userID}
+	switch kind, id, _ := ParseTopic(key); kind {
+	case TopicChat:
+		scope.RoomID = id
+	case TopicBoard:
+		scope.BoardID = id
+	}
+	return scope
+}
+
+// 전체 사용자 대상 메시지 - 각 연결의 user 토픽으로 전달
+func (hub *WebSocketHub) deliverToAllTopicClients(message interface{}) {
+	hub.topicMutex.RLock()
+	userIDs := make([]uint, 0, len(hub.topicUserConns))
+	for userID := range hub.topicUserConns {
+		userIDs = append(userIDs, userID)
+	}
+	hub.topicMutex.RUnlock()
+
+	for _, userID := range userIDs {
+		hub.deliverToTopic(userTopic(userID), 0, message)
+	}
+}
+
+// Send 연결의 전송 큐에 추가 (실제 쓰기는 writer 고루틴)
+func (client *TopicClient) Send(envelope res.WsEnvelope) {
+	client.send(envelope, dropScope{UserID: client.UserID})
+}
+
+func (client *TopicClient) send(envelope res.WsEnvelope, scope dropScope) {
+	client.writer.enqueue(outboundMessage{message: envelope, scope: scope})
+}
+
+// Ping ping 프레임 전송 (control frame은 writer와 동시에 호출 가능)
+func (client *TopicClient) Ping() error {
+	return client.Conn.WriteControl(websocket.PingMessage, []byte{}, time.Now().Add(WriteWait))
+}
+
+// 기존 메시지(res.JsonResponse, 클러스터에서 받은 json) -> envelope type/payload
+// payload가 없는 메시지(message만 있는 알림 등)는 원본 전체를 payload로 사용
+func envelopeBody(message interface{}) (string, interface{}) {
+	switch msg := message.(type) {
+	case res.JsonResponse:
+		if msg.Payload != nil {
+			return msg.Type, msg.Payload
+		}
+		return msg.Type, msg
+	case json.RawMessage:
+		var legacy struct {
+			Type    string          `json:"type"`
+			Payload json.RawMessage `json:"payload"`
+		}
+		if err := json.Unmarshal(msg, &legacy); err != nil {
+			return "", msg
+		}
+		if len(legacy.Payload) > 0 && string(legacy.Payload) != "null" {
+			return legacy.Type, legacy.Payload
+		}
+		return legacy.Type, msg
+	}
+	return "", message
+}
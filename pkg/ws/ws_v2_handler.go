@@ -0,0 +1,260 @@
+package ws
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+
+	"link/pkg/dto/req"
+	"link/pkg/dto/res"
+)
+
+// /ws/v2 프레임 타입
+const (
+	FrameSubscribe   = "subscribe"
+	FrameUnsubscribe = "unsubscribe"
+	FrameResume      = "resume"
+	FramePing        = "ping"
+
+	// 채팅 (topic: chat:<roomId>)
+	FrameTyping    = "typing"
+	FrameRead      = "read"
+	FrameDelivered = "delivered"
+
+	FrameConnected    = "connected"
+	FrameSubscribed   = "subscribed"
+	FrameUnsubscribed = "unsubscribed"
+	FrameResumed      = "resumed"
+	FrameReset        = "reset"
+	FramePong         = "pong"
+	FrameError        = "error"
+)
+
+// TODO 멀티플렉스 웹소켓 연결 핸들러 - 연결 시 한 번만 인증하고 토픽 단위로 구독
+//
+//	→ {"type":"subscribe","topic":"chat:12"}
+//	← {"v":1,"type":"subscribed","topic":"chat:12","seq":0}
+//	← {"v":1,"type":"chat","topic":"chat:12","seq":1,"payload":{...}}
+//
+// 재연결 시 마지막으로 받은 seq로 resume - 놓친 메시지 후 resumed, 버퍼를 벗어나면 reset
+//
+//	→ {"type":"resume","topic":"chat:12","seq":1}
+func (h *WsHandler) HandleWebSocketV2(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		token = strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	}
+	if token == "" {
+		c.JSON(http.StatusUnauthorized, res.JsonResponse{
+			Success: false,
+			Message: "토큰이 필요합니다",
+			Type:    "error",
+		})
+		return
+	}
+
+	// 토큰 검증 (연결 이후에는 다시 검증하지 않음)
+	claims, err := h.authUsecase.ValidateAccessToken(token)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, res.JsonResponse{
+			Success: false,
+			Message: "유효하지 않은 토큰입니다",
+			Type:    "error",
+		})
+		return
+	}
+
+	user, err := h.userUsecase.GetUserMyInfo(claims.UserId)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, res.JsonResponse{
+			Success: false,
+			Message: "사용자 조회 실패",
+			Type:    "error",
+		})
+		return
+	}
+	var companyID uint
+	if user.UserProfile != nil && user.UserProfile.CompanyID != nil {
+		companyID = *user.UserProfile.CompanyID
+	}
+
+	conn, err := Upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Printf("WebSocket 업그레이드 실패: %v", err)
+		return
+	}
+
+	client := h.hub.RegisterTopicClient(conn, claims.UserId, companyID)
+	client.Name = claims.Name
+	defer h.hub.UnregisterTopicClient(client)
+
+	client.Send(res.WsEnvelope{
+		V:    EnvelopeVersion,
+		Type: FrameConnected,
+		Payload: map[string]interface{}{
+			"user_id":    claims.UserId,
+			"company_id": companyID,
+		},
+	})
+
+	conn.SetReadDeadline(time.Now().Add(PongWait))
+	conn.SetPongHandler(func(string) error {
+		conn.SetReadDeadline(time.Now().Add(PongWait))
+		return nil
+	})
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		ticker := time.NewTicker(PingInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := client.Ping(); err != nil {
+					log.Printf("Ping 전송 실패: %v", err)
+					conn.Close()
+					return
+				}
+			}
+		}
+	}()
+
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				log.Printf("v2 웹소켓 오류 (사용자 %d): %v", claims.UserId, err)
+			}
+			return
+		}
+		conn.SetReadDeadline(time.Now().Add(PongWait))
+
+		var frame req.WsFrameRequest
+		if err := json.Unmarshal(message, &frame); err != nil {
+			sendFrameError(client, "", "메시지 형식이 올바르지 않습니다")
+			continue
+		}
+
+		switch frame.Type {
+		case FrameSubscribe:
+			h.handleSubscribe(client, frame.Topic)
+		case FrameResume:
+			h.handleResume(client, frame.Topic, frame.Seq)
+		case FrameUnsubscribe:
+			if _, _, err := ParseTopic(frame.Topic); err != nil {
+				sendFrameError(client, frame.Topic, err.Error())
+				continue
+			}
+			h.hub.Unsubscribe(client, frame.Topic)
+			client.Send(res.WsEnvelope{V: EnvelopeVersion, Type: FrameUnsubscribed, Topic: frame.Topic})
+		case FrameTyping, FrameRead, FrameDelivered:
+			h.handleChatFrame(client, frame)
+		case FramePing:
+			client.Send(res.WsEnvelope{V: EnvelopeVersion, Type: FramePong})
+		default:
+			sendFrameError(client, frame.Topic, "지원하지 않는 메시지 타입입니다")
+		}
+	}
+}
+
+func (h *WsHandler) handleSubscribe(client *TopicClient, topic string) {
+	kind, id, err := ParseTopic(topic)
+	if err != nil {
+		sendFrameError(client, topic, err.Error())
+		return
+	}
+
+	if !h.canSubscribe(client, kind, id) {
+		sendFrameError(client, topic, "토픽을 구독할 권한이 없습니다")
+		return
+	}
+
+	seq, err := h.hub.Subscribe(client, topic)
+	if err != nil {
+		sendFrameError(client, topic, err.Error())
+		return
+	}
+	client.Send(res.WsEnvelope{V: EnvelopeVersion, Type: FrameSubscribed, Topic: topic, Seq: seq})
+}
+
+func (h *WsHandler) handleResume(client *TopicClient, topic string, lastSeq uint64) {
+	kind, id, err := ParseTopic(topic)
+	if err != nil {
+		sendFrameError(client, topic, err.Error())
+		return
+	}
+
+	if !h.canSubscribe(client, kind, id) {
+		sendFrameError(client, topic, "토픽을 구독할 권한이 없습니다")
+		return
+	}
+
+	if err := h.hub.Resume(client, topic, lastSeq); err != nil {
+		sendFrameError(client, topic, err.Error())
+	}
+}
+
+// 채팅 토픽 입력중/읽음/수신 확인 - payload는 ChatSignalRequest
+//
+//	→ {"type":"read","topic":"chat:12","payload":{"message_id":"..."}}
+func (h *WsHandler) handleChatFrame(client *TopicClient, frame req.WsFrameRequest) {
+	kind, roomId, err := ParseTopic(frame.Topic)
+	if err != nil || kind != TopicChat {
+		sendFrameError(client, frame.Topic, "chat 토픽이 필요합니다")
+		return
+	}
+
+	var signal req.ChatSignalRequest
+	if len(frame.Payload) > 0 {
+		if err := json.Unmarshal(frame.Payload, &signal); err != nil {
+			sendFrameError(client, frame.Topic, "payload 형식이 올바르지 않습니다")
+			return
+		}
+	}
+
+	if err := h.handleChatSignal(client.UserID, client.Name, roomId, frame.Type, signal); err != nil {
+		sendFrameError(client, frame.Topic, errorMessage(err))
+	}
+}
+
+// 토픽 구독 권한 - 채팅방 참여자, 보드 참여자, 같은 회사 소속만 허용
+func (h *WsHandler) canSubscribe(client *TopicClient, kind string, id uint) bool {
+	switch kind {
+	case TopicUser:
+		return true
+	case TopicCompany:
+		return client.CompanyID != 0 && client.CompanyID == id
+	case TopicBoard:
+		_, err := h.boardUsecase.GetBoard(client.UserID, id)
+		return err == nil
+	case TopicChat:
+		chatRoom, err := h.chatUsecase.GetChatRoomById(id)
+		if err != nil || chatRoom == nil {
+			return false
+		}
+		for _, user := range chatRoom.Users {
+			if user.ID != nil && *user.ID == client.UserID && user.LeftAt == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func sendFrameError(client *TopicClient, topic string, message string) {
+	client.Send(res.WsEnvelope{
+		V:       EnvelopeVersion,
+		Type:    FrameError,
+		Topic:   topic,
+		Payload: map[string]string{"message": message},
+	})
+}
@@ -0,0 +1,242 @@
+package ws
+
+import (
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"link/pkg/dto/res"
+)
+
+// 연결별 전송 큐
+// 모든 메시지는 연결마다 하나뿐인 writer 고루틴이 순서대로 쓰므로 동시 쓰기 panic이 없고,
+// 느린 클라이언트 하나가 채팅방/보드 전체 브로드캐스트를 막지 않음
+//
+//	WS_SEND_QUEUE_SIZE  연결별 큐 크기 (기본 256)
+//	WS_OVERFLOW_POLICY  큐가 가득 찼을 때 drop_oldest(기본, 가장 오래된 메시지 버림) | disconnect(느린 연결 종료)
+//
+// ping/close 같은 control frame은 gorilla/websocket에서 동시 호출이 허용되므로 큐를 거치지 않음
+type OverflowPolicy string
+
+const (
+	OverflowDropOldest OverflowPolicy = "drop_oldest"
+	OverflowDisconnect OverflowPolicy = "disconnect"
+
+	DefaultSendQueueSize = 256
+)
+
+// 버린 메시지 집계 대상
+type dropScope struct {
+	UserID  uint
+	RoomID  uint
+	BoardID uint
+}
+
+type outboundMessage struct {
+	message    interface{}
+	scope      dropScope
+	closeAfter bool // 전송 후 연결 종료 (중복 접속 해제 안내 등)
+}
+
+type connWriter struct {
+	hub       *WebSocketHub
+	conn      *websocket.Conn
+	send      chan outboundMessage
+	done      chan struct{}
+	closeOnce sync.Once
+	mutex     sync.Mutex // 큐가 가득 찼을 때 꺼내고 넣는 동작을 묶음
+}
+
+type dropCounters struct {
+	total   uint64
+	byUser  sync.Map // userId -> *uint64
+	byRoom  sync.Map // roomId -> *uint64
+	byBoard sync.Map // boardId -> *uint64
+}
+
+func overflowPolicyFromEnv() OverflowPolicy {
+	if OverflowPolicy(os.Getenv("WS_OVERFLOW_POLICY")) == OverflowDisconnect {
+		return OverflowDisconnect
+	}
+	return OverflowDropOldest
+}
+
+func sendQueueSizeFromEnv() int {
+	if size, err := strconv.Atoi(os.Getenv("WS_SEND_QUEUE_SIZE")); err == nil && size > 0 {
+		return size
+	}
+	return DefaultSendQueueSize
+}
+
+// 연결의 writer (없으면 생성해서 시작)
+func (hub *WebSocketHub) attachWriter(conn *websocket.Conn) *connWriter {
+	if writer, ok := hub.writers.Load(conn); ok {
+		return writer.(*connWriter)
+	}
+
+	writer := &connWriter{
+		hub:  hub,
+		conn: conn,
+		send: make(chan outboundMessage, hub.sendQueueSize),
+		done: make(chan struct{}),
+	}
+	if existing, loaded := hub.writers.LoadOrStore(conn, writer); loaded {
+		return existing.(*connWriter)
+	}
+
+	go writer.run()
+	return writer
+}
+
+// 연결 해제 시 writer 정리
+func (hub *WebSocketHub) releaseWriter(conn *websocket.Conn) {
+	if writer, ok := hub.writers.Load(conn); ok {
+		writer.(*connWriter).stop()
+	}
+}
+
+// 연결의 전송 큐에 메시지 추가 - writer가 없으면 이미 해제 중인 연결이므로 버림
+func (hub *WebSocketHub) enqueue(conn *websocket.Conn, message interface{}, scope dropScope) {
+	if writer, ok := hub.writers.Load(conn); ok {
+		writer.(*connWriter).enqueue(outboundMessage{message: message, scope: scope})
+	}
+}
+
+// SendToConn 핸들러에서 특정 연결에 보내는 응답 (연결 성공, 에러 등)
+// 등록 전이라 writer가 없으면 핸들러 고루틴만 쓰는 중이므로 바로 씀
+func (hub *WebSocketHub) SendToConn(conn *websocket.Conn, message interface{}) {
+	if writer, ok := hub.writers.Load(conn); ok {
+		writer.(*connWriter).enqueue(outboundMessage{message: message})
+		return
+	}
+
+	conn.SetWriteDeadline(time.Now().Add(WriteWait))
+	if err := conn.WriteJSON(message); err != nil {
+		log.Printf("클라이언트에게 메시지 전송 실패: %v\n", err)
+	}
+}
+
+// 남은 메시지를 보낸 뒤 연결 종료
+func (hub *WebSocketHub) closeAfterSend(conn *websocket.Conn, message interface{}) {
+	if writer, ok := hub.writers.Load(conn); ok {
+		writer.(*connWriter).enqueue(outboundMessage{message: message, closeAfter: true})
+		return
+	}
+	conn.WriteJSON(message)
+	conn.Close()
+}
+
+func (w *connWriter) run() {
+	for {
+		select {
+		case <-w.done:
+			return
+		case item := <-w.send:
+			w.conn.SetWriteDeadline(time.Now().Add(WriteWait))
+			if err := w.conn.WriteJSON(item.message); err != nil {
+				log.Printf("클라이언트에게 메시지 전송 실패: %v\n", err)
+				w.stop()
+				w.conn.Close()
+				return
+			}
+			if item.closeAfter {
+				w.stop()
+				w.conn.Close()
+				return
+			}
+		}
+	}
+}
+
+func (w *connWriter) stop() {
+	w.closeOnce.Do(func() {
+		close(w.done)
+		w.hub.writers.CompareAndDelete(w.conn, w)
+	})
+}
+
+func (w *connWriter) enqueue(item outboundMessage) {
+	select {
+	case <-w.done:
+		return
+	default:
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	select {
+	case w.send <- item:
+		return
+	default:
+	}
+
+	// 큐가 가득 참
+	if w.hub.overflowPolicy == OverflowDisconnect {
+		w.hub.recordDrop(item.scope)
+		log.Printf("전송 큐 초과로 느린 연결 종료 (사용자 %d)", item.scope.UserID)
+		w.stop()
+		w.conn.Close()
+		return
+	}
+
+	select {
+	case dropped := <-w.send:
+		w.hub.recordDrop(dropped.scope)
+	default:
+	}
+	select {
+	case w.send <- item:
+	default:
+		w.hub.recordDrop(item.scope)
+	}
+}
+
+func (hub *WebSocketHub) recordDrop(scope dropScope) {
+	atomic.AddUint64(&hub.drops.total, 1)
+	incrementDrop(&hub.drops.byUser, scope.UserID)
+	incrementDrop(&hub.drops.byRoom, scope.RoomID)
+	incrementDrop(&hub.drops.byBoard, scope.BoardID)
+}
+
+func incrementDrop(counters *sync.Map, id uint) {
+	if id == 0 {
+		return
+	}
+	counter, _ := counters.LoadOrStore(id, new(uint64))
+	atomic.AddUint64(counter.(*uint64), 1)
+}
+
+func dropCounts(counters *sync.Map) []res.WsDropCount {
+	counts := []res.WsDropCount{}
+	counters.Range(func(key, value interface{}) bool {
+		counts = append(counts, res.WsDropCount{ID: key.(uint), Dropped: atomic.LoadUint64(value.(*uint64))})
+		return true
+	})
+	sort.Slice(counts, func(i, j int) bool { return counts[i].Dropped > counts[j].Dropped })
+	return counts
+}
+
+// GetDropStat 이 노드에서 전송 큐 초과로 버려진 메시지 수
+func (hub *WebSocketHub) GetDropStat() *res.WsDropStatResponse {
+	nodeID := ""
+	if hub.cluster != nil {
+		nodeID = hub.cluster.nodeID
+	}
+
+	return &res.WsDropStatResponse{
+		NodeID:         nodeID,
+		OverflowPolicy: string(hub.overflowPolicy),
+		SendQueueSize:  hub.sendQueueSize,
+		Total:          atomic.LoadUint64(&hub.drops.total),
+		Users:          dropCounts(&hub.drops.byUser),
+		Rooms:          dropCounts(&hub.drops.byRoom),
+		Boards:         dropCounts(&hub.drops.byBoard),
+	}
+}